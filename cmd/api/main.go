@@ -48,22 +48,88 @@ func main() {
 
 	gin.SetMode(cfg.GinMode)
 
-	db, err := database.InitDB()
+	db, err := database.InitDB(database.Options{
+		Path:        cfg.DatabasePath,
+		WAL:         cfg.DatabaseWAL,
+		BusyTimeout: cfg.DatabaseBusyTimeout,
+		ForeignKeys: cfg.DatabaseForeignKeys,
+		Seed:        cfg.DatabaseSeed,
+	})
 	if err != nil {
-		log.Fatal().Err(err).Msg("Failed to initialize database")
+		log.Fatal().Err(err).Str("path", cfg.DatabasePath).Msg("Failed to initialize database")
 	}
 	defer db.Close()
 
-	log.Info().Msg("Database initialized successfully")
+	log.Info().Str("path", cfg.DatabasePath).Msg("Database initialized successfully")
 
 	productRepo := database.NewProductRepository(db)
-	listProductUseCase := usecase.NewListProductUseCase(productRepo)
-	getProductUseCase := usecase.NewGetProductUseCase(productRepo)
-
-	productHandler := handler.NewProductHandler(listProductUseCase, getProductUseCase)
+	categoryRepo := database.NewCategoryRepository(db)
+	sellerRepo := database.NewSellerRepository(db)
+	reviewRepo := database.NewReviewRepository(db)
+	questionRepo := database.NewQuestionRepository(db)
+	exchangeRateRepo := database.NewExchangeRateRepository(db)
+	shippingRateRepo := database.NewShippingRateRepository(db)
+	installmentPlanRepo := database.NewInstallmentPlanRepository(db)
+	promotionRepo := database.NewPromotionRepository(db)
+	listProductUseCase := usecase.NewListProductUseCase(productRepo, categoryRepo, exchangeRateRepo, installmentPlanRepo, promotionRepo)
+	getProductUseCase := usecase.NewGetProductUseCase(productRepo, categoryRepo, questionRepo, exchangeRateRepo, installmentPlanRepo, promotionRepo)
+	createProductUseCase := usecase.NewCreateProductUseCase(productRepo, categoryRepo)
+	updateProductUseCase := usecase.NewUpdateProductUseCase(productRepo, categoryRepo)
+	patchProductUseCase := usecase.NewPatchProductUseCase(productRepo, categoryRepo)
+	deleteProductUseCase := usecase.NewDeleteProductUseCase(productRepo)
+	restoreProductUseCase := usecase.NewRestoreProductUseCase(productRepo)
+	searchProductsUseCase := usecase.NewSearchProductsUseCase(productRepo, categoryRepo, exchangeRateRepo, installmentPlanRepo, promotionRepo)
+	batchGetProductsUseCase := usecase.NewBatchGetProductsUseCase(productRepo, categoryRepo, exchangeRateRepo, installmentPlanRepo, promotionRepo)
+	listCategoriesUseCase := usecase.NewListCategoriesUseCase(categoryRepo)
+	getCategoryUseCase := usecase.NewGetCategoryUseCase(categoryRepo)
+	getSellerUseCase := usecase.NewGetSellerUseCase(sellerRepo)
+	listSellerProductsUseCase := usecase.NewListSellerProductsUseCase(productRepo, categoryRepo, sellerRepo, exchangeRateRepo, installmentPlanRepo, promotionRepo)
+	createReviewUseCase := usecase.NewCreateReviewUseCase(productRepo, reviewRepo)
+	listReviewsUseCase := usecase.NewListReviewsUseCase(productRepo, reviewRepo)
+	createQuestionUseCase := usecase.NewCreateQuestionUseCase(productRepo, questionRepo)
+	listQuestionsUseCase := usecase.NewListQuestionsUseCase(productRepo, questionRepo)
+	answerQuestionUseCase := usecase.NewAnswerQuestionUseCase(productRepo, questionRepo)
+	hideQuestionUseCase := usecase.NewHideQuestionUseCase(productRepo, questionRepo)
+	listExchangeRatesUseCase := usecase.NewListExchangeRatesUseCase(exchangeRateRepo)
+	loadExchangeRatesUseCase := usecase.NewLoadExchangeRatesUseCase(exchangeRateRepo)
+	getShippingQuoteUseCase := usecase.NewGetShippingQuoteUseCase(productRepo, shippingRateRepo, cfg.ShippingHolidays)
+	getProductInstallmentsUseCase := usecase.NewGetProductInstallmentsUseCase(productRepo, categoryRepo, exchangeRateRepo, installmentPlanRepo, promotionRepo)
+	createPromotionUseCase := usecase.NewCreatePromotionUseCase(promotionRepo, productRepo, categoryRepo)
+	listPromotionsUseCase := usecase.NewListPromotionsUseCase(promotionRepo)
+	deletePromotionUseCase := usecase.NewDeletePromotionUseCase(promotionRepo)
+	changeProductStatusUseCase := usecase.NewChangeProductStatusUseCase(productRepo, categoryRepo, installmentPlanRepo, promotionRepo)
+	listStatusChangesUseCase := usecase.NewListStatusChangesUseCase(productRepo)
+	purgeDeletedProductsUseCase := usecase.NewPurgeDeletedProductsUseCase(productRepo, cfg.PurgeRetention)
+	applyListingScheduleUseCase := usecase.NewApplyListingScheduleUseCase(productRepo)
+
+	productHandler := handler.NewProductHandler(
+		listProductUseCase,
+		getProductUseCase,
+		createProductUseCase,
+		updateProductUseCase,
+		patchProductUseCase,
+		deleteProductUseCase,
+		restoreProductUseCase,
+		searchProductsUseCase,
+		batchGetProductsUseCase,
+	)
+	categoryHandler := handler.NewCategoryHandler(listCategoriesUseCase, getCategoryUseCase)
+	sellerHandler := handler.NewSellerHandler(getSellerUseCase, listSellerProductsUseCase)
+	reviewHandler := handler.NewReviewHandler(createReviewUseCase, listReviewsUseCase)
+	questionHandler := handler.NewQuestionHandler(createQuestionUseCase, listQuestionsUseCase, answerQuestionUseCase, hideQuestionUseCase)
+	exchangeRateHandler := handler.NewExchangeRateHandler(listExchangeRatesUseCase, loadExchangeRatesUseCase)
+	shippingHandler := handler.NewShippingHandler(getShippingQuoteUseCase)
+	installmentHandler := handler.NewInstallmentHandler(getProductInstallmentsUseCase)
+	promotionHandler := handler.NewPromotionHandler(createPromotionUseCase, listPromotionsUseCase, deletePromotionUseCase)
+	productStatusHandler := handler.NewProductStatusHandler(changeProductStatusUseCase, listStatusChangesUseCase)
 	healthHandler := handler.NewHealthHandler()
 
-	router := httpInfra.SetupRouter(productHandler, healthHandler)
+	router := httpInfra.SetupRouter(productHandler, categoryHandler, sellerHandler, reviewHandler, questionHandler, exchangeRateHandler, shippingHandler, installmentHandler, promotionHandler, productStatusHandler, healthHandler, cfg.AdminToken)
+
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	go runPurge(jobsCtx, purgeDeletedProductsUseCase, cfg.PurgeInterval)
+	go runListingSchedule(jobsCtx, applyListingScheduleUseCase, cfg.ListingScheduleInterval)
 
 	serverAddr := fmt.Sprintf(":%s", cfg.AppPort)
 
@@ -98,3 +164,41 @@ func main() {
 		log.Info().Msg("Server gracefully stopped")
 	}
 }
+
+// runPurge periodically removes soft deleted products past their retention
+// until ctx is cancelled.
+func runPurge(ctx context.Context, purgeUseCase *usecase.PurgeDeletedProductsUseCase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := purgeUseCase.Execute(ctx); err != nil {
+				log.Error().Err(err).Msg("Scheduled purge of deleted products failed")
+			}
+		}
+	}
+}
+
+// runListingSchedule publishes and closes listings by their publishing
+// windows until ctx is cancelled. It runs once right away, catching up with
+// what fell due while the service was down, and then every interval.
+func runListingSchedule(ctx context.Context, scheduleUseCase *usecase.ApplyListingScheduleUseCase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := scheduleUseCase.Execute(ctx); err != nil {
+			log.Error().Err(err).Msg("Scheduled publishing of listings failed")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -23,24 +23,69 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/v1/products": {
+        "/api/v1/categories": {
             "get": {
-                "description": "Get a list of all products with thumbnails",
-                "consumes": [
+                "description": "Get the whole category tree. Each category counts the products in it and in its subcategories.",
+                "produces": [
                     "application/json"
                 ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "List categories",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CategoryTreeResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/categories/{id}": {
+            "get": {
+                "description": "Get a category with its breadcrumbs from the root, its direct children and the attributes its products may have, including those of its ancestors. List its products with GET /api/v1/products?category_id={id}.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "products"
+                    "categories"
+                ],
+                "summary": "Get a category by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "example": 6,
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "List all products",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ProductListResponse"
+                            "$ref": "#/definitions/dto.CategoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
                         }
                     },
                     "500": {
@@ -52,9 +97,34 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/products/{id}": {
+        "/api/v1/exchange-rates": {
             "get": {
-                "description": "Get product details by product ID including all images",
+                "description": "Get every exchange rate, quoted against the base currency. These are the currencies accepted in ?currency= by the product endpoints.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "exchange-rates"
+                ],
+                "summary": "List exchange rates",
+                "parameters": [],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ExchangeRatesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Add or replace exchange rates, quoted against the base currency (USD). Currencies left out keep their rate. Administrators only.",
                 "consumes": [
                     "application/json"
                 ],
@@ -62,24 +132,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "products"
+                    "exchange-rates"
                 ],
-                "summary": "Get a product by ID",
+                "summary": "Load exchange rates",
                 "parameters": [
                     {
                         "type": "string",
-                        "example": "MLB001",
-                        "description": "Product ID",
-                        "name": "id",
-                        "in": "path",
+                        "description": "Administrator token",
+                        "name": "X-Admin-Token",
+                        "in": "header",
                         "required": true
+                    },
+                    {
+                        "description": "Rates by currency code",
+                        "name": "rates",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoadExchangeRatesInputDTO"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ProductResponse"
+                            "$ref": "#/definitions/dto.ExchangeRatesResponse"
                         }
                     },
                     "400": {
@@ -88,8 +166,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/errors.ErrorResponse"
                         }
@@ -103,105 +181,3240 @@ const docTemplate = `{
                 }
             }
         },
-        "/health": {
+        "/api/v1/products": {
             "get": {
-                "description": "Returns the health status of the API",
+                "description": "Get a page of products with thumbnails, ordered by ID. Follow pagination.next_cursor to read the next page. Facets count every product matching the filters.\nFilter by attribute with attr.\u003cname\u003e=\u003cvalue\u003e, as in attr.brand=Apple\u0026attr.storage=256GB; every attribute must match, ignoring case, on the product or on one of its variations.\nDuring an active promotion, price is discounted and original_price, original_currency and discount_percent show the list price and the discount. Price filters and facets use the list price.\nPrice filters and price ranges compare prices in currency, or in USD without it, converting the other currencies through the exchange rates; a product priced in a currency without an exchange rate matches no price filter and is counted in no price range.\nOnly active listings are listed unless status asks for another one, which only administrators and a seller listing its own products, with seller_id and X-Seller-ID, may do.\nWith ids, returns those products with all their images instead, in the requested order, as a dto.ProductBatchResponse. Each ID not found, or not active and not of the seller in X-Seller-ID, is reported in errors; ids cannot be combined with pagination, filters or expand.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "health"
+                    "products"
+                ],
+                "summary": "List products",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "MLB001,MLB002",
+                        "description": "Comma-separated product IDs to get at once (at most 100)",
+                        "name": "ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Page size (1-100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from pagination.next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "active",
+                            "paused",
+                            "closed",
+                            "under_review",
+                            "scheduled"
+                        ],
+                        "type": "string",
+                        "default": "active",
+                        "description": "Listing status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "Electronics \u003e Smartphones",
+                        "description": "Category path; also matches its subcategories",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 6,
+                        "description": "Category ID; also matches its subcategories",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "new",
+                            "used",
+                            "refurbished"
+                        ],
+                        "type": "string",
+                        "description": "Product condition",
+                        "name": "condition",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "SELLER001",
+                        "description": "Seller ID",
+                        "name": "seller_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum price, inclusive, in currency or in USD without it",
+                        "name": "min_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum price, inclusive, in currency or in USD without it",
+                        "name": "max_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only products with (true) or without (false) stock",
+                        "name": "in_stock",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "id,title,price",
+                        "description": "Comma-separated product fields to return",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "images,seller",
+                        "description": "Comma-separated related data to embed in each product: images, seller",
+                        "name": "expand",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "BRL",
+                        "description": "Convert prices to this currency, which must have an exchange rate",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "number",
+                            "money"
+                        ],
+                        "type": "string",
+                        "default": "number",
+                        "description": "Also render prices exactly, in minor units, as price_money",
+                        "name": "price_format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include soft deleted products (administrators only)",
+                        "name": "include_deleted",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Administrator token",
+                        "name": "X-Admin-Token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "example": "SELLER001",
+                        "description": "ID of the calling seller, who may list its own listings in any status",
+                        "name": "X-Seller-ID",
+                        "in": "header"
+                    }
                 ],
-                "summary": "Health check endpoint",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.HealthResponse"
+                            "$ref": "#/definitions/dto.ProductListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a product together with its images. The ID is generated when omitted. Attributes must be defined by the category or one of its ancestors, and the required ones must be present. A product with variations is priced at its cheapest variation and has the stock of all of them.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Create a product",
+                "parameters": [
+                    {
+                        "description": "Product to create",
+                        "name": "product",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateProductInputDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProductResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Product version"
+                            },
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the created product"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "dto.ProductDTO": {
+        },
+        "/api/v1/products/search": {
+            "get": {
+                "description": "Full-text search over title, description and category, best matches first. Matched terms are wrapped in \u003cem\u003e in the highlight of each result. Accepts the same filters as the product list, status included, and returns the same facets, counted over every match. Price filters and price ranges compare prices in currency, or in USD without it, as in the product list.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Search products",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "iphone pro",
+                        "description": "Search text; the last word also matches as a prefix",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Page size (1-100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from pagination.next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "active",
+                            "paused",
+                            "closed",
+                            "under_review",
+                            "scheduled"
+                        ],
+                        "type": "string",
+                        "default": "active",
+                        "description": "Listing status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category path; also matches its subcategories",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Category ID; also matches its subcategories",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "new",
+                            "used",
+                            "refurbished"
+                        ],
+                        "type": "string",
+                        "description": "Product condition",
+                        "name": "condition",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Seller ID",
+                        "name": "seller_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum price, inclusive, in currency or in USD without it",
+                        "name": "min_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum price, inclusive, in currency or in USD without it",
+                        "name": "max_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only products with (true) or without (false) stock",
+                        "name": "in_stock",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "id,title,price",
+                        "description": "Comma-separated product fields to return",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "images,seller",
+                        "description": "Comma-separated related data to embed in each product: images, seller",
+                        "name": "expand",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "BRL",
+                        "description": "Convert prices to this currency, which must have an exchange rate",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "number",
+                            "money"
+                        ],
+                        "type": "string",
+                        "default": "number",
+                        "description": "Also render prices exactly, in minor units, as price_money",
+                        "name": "price_format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Administrator token",
+                        "name": "X-Admin-Token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "example": "SELLER001",
+                        "description": "ID of the calling seller, who may search its own listings in any status",
+                        "name": "X-Seller-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProductListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "501": {
+                        "description": "Not Implemented",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/products/{id}": {
+            "get": {
+                "description": "Get product details by product ID including all images, the variations, the seller and the category breadcrumbs. During an active promotion, price is discounted and original_price, original_currency and discount_percent show the list price and the discount. With expand=questions, the latest answered questions are embedded too. A listing that is not active is only returned to administrators and to its seller, identified by X-Seller-ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Get a product by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "MLB001",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "id,title,price",
+                        "description": "Comma-separated product fields to return",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "questions",
+                        "description": "Comma-separated related data to embed: questions (images and seller are always embedded)",
+                        "name": "expand",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "BRL",
+                        "description": "Convert prices to this currency, which must have an exchange rate",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "number",
+                            "money"
+                        ],
+                        "type": "string",
+                        "default": "number",
+                        "description": "Also render prices exactly, in minor units, as price_money",
+                        "name": "price_format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return the product even if soft deleted (administrators only)",
+                        "name": "include_deleted",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Administrator token",
+                        "name": "X-Admin-Token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "example": "SELLER001",
+                        "description": "ID of the calling seller, who may get its own listings in any status",
+                        "name": "X-Seller-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProductResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Product version, to be sent back in If-Match on updates"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace every writable field of a product, including its images and variations. Requires the ETag from a previous read in If-Match.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Replace a product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "MLB001",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the product version being replaced",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "New product representation",
+                        "name": "product",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProductFieldsDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProductResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New product version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Soft delete a product. It disappears from reads but can be restored by an administrator until it is purged.",
+                "tags": [
+                    "products"
+                ],
+                "summary": "Delete a product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "MLB001",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Apply a JSON merge patch (RFC 7396) to a product. Requires the ETag from a previous read in If-Match.",
+                "consumes": [
+                    "application/json",
+                    "application/merge-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Partially update a product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "MLB001",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the product version being patched",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "JSON merge patch document",
+                        "name": "patch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProductResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New product version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/products/{id}/installments": {
+            "get": {
+                "description": "Split the price of a product, discounted by its active promotion, by every installment plan of its currency, by ascending number of installments. Interest-free options add up to the price; the first installment carries the cents the split leaves over. Options with interest charge a monthly rate and report the total paid. Plans whose installments would be below their minimum are left out. A listing that is not active is only returned to administrators and to its seller, identified by X-Seller-ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "installments"
+                ],
+                "summary": "List the installment options of a product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "MLB001",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "BRL",
+                        "description": "Convert the price to this currency, which must have an exchange rate, before splitting it",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Administrator token",
+                        "name": "X-Admin-Token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "example": "SELLER001",
+                        "description": "ID of the calling seller, who may get its own listings in any status",
+                        "name": "X-Seller-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProductInstallmentsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/products/{id}/questions": {
+            "get": {
+                "description": "Get a page of the questions of a product, newest first. Without status, unanswered and answered questions are listed; hidden questions are only listed for administrators. Follow pagination.next_cursor to read the next page. The questions of a listing that is not active are only returned to administrators and to its seller, identified by X-Seller-ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "questions"
+                ],
+                "summary": "List the questions of a product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "MLB001",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "unanswered",
+                            "answered",
+                            "hidden"
+                        ],
+                        "type": "string",
+                        "description": "Only questions in this state",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Page size (1-100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from pagination.next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Administrator token, required for status=hidden",
+                        "name": "X-Admin-Token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "example": "SELLER001",
+                        "description": "ID of the calling seller, who may get its own listings in any status",
+                        "name": "X-Seller-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.QuestionListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Post a question on a product page. It stays unanswered until the seller of the product answers it. A listing that is not active only takes questions from administrators and from its seller, identified by X-Seller-ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "questions"
+                ],
+                "summary": "Ask a question about a product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "MLB001",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Question to ask",
+                        "name": "question",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateQuestionInputDTO"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Administrator token",
+                        "name": "X-Admin-Token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "example": "SELLER001",
+                        "description": "ID of the calling seller, who may get its own listings in any status",
+                        "name": "X-Seller-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.QuestionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/products/{id}/questions/{question_id}/answer": {
+            "post": {
+                "description": "Answer an unanswered question as the seller of the product, identified by X-Seller-ID. A question is answered only once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "questions"
+                ],
+                "summary": "Answer a question",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "MLB001",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 3,
+                        "description": "Question ID",
+                        "name": "question_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "SELLER001",
+                        "description": "ID of the seller of the product",
+                        "name": "X-Seller-ID",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Answer",
+                        "name": "answer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.AnswerQuestionInputDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.QuestionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/products/{id}/questions/{question_id}/hide": {
+            "post": {
+                "description": "Hide a question from the public listings, as the seller of the product identified by X-Seller-ID or as an administrator.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "questions"
+                ],
+                "summary": "Hide a question",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "MLB001",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 3,
+                        "description": "Question ID",
+                        "name": "question_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "SELLER001",
+                        "description": "ID of the seller of the product",
+                        "name": "X-Seller-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Administrator token",
+                        "name": "X-Admin-Token",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.QuestionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/products/{id}/restore": {
+            "post": {
+                "description": "Undo the soft delete of a product that has not been purged yet. Administrators only.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Restore a deleted product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "MLB001",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Administrator token",
+                        "name": "X-Admin-Token",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProductResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Product version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/products/{id}/reviews": {
+            "get": {
+                "description": "Get a page of the reviews of a product, newest first. Follow pagination.next_cursor to read the next page. The reviews of a listing that is not active are only returned to administrators and to its seller, identified by X-Seller-ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "List the reviews of a product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "MLB001",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Page size (1-100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from pagination.next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Administrator token",
+                        "name": "X-Admin-Token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "example": "SELLER001",
+                        "description": "ID of the calling seller, who may get its own listings in any status",
+                        "name": "X-Seller-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReviewListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Rate a product from 1 to 5 stars, with an optional title and comment. The rating is added to the rating of the product at once. A listing that is not active is only reviewed by administrators and by its seller, identified by X-Seller-ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "Review a product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "MLB001",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Review to create",
+                        "name": "review",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateReviewInputDTO"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Administrator token",
+                        "name": "X-Admin-Token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "example": "SELLER001",
+                        "description": "ID of the calling seller, who may get its own listings in any status",
+                        "name": "X-Seller-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/products/{id}/shipping": {
+            "get": {
+                "description": "Calculate the cost of shipping a product to a zip code from the rate of its route, by the weight of its package, and estimate the delivery in business days from today, skipping weekends and holidays. A product with free shipping costs nothing to ship. A listing that is not active is only quoted to administrators and to its seller, identified by X-Seller-ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shipping"
+                ],
+                "summary": "Quote the shipping of a product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "MLB001",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "20040-002",
+                        "description": "Destination zip code, as 8 digits or as 01310-100",
+                        "name": "zip",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Administrator token",
+                        "name": "X-Admin-Token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "example": "SELLER001",
+                        "description": "ID of the calling seller, who may get its own listings in any status",
+                        "name": "X-Seller-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ShippingQuoteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/products/{id}/status": {
+            "post": {
+                "description": "Move a listing to active, paused, closed or under_review, as the seller of the product identified by X-Seller-ID or as an administrator. Only administrators put a listing under review or take it out of review, a closed listing cannot change status and a listing without stock cannot be activated. Changing to the current status does nothing. Every change is recorded in the status history.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Change the status of a listing",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "MLB001",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "SELLER001",
+                        "description": "ID of the seller of the product",
+                        "name": "X-Seller-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Administrator token",
+                        "name": "X-Admin-Token",
+                        "in": "header"
+                    },
+                    {
+                        "description": "New status",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ChangeProductStatusInputDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProductResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Product version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/products/{id}/status-changes": {
+            "get": {
+                "description": "Get every status change of a listing, oldest first, with who made it and when: an administrator, the seller or the system, which pauses listings that run out of stock. Only the seller of the product identified by X-Seller-ID and administrators may read it.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "List the status history of a listing",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "MLB001",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "SELLER001",
+                        "description": "ID of the seller of the product",
+                        "name": "X-Seller-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Administrator token",
+                        "name": "X-Admin-Token",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StatusChangeListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/promotions": {
+            "get": {
+                "description": "Get every promotion, past and scheduled ones included, ordered by ID. active=true keeps the ones active now.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "promotions"
+                ],
+                "summary": "List promotions",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "example": true,
+                        "description": "Only the promotions active now",
+                        "name": "active",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PromotionListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Discount a product, or every product of a category and its subcategories, by a percentage or a fixed amount from starts_at until just before ends_at. A fixed amount only discounts prices in its currency. When several promotions are active for a product the highest priority wins, then a product promotion over a category one and a subcategory over its parent, then the oldest one. Promotions for the same product or category with the same priority cannot overlap. Administrators only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "promotions"
+                ],
+                "summary": "Create a promotion",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Administrator token",
+                        "name": "X-Admin-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Promotion",
+                        "name": "promotion",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreatePromotionInputDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PromotionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/promotions/{id}": {
+            "delete": {
+                "description": "Delete a promotion for good, past, active or scheduled. The prices it discounted go back to their list price. Administrators only.",
+                "tags": [
+                    "promotions"
+                ],
+                "summary": "Delete a promotion",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "example": 1,
+                        "description": "Promotion ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Administrator token",
+                        "name": "X-Admin-Token",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/sellers/{id}": {
+            "get": {
+                "description": "Get a seller with the number of products it has listed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sellers"
+                ],
+                "summary": "Get a seller by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "SELLER001",
+                        "description": "Seller ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SellerResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/sellers/{id}/products": {
+            "get": {
+                "description": "Get a paginated list of the products of one seller, ordered by ID. Accepts the same parameters as the product list except seller_id and ids. The seller, identified by X-Seller-ID, and administrators may list its listings in any status.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sellers"
+                ],
+                "summary": "List the products of a seller",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "SELLER001",
+                        "description": "Seller ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Page size (1-100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from pagination.next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "active",
+                            "paused",
+                            "closed",
+                            "under_review",
+                            "scheduled"
+                        ],
+                        "type": "string",
+                        "default": "active",
+                        "description": "Listing status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "Electronics \u003e Smartphones",
+                        "description": "Category path; also matches its subcategories",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 6,
+                        "description": "Category ID; also matches its subcategories",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "new",
+                            "used",
+                            "refurbished"
+                        ],
+                        "type": "string",
+                        "description": "Product condition",
+                        "name": "condition",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum price, inclusive, in currency or in USD without it",
+                        "name": "min_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum price, inclusive, in currency or in USD without it",
+                        "name": "max_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only products with (true) or without (false) stock",
+                        "name": "in_stock",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "id,title,price",
+                        "description": "Comma-separated product fields to return",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "images,seller",
+                        "description": "Comma-separated related data to embed in each product: images, seller",
+                        "name": "expand",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "BRL",
+                        "description": "Convert prices to this currency, which must have an exchange rate",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "number",
+                            "money"
+                        ],
+                        "type": "string",
+                        "default": "number",
+                        "description": "Also render prices exactly, in minor units, as price_money",
+                        "name": "price_format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include soft deleted products (administrators only)",
+                        "name": "include_deleted",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Administrator token",
+                        "name": "X-Admin-Token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "example": "SELLER001",
+                        "description": "ID of the seller, who may list its own listings in any status",
+                        "name": "X-Seller-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProductListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "Returns the health status of the API",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Health check endpoint",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.HealthResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.AnswerQuestionInputDTO": {
+            "type": "object",
+            "properties": {
+                "answer": {
+                    "type": "string",
+                    "example": "No, only the USB-C cable is included."
+                }
+            }
+        },
+        "dto.CategoryAttributeDTO": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "storage"
+                },
+                "required": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "type": {
+                    "type": "string",
+                    "example": "string"
+                }
+            }
+        },
+        "dto.CategoryDTO": {
+            "type": "object",
+            "properties": {
+                "attributes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CategoryAttributeDTO"
+                    }
+                },
+                "breadcrumbs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CategoryRefDTO"
+                    }
+                },
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CategoryRefDTO"
+                    }
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Smartphones"
+                },
+                "parent_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "path": {
+                    "type": "string",
+                    "example": "Electronics \u003e Smartphones"
+                },
+                "product_count": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "dto.CategoryFacetDTO": {
+            "type": "object",
+            "properties": {
+                "buckets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FacetBucketDTO"
+                    }
+                },
+                "level": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "dto.CategoryNodeDTO": {
+            "type": "object",
+            "properties": {
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CategoryNodeDTO"
+                    }
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Electronics"
+                },
+                "path": {
+                    "type": "string",
+                    "example": "Electronics"
+                },
+                "product_count": {
+                    "type": "integer",
+                    "example": 4
+                }
+            }
+        },
+        "dto.CategoryRefDTO": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Smartphones"
+                }
+            }
+        },
+        "dto.CategoryResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.CategoryDTO"
+                }
+            }
+        },
+        "dto.CategoryTreeResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CategoryNodeDTO"
+                    }
+                }
+            }
+        },
+        "dto.ChangeProductStatusInputDTO": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "example": "Seller on vacation"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "paused"
+                }
+            }
+        },
+        "dto.CreateProductInputDTO": {
+            "type": "object",
+            "properties": {
+                "attributes": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "brand": "Apple",
+                        "storage": "256GB"
+                    }
+                },
+                "category": {
+                    "type": "string",
+                    "example": "Electronics \u003e Smartphones"
+                },
+                "condition": {
+                    "type": "string",
+                    "example": "new"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Latest Apple flagship smartphone with A17 Pro chip"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2024-12-31T23:59:59Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "MLB006"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "https://images.unsplash.com/photo-1696446702230-a8ff49103cd1?w=800"
+                    ]
+                },
+                "price": {
+                    "type": "number",
+                    "example": 1299.99
+                },
+                "publish_at": {
+                    "type": "string",
+                    "example": "2024-12-01T00:00:00Z"
+                },
+                "seller_id": {
+                    "type": "string",
+                    "example": "SELLER001"
+                },
+                "seller_name": {
+                    "type": "string",
+                    "example": "TechWorld Store"
+                },
+                "shipping": {
+                    "$ref": "#/definitions/dto.ShippingDTO"
+                },
+                "stock": {
+                    "type": "integer",
+                    "example": 45
+                },
+                "title": {
+                    "type": "string",
+                    "example": "iPhone 15 Pro Max 256GB - Titanium Blue"
+                },
+                "variations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.VariationFieldsDTO"
+                    }
+                }
+            }
+        },
+        "dto.CreatePromotionInputDTO": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "ends_at": {
+                    "type": "string",
+                    "example": "2024-12-02T00:00:00Z"
+                },
+                "priority": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "product_id": {
+                    "type": "string",
+                    "example": "MLB001"
+                },
+                "starts_at": {
+                    "type": "string",
+                    "example": "2024-11-29T00:00:00Z"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "percentage"
+                },
+                "value": {
+                    "type": "number",
+                    "example": 15
+                }
+            }
+        },
+        "dto.CreateQuestionInputDTO": {
+            "type": "object",
+            "properties": {
+                "text": {
+                    "type": "string",
+                    "example": "Does it come with a power adapter?"
+                }
+            }
+        },
+        "dto.CreateReviewInputDTO": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "example": "Battery lasts the whole day and the camera is outstanding."
+                },
+                "rating": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Best iPhone so far"
+                }
+            }
+        },
+        "dto.ExchangeRateDTO": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "rate": {
+                    "type": "number",
+                    "example": 4.92
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-15T00:00:00Z"
+                }
+            }
+        },
+        "dto.ExchangeRatesDTO": {
+            "type": "object",
+            "properties": {
+                "base": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "rates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ExchangeRateDTO"
+                    }
+                }
+            }
+        },
+        "dto.ExchangeRatesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.ExchangeRatesDTO"
+                }
+            }
+        },
+        "dto.FacetBucketDTO": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "label": {
+                    "type": "string",
+                    "example": "Smartphones"
+                },
+                "value": {
+                    "type": "string",
+                    "example": "Electronics \u003e Smartphones"
+                }
+            }
+        },
+        "dto.InstallmentDTO": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "example": 108.33
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "first_amount": {
+                    "type": "number",
+                    "example": 108.36
+                },
+                "interest_free": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "monthly_interest_rate": {
+                    "type": "number",
+                    "example": 0
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "total": {
+                    "type": "number",
+                    "example": 1299.99
+                }
+            }
+        },
+        "dto.LoadExchangeRatesInputDTO": {
+            "type": "object",
+            "properties": {
+                "rates": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number",
+                        "format": "float64"
+                    }
+                }
+            }
+        },
+        "dto.MoneyDTO": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "example": 129999
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "decimals": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "dto.PaginationDTO": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "next_cursor": {
+                    "type": "string",
+                    "example": "eyJhZnRlcl9pZCI6Ik1MQjAyMCJ9"
+                }
+            }
+        },
+        "dto.PriceRangeBucketDTO": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "max": {
+                    "type": "number",
+                    "example": 250
+                },
+                "min": {
+                    "type": "number",
+                    "example": 100
+                }
+            }
+        },
+        "dto.ProductDTO": {
+            "type": "object",
+            "properties": {
+                "attributes": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "brand": "Apple",
+                        "storage": "256GB"
+                    }
+                },
+                "breadcrumbs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CategoryRefDTO"
+                    }
+                },
+                "category": {
+                    "type": "string",
+                    "example": "Electronics \u003e Smartphones"
+                },
+                "category_id": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "condition": {
+                    "type": "string",
+                    "example": "new"
+                },
+                "converted": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "deleted_at": {
+                    "type": "string",
+                    "example": "2024-02-01T00:00:00Z"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Latest Apple flagship smartphone with A17 Pro chip"
+                },
+                "discount_percent": {
+                    "type": "number",
+                    "example": 15
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2024-12-31T23:59:59Z"
+                },
+                "highlight": {
+                    "$ref": "#/definitions/dto.ProductHighlightDTO"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "MLB001"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ProductImageDTO"
+                    }
+                },
+                "installments": {
+                    "$ref": "#/definitions/dto.InstallmentDTO"
+                },
+                "not_converted": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "original_currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "original_price": {
+                    "type": "number",
+                    "example": 1299.99
+                },
+                "original_price_money": {
+                    "$ref": "#/definitions/dto.MoneyDTO"
+                },
+                "price": {
+                    "type": "number",
+                    "example": 1299.99
+                },
+                "price_money": {
+                    "$ref": "#/definitions/dto.MoneyDTO"
+                },
+                "promotion_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "publish_at": {
+                    "type": "string",
+                    "example": "2024-12-01T00:00:00Z"
+                },
+                "questions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.QuestionDTO"
+                    }
+                },
+                "rating": {
+                    "$ref": "#/definitions/dto.ProductRatingDTO"
+                },
+                "seller": {
+                    "$ref": "#/definitions/dto.SellerDTO"
+                },
+                "seller_id": {
+                    "type": "string",
+                    "example": "SELLER001"
+                },
+                "seller_name": {
+                    "type": "string",
+                    "example": "TechWorld Store"
+                },
+                "seller_reputation": {
+                    "$ref": "#/definitions/dto.SellerReputationSummaryDTO"
+                },
+                "shipping": {
+                    "$ref": "#/definitions/dto.ShippingDTO"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "active"
+                },
+                "stock": {
+                    "type": "integer",
+                    "example": 45
+                },
+                "thumbnail": {
+                    "type": "string",
+                    "example": "https://images.unsplash.com/photo-1696446702230-a8ff49103cd1?w=800"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "iPhone 15 Pro Max 256GB - Titanium Blue"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "variations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.VariationDTO"
+                    }
+                }
+            }
+        },
+        "dto.ProductFacetsDTO": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "$ref": "#/definitions/dto.CategoryFacetDTO"
+                },
+                "condition": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FacetBucketDTO"
+                    }
+                },
+                "price_range": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PriceRangeBucketDTO"
+                    }
+                },
+                "seller": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FacetBucketDTO"
+                    }
+                }
+            }
+        },
+        "dto.ProductFieldsDTO": {
+            "type": "object",
+            "properties": {
+                "attributes": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "brand": "Apple",
+                        "storage": "256GB"
+                    }
+                },
+                "category": {
+                    "type": "string",
+                    "example": "Electronics \u003e Smartphones"
+                },
+                "condition": {
+                    "type": "string",
+                    "example": "new"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Latest Apple flagship smartphone with A17 Pro chip"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2024-12-31T23:59:59Z"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "https://images.unsplash.com/photo-1696446702230-a8ff49103cd1?w=800"
+                    ]
+                },
+                "price": {
+                    "type": "number",
+                    "example": 1299.99
+                },
+                "publish_at": {
+                    "type": "string",
+                    "example": "2024-12-01T00:00:00Z"
+                },
+                "seller_id": {
+                    "type": "string",
+                    "example": "SELLER001"
+                },
+                "seller_name": {
+                    "type": "string",
+                    "example": "TechWorld Store"
+                },
+                "shipping": {
+                    "$ref": "#/definitions/dto.ShippingDTO"
+                },
+                "stock": {
+                    "type": "integer",
+                    "example": 45
+                },
+                "title": {
+                    "type": "string",
+                    "example": "iPhone 15 Pro Max 256GB - Titanium Blue"
+                },
+                "variations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.VariationFieldsDTO"
+                    }
+                }
+            }
+        },
+        "dto.ProductHighlightDTO": {
+            "type": "object",
+            "properties": {
+                "snippet": {
+                    "type": "string",
+                    "example": "Latest Apple flagship smartphone…"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "\u003cem\u003eiPhone\u003c/em\u003e 15 Pro Max 256GB - Titanium Blue"
+                }
+            }
+        },
+        "dto.ProductImageDTO": {
+            "type": "object",
+            "properties": {
+                "display_order": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "image_url": {
+                    "type": "string",
+                    "example": "https://images.unsplash.com/photo-1696446702230-a8ff49103cd1?w=800"
+                },
+                "product_id": {
+                    "type": "string",
+                    "example": "MLB001"
+                }
+            }
+        },
+        "dto.ProductInstallmentsDTO": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "not_converted": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.InstallmentDTO"
+                    }
+                },
+                "price": {
+                    "type": "number",
+                    "example": 1299.99
+                },
+                "product_id": {
+                    "type": "string",
+                    "example": "MLB001"
+                }
+            }
+        },
+        "dto.ProductInstallmentsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.ProductInstallmentsDTO"
+                }
+            }
+        },
+        "dto.ProductListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ProductDTO"
+                    }
+                },
+                "facets": {
+                    "$ref": "#/definitions/dto.ProductFacetsDTO"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/dto.PaginationDTO"
+                }
+            }
+        },
+        "dto.ProductRatingDTO": {
+            "type": "object",
+            "properties": {
+                "average": {
+                    "type": "number",
+                    "example": 4.67
+                },
+                "count": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "stars": {
+                    "$ref": "#/definitions/dto.ProductRatingStarsDTO"
+                }
+            }
+        },
+        "dto.ProductRatingStarsDTO": {
+            "type": "object",
+            "properties": {
+                "1": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "2": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "3": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "4": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "5": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "dto.ProductResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.ProductDTO"
+                }
+            }
+        },
+        "dto.PromotionDTO": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "category_id": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-11-20T00:00:00Z"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "ends_at": {
+                    "type": "string",
+                    "example": "2024-12-02T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "priority": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "product_id": {
+                    "type": "string",
+                    "example": "MLB001"
+                },
+                "starts_at": {
+                    "type": "string",
+                    "example": "2024-11-29T00:00:00Z"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "percentage"
+                },
+                "value": {
+                    "type": "number",
+                    "example": 15
+                }
+            }
+        },
+        "dto.PromotionListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PromotionDTO"
+                    }
+                }
+            }
+        },
+        "dto.PromotionResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.PromotionDTO"
+                }
+            }
+        },
+        "dto.QuestionDTO": {
             "type": "object",
             "properties": {
-                "category": {
+                "answer": {
                     "type": "string",
-                    "example": "Electronics \u003e Smartphones"
+                    "example": "No, only the USB-C cable is included."
                 },
-                "condition": {
+                "answered_at": {
                     "type": "string",
-                    "example": "new"
+                    "example": "2024-01-08T16:40:00Z"
                 },
                 "created_at": {
                     "type": "string",
-                    "example": "2024-01-01T00:00:00Z"
+                    "example": "2024-01-08T14:20:00Z"
                 },
-                "currency": {
+                "id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "product_id": {
                     "type": "string",
-                    "example": "USD"
+                    "example": "MLB001"
                 },
-                "description": {
+                "status": {
                     "type": "string",
-                    "example": "Latest Apple flagship smartphone with A17 Pro chip"
+                    "example": "answered"
+                },
+                "text": {
+                    "type": "string",
+                    "example": "Does it come with a power adapter?"
+                }
+            }
+        },
+        "dto.QuestionListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.QuestionDTO"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/dto.PaginationDTO"
+                }
+            }
+        },
+        "dto.QuestionResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.QuestionDTO"
+                }
+            }
+        },
+        "dto.ReviewDTO": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "example": "Battery lasts the whole day and the camera is outstanding."
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-10T12:00:00Z"
                 },
                 "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "product_id": {
                     "type": "string",
                     "example": "MLB001"
                 },
-                "images": {
+                "rating": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Best iPhone so far"
+                }
+            }
+        },
+        "dto.ReviewListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.ProductImageDTO"
+                        "$ref": "#/definitions/dto.ReviewDTO"
                     }
                 },
-                "price": {
-                    "type": "number",
-                    "example": 1299.99
+                "pagination": {
+                    "$ref": "#/definitions/dto.PaginationDTO"
+                }
+            }
+        },
+        "dto.ReviewResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.ReviewDTO"
+                }
+            }
+        },
+        "dto.SellerDTO": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "SELLER001"
                 },
-                "seller_id": {
+                "name": {
+                    "type": "string",
+                    "example": "TechWorld Store"
+                }
+            }
+        },
+        "dto.SellerDetailDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "id": {
                     "type": "string",
                     "example": "SELLER001"
                 },
-                "seller_name": {
+                "name": {
                     "type": "string",
                     "example": "TechWorld Store"
                 },
-                "stock": {
+                "product_count": {
                     "type": "integer",
-                    "example": 45
+                    "example": 2
                 },
-                "thumbnail": {
+                "reputation": {
+                    "$ref": "#/definitions/dto.SellerReputationDTO"
+                },
+                "updated_at": {
                     "type": "string",
-                    "example": "https://images.unsplash.com/photo-1696446702230-a8ff49103cd1?w=800"
+                    "example": "2024-01-01T00:00:00Z"
+                }
+            }
+        },
+        "dto.SellerReputationDTO": {
+            "type": "object",
+            "properties": {
+                "cancellation_rate": {
+                    "type": "number",
+                    "example": 0.0164
                 },
-                "title": {
+                "cancelled_sales": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "completed_sales": {
+                    "type": "integer",
+                    "example": 60
+                },
+                "level": {
                     "type": "string",
-                    "example": "iPhone 15 Pro Max 256GB - Titanium Blue"
+                    "enum": [
+                        "green",
+                        "light_green",
+                        "yellow",
+                        "orange",
+                        "red",
+                        "none"
+                    ],
+                    "example": "green"
                 },
-                "updated_at": {
+                "power_seller": {
                     "type": "string",
-                    "example": "2024-01-01T00:00:00Z"
+                    "enum": [
+                        "platinum",
+                        "gold",
+                        "silver"
+                    ],
+                    "example": "gold"
+                },
+                "rating_average": {
+                    "type": "number",
+                    "example": 4.67
+                },
+                "review_count": {
+                    "type": "integer",
+                    "example": 3
                 }
             }
         },
-        "dto.ProductImageDTO": {
+        "dto.SellerReputationSummaryDTO": {
             "type": "object",
             "properties": {
-                "display_order": {
+                "completed_sales": {
                     "type": "integer",
-                    "example": 0
+                    "example": 60
                 },
-                "id": {
+                "level": {
+                    "type": "string",
+                    "enum": [
+                        "green",
+                        "light_green",
+                        "yellow",
+                        "orange",
+                        "red",
+                        "none"
+                    ],
+                    "example": "green"
+                },
+                "power_seller": {
+                    "type": "string",
+                    "enum": [
+                        "platinum",
+                        "gold",
+                        "silver"
+                    ],
+                    "example": "gold"
+                }
+            }
+        },
+        "dto.SellerResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.SellerDetailDTO"
+                }
+            }
+        },
+        "dto.ShippingDTO": {
+            "type": "object",
+            "properties": {
+                "free_shipping": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "height_cm": {
                     "type": "integer",
-                    "example": 1
+                    "example": 6
                 },
-                "image_url": {
+                "length_cm": {
+                    "type": "integer",
+                    "example": 18
+                },
+                "origin_zip": {
                     "type": "string",
-                    "example": "https://images.unsplash.com/photo-1696446702230-a8ff49103cd1?w=800"
+                    "example": "01310100"
+                },
+                "weight_grams": {
+                    "type": "integer",
+                    "example": 240
+                },
+                "width_cm": {
+                    "type": "integer",
+                    "example": 10
+                }
+            }
+        },
+        "dto.ShippingQuoteDTO": {
+            "type": "object",
+            "properties": {
+                "billable_weight_grams": {
+                    "type": "integer",
+                    "example": 240
+                },
+                "cost": {
+                    "type": "number",
+                    "example": 12.9
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "destination_zip": {
+                    "type": "string",
+                    "example": "20040002"
+                },
+                "estimated_delivery_from": {
+                    "type": "string",
+                    "example": "2024-01-04"
+                },
+                "estimated_delivery_to": {
+                    "type": "string",
+                    "example": "2024-01-08"
+                },
+                "free_shipping": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "max_days": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "min_days": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "origin_zip": {
+                    "type": "string",
+                    "example": "01310100"
                 },
                 "product_id": {
                     "type": "string",
@@ -209,22 +3422,130 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.ProductListResponse": {
+        "dto.ShippingQuoteResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.ShippingQuoteDTO"
+                }
+            }
+        },
+        "dto.StatusChangeDTO": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "type": "string",
+                    "example": "seller"
+                },
+                "actor_id": {
+                    "type": "string",
+                    "example": "SELLER001"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-03-01T12:00:00Z"
+                },
+                "from": {
+                    "type": "string",
+                    "example": "active"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "product_id": {
+                    "type": "string",
+                    "example": "MLB001"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Seller on vacation"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "paused"
+                }
+            }
+        },
+        "dto.StatusChangeListResponse": {
             "type": "object",
             "properties": {
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.ProductDTO"
+                        "$ref": "#/definitions/dto.StatusChangeDTO"
                     }
                 }
             }
         },
-        "dto.ProductResponse": {
+        "dto.VariationDTO": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/dto.ProductDTO"
+                "attributes": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "color": "Titanium Blue",
+                        "storage": "512GB"
+                    }
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "https://images.unsplash.com/photo-1696446702230-a8ff49103cd1?w=800"
+                    ]
+                },
+                "price": {
+                    "type": "number",
+                    "example": 1499.99
+                },
+                "price_money": {
+                    "$ref": "#/definitions/dto.MoneyDTO"
+                },
+                "stock": {
+                    "type": "integer",
+                    "example": 15
+                }
+            }
+        },
+        "dto.VariationFieldsDTO": {
+            "type": "object",
+            "properties": {
+                "attributes": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "color": "Titanium Blue",
+                        "storage": "512GB"
+                    }
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "https://images.unsplash.com/photo-1696446702230-a8ff49103cd1?w=800"
+                    ]
+                },
+                "price": {
+                    "type": "number",
+                    "example": 1499.99
+                },
+                "stock": {
+                    "type": "integer",
+                    "example": 15
                 }
             }
         },
@@ -235,6 +3556,12 @@ const docTemplate = `{
                     "type": "string",
                     "example": "PRODUCT_NOT_FOUND"
                 },
+                "details": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/errors.FieldError"
+                    }
+                },
                 "error": {
                     "type": "string",
                     "example": "product not found"
@@ -249,6 +3576,23 @@ const docTemplate = `{
                 }
             }
         },
+        "errors.FieldError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string",
+                    "example": "title"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "title is required"
+                },
+                "rule": {
+                    "type": "string",
+                    "example": "required"
+                }
+            }
+        },
         "handler.HealthResponse": {
             "type": "object",
             "properties": {
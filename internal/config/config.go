@@ -3,6 +3,8 @@ package config
 import (
 	"log"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -16,6 +18,32 @@ type Config struct {
 	APITimeout time.Duration
 	LogLevel   string
 	LogFormat  string
+
+	// DatabasePath is the SQLite database file; ":memory:" keeps the data in
+	// memory, lost on restart. DatabaseSeed loads the demo catalog into a new
+	// database.
+	DatabasePath        string
+	DatabaseWAL         bool
+	DatabaseBusyTimeout time.Duration
+	DatabaseForeignKeys bool
+	DatabaseSeed        bool
+
+	// AdminToken enables administrative operations when sent in X-Admin-Token.
+	// Empty disables them.
+	AdminToken string
+
+	// PurgeRetention is how long soft deleted products are kept before being
+	// purged, checked every PurgeInterval.
+	PurgeRetention time.Duration
+	PurgeInterval  time.Duration
+
+	// ListingScheduleInterval is how often listings are published and
+	// closed by their publish_at and expires_at.
+	ListingScheduleInterval time.Duration
+
+	// ShippingHolidays are the dates, besides weekends, on which carriers do
+	// not deliver.
+	ShippingHolidays []time.Time
 }
 
 func Load() *Config {
@@ -31,6 +59,21 @@ func Load() *Config {
 		APITimeout: getEnvAsDuration("API_TIMEOUT", 30*time.Second),
 		LogLevel:   getEnv("LOG_LEVEL", "info"),
 		LogFormat:  getEnv("LOG_FORMAT", "text"),
+
+		DatabasePath:        getEnv("DB_PATH", ":memory:"),
+		DatabaseWAL:         getEnvAsBool("DB_WAL", true),
+		DatabaseBusyTimeout: getEnvAsDuration("DB_BUSY_TIMEOUT", 5*time.Second),
+		DatabaseForeignKeys: getEnvAsBool("DB_FOREIGN_KEYS", true),
+		DatabaseSeed:        getEnvAsBool("DB_SEED", true),
+
+		AdminToken: getEnv("ADMIN_TOKEN", ""),
+
+		PurgeRetention: getEnvAsDuration("PURGE_RETENTION", 30*24*time.Hour),
+		PurgeInterval:  getEnvAsPositiveDuration("PURGE_INTERVAL", time.Hour),
+
+		ListingScheduleInterval: getEnvAsPositiveDuration("LISTING_SCHEDULE_INTERVAL", time.Minute),
+
+		ShippingHolidays: getEnvAsDates("SHIPPING_HOLIDAYS"),
 	}
 }
 
@@ -48,3 +91,41 @@ func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
 	}
 	return defaultValue
 }
+
+// getEnvAsPositiveDuration is getEnvAsDuration for intervals, which must be
+// positive; zero or a negative value falls back to the default.
+func getEnvAsPositiveDuration(key string, defaultValue time.Duration) time.Duration {
+	value := getEnvAsDuration(key, defaultValue)
+	if value <= 0 {
+		log.Printf("ignoring non-positive duration %s in %s, using %s", value, key, defaultValue)
+		return defaultValue
+	}
+	return value
+}
+
+func getEnvAsBool(key string, defaultValue bool) bool {
+	valueStr := getEnv(key, "")
+	if value, err := strconv.ParseBool(valueStr); err == nil {
+		return value
+	}
+	return defaultValue
+}
+
+// getEnvAsDates reads a comma-separated list of dates such as
+// 2024-12-25,2025-01-01, skipping the ones that do not parse.
+func getEnvAsDates(key string) []time.Time {
+	var dates []time.Time
+	for _, value := range strings.Split(getEnv(key, ""), ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		date, err := time.Parse(time.DateOnly, value)
+		if err != nil {
+			log.Printf("ignoring invalid date %q in %s", value, key)
+			continue
+		}
+		dates = append(dates, date)
+	}
+	return dates
+}
@@ -0,0 +1,52 @@
+package dto
+
+// CategoryRefDTO names a category, as used in breadcrumbs and child lists.
+type CategoryRefDTO struct {
+	ID   int64  `json:"id" example:"6"`
+	Name string `json:"name" example:"Smartphones"`
+}
+
+// CategoryNodeDTO is a category of the tree with all its subcategories.
+// ProductCount includes the products of the subcategories and, like every
+// product count, only counts active listings.
+type CategoryNodeDTO struct {
+	ID           int64             `json:"id" example:"1"`
+	Name         string            `json:"name" example:"Electronics"`
+	Path         string            `json:"path" example:"Electronics"`
+	ProductCount int               `json:"product_count" example:"4"`
+	Children     []CategoryNodeDTO `json:"children"`
+}
+
+// CategoryDTO details one category. Breadcrumbs run from the root to the
+// category itself. Attributes are the attributes its products may have,
+// including those defined by its ancestors.
+type CategoryDTO struct {
+	ID           int64                  `json:"id" example:"6"`
+	Name         string                 `json:"name" example:"Smartphones"`
+	Path         string                 `json:"path" example:"Electronics > Smartphones"`
+	ParentID     *int64                 `json:"parent_id,omitempty" example:"1"`
+	ProductCount int                    `json:"product_count" example:"1"`
+	Breadcrumbs  []CategoryRefDTO       `json:"breadcrumbs"`
+	Children     []CategoryRefDTO       `json:"children"`
+	Attributes   []CategoryAttributeDTO `json:"attributes"`
+}
+
+// CategoryAttributeDTO defines an attribute of the products of a category.
+// Type is string, number or boolean.
+type CategoryAttributeDTO struct {
+	Name     string `json:"name" example:"storage"`
+	Type     string `json:"type" example:"string"`
+	Required bool   `json:"required" example:"true"`
+}
+
+type CategoryTreeResponse struct {
+	Data []CategoryNodeDTO `json:"data"`
+}
+
+type CategoryResponse struct {
+	Data CategoryDTO `json:"data"`
+}
+
+type CategoryInputDTO struct {
+	ID int64 `json:"id"`
+}
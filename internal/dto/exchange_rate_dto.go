@@ -0,0 +1,25 @@
+package dto
+
+import "time"
+
+// LoadExchangeRatesInputDTO maps currency codes to how many units of each one
+// unit of the base currency buys. Currencies left out keep their rate.
+type LoadExchangeRatesInputDTO struct {
+	Rates map[string]float64 `json:"rates"`
+}
+
+type ExchangeRateDTO struct {
+	Currency  string    `json:"currency" example:"BRL"`
+	Rate      float64   `json:"rate" example:"4.92"`
+	UpdatedAt time.Time `json:"updated_at" example:"2024-01-15T00:00:00Z"`
+}
+
+// ExchangeRatesDTO lists every rate, quoted against Base.
+type ExchangeRatesDTO struct {
+	Base  string            `json:"base" example:"USD"`
+	Rates []ExchangeRateDTO `json:"rates"`
+}
+
+type ExchangeRatesResponse struct {
+	Data ExchangeRatesDTO `json:"data"`
+}
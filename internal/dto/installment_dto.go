@@ -0,0 +1,40 @@
+package dto
+
+// InstallmentDTO is a price split into Quantity monthly installments of
+// Amount. Only the first one, of FirstAmount, differs: it carries the cents
+// the split leaves over. Total is what the buyer pays, the price itself when
+// InterestFree, and MonthlyInterestRate is a percentage.
+type InstallmentDTO struct {
+	Quantity            int     `json:"quantity" example:"12"`
+	Amount              float64 `json:"amount" example:"108.33"`
+	FirstAmount         float64 `json:"first_amount" example:"108.36"`
+	Total               float64 `json:"total" example:"1299.99"`
+	Currency            string  `json:"currency" example:"USD"`
+	InterestFree        bool    `json:"interest_free" example:"true"`
+	MonthlyInterestRate float64 `json:"monthly_interest_rate" example:"0"`
+}
+
+// ProductInstallmentsInputDTO asks how a product can be paid in
+// installments. A non-empty Currency converts its price first. A listing that
+// is not active is only shown to administrators and to its seller, SellerID.
+type ProductInstallmentsInputDTO struct {
+	ProductID string `json:"product_id"`
+	Currency  string `json:"currency,omitempty"`
+	SellerID  string `json:"seller_id,omitempty"`
+	IsAdmin   bool   `json:"is_admin,omitempty"`
+}
+
+// ProductInstallmentsDTO lists every installment option of the price of a
+// product, by ascending number of installments. NotConverted marks a price
+// left in its own currency because it has no exchange rate.
+type ProductInstallmentsDTO struct {
+	ProductID    string           `json:"product_id" example:"MLB001"`
+	Price        float64          `json:"price" example:"1299.99"`
+	Currency     string           `json:"currency" example:"USD"`
+	NotConverted bool             `json:"not_converted,omitempty" example:"false"`
+	Options      []InstallmentDTO `json:"options"`
+}
+
+type ProductInstallmentsResponse struct {
+	Data ProductInstallmentsDTO `json:"data"`
+}
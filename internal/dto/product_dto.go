@@ -4,8 +4,139 @@ import (
 	"time"
 )
 
+// Price formats. Prices are always rendered as JSON numbers; PriceFormatMoney
+// also renders them exactly, in minor units, as PriceMoney.
+const (
+	PriceFormatNumber = "number"
+	PriceFormatMoney  = "money"
+)
+
+// ProductInputDTO selects a single product. A non-empty Currency converts its
+// price and PriceFormat selects how prices are rendered, as in every product
+// input below. A listing that is not active is only returned to
+// administrators and to its seller, SellerID.
 type ProductInputDTO struct {
-	ID string `json:"id"`
+	ID             string           `json:"id"`
+	IncludeDeleted bool             `json:"include_deleted,omitempty"`
+	Expand         ProductExpandDTO `json:"expand,omitempty"`
+	Currency       string           `json:"currency,omitempty"`
+	PriceFormat    string           `json:"price_format,omitempty"`
+	SellerID       string           `json:"seller_id,omitempty"`
+	IsAdmin        bool             `json:"is_admin,omitempty"`
+}
+
+// BatchGetProductsInputDTO selects several products by ID at once. Listings
+// that are not active are reported as not found, except to administrators
+// and to their seller, SellerID.
+type BatchGetProductsInputDTO struct {
+	IDs            []string `json:"ids"`
+	IncludeDeleted bool     `json:"include_deleted,omitempty"`
+	Currency       string   `json:"currency,omitempty"`
+	PriceFormat    string   `json:"price_format,omitempty"`
+	SellerID       string   `json:"seller_id,omitempty"`
+	IsAdmin        bool     `json:"is_admin,omitempty"`
+}
+
+// ProductFiltersDTO narrows a product listing. Empty and nil fields are not
+// applied, except Status: without it only active listings are listed.
+// Attributes keeps the products having every attribute with the given value.
+type ProductFiltersDTO struct {
+	Status     string            `json:"status,omitempty"`
+	Category   string            `json:"category,omitempty"`
+	CategoryID *int64            `json:"category_id,omitempty"`
+	Condition  string            `json:"condition,omitempty"`
+	SellerID   string            `json:"seller_id,omitempty"`
+	MinPrice   *float64          `json:"min_price,omitempty"`
+	MaxPrice   *float64          `json:"max_price,omitempty"`
+	InStock    *bool             `json:"in_stock,omitempty"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// ListProductInputDTO selects a page of products. Limit zero means the
+// default page size and an empty Cursor starts from the first page.
+type ListProductInputDTO struct {
+	IncludeDeleted bool             `json:"include_deleted,omitempty"`
+	Limit          int              `json:"limit,omitempty"`
+	Cursor         string           `json:"cursor,omitempty"`
+	Expand         ProductExpandDTO `json:"expand,omitempty"`
+	Currency       string           `json:"currency,omitempty"`
+	PriceFormat    string           `json:"price_format,omitempty"`
+	ProductFiltersDTO
+}
+
+// ProductExpandDTO selects the related data embedded in each listed product.
+// Questions is only available when getting a single product, which always
+// embeds its images and seller.
+type ProductExpandDTO struct {
+	Images    bool `json:"images,omitempty"`
+	Seller    bool `json:"seller,omitempty"`
+	Questions bool `json:"questions,omitempty"`
+}
+
+// ProductSearchInputDTO selects a page of full-text search results.
+type ProductSearchInputDTO struct {
+	Query       string           `json:"q"`
+	Limit       int              `json:"limit,omitempty"`
+	Cursor      string           `json:"cursor,omitempty"`
+	Expand      ProductExpandDTO `json:"expand,omitempty"`
+	Currency    string           `json:"currency,omitempty"`
+	PriceFormat string           `json:"price_format,omitempty"`
+	ProductFiltersDTO
+}
+
+// ProductFieldsDTO holds the writable product fields shared by create and update requests.
+// SellerName registers SellerID as a new seller and is ignored for a seller
+// that already exists, whose stored name is kept. Attributes must be defined
+// by the category. A product with Variations takes the price of the cheapest
+// one and the stock of all of them instead of Price and Stock. A future
+// PublishAt schedules the listing, which goes live at that time, and the
+// listing closes at ExpiresAt.
+type ProductFieldsDTO struct {
+	Title       string               `json:"title" example:"iPhone 15 Pro Max 256GB - Titanium Blue"`
+	Description string               `json:"description,omitempty" example:"Latest Apple flagship smartphone with A17 Pro chip"`
+	Price       float64              `json:"price" example:"1299.99"`
+	Currency    string               `json:"currency" example:"USD"`
+	Condition   string               `json:"condition" example:"new"`
+	Stock       int                  `json:"stock" example:"45"`
+	SellerID    string               `json:"seller_id" example:"SELLER001"`
+	SellerName  string               `json:"seller_name,omitempty" example:"TechWorld Store"`
+	Category    string               `json:"category,omitempty" example:"Electronics > Smartphones"`
+	Attributes  map[string]string    `json:"attributes,omitempty" example:"brand:Apple,storage:256GB"`
+	Images      []string             `json:"images,omitempty" example:"https://images.unsplash.com/photo-1696446702230-a8ff49103cd1?w=800"`
+	Variations  []VariationFieldsDTO `json:"variations,omitempty"`
+	Shipping    *ShippingDTO         `json:"shipping,omitempty"`
+	PublishAt   *time.Time           `json:"publish_at,omitempty" example:"2024-12-01T00:00:00Z"`
+	ExpiresAt   *time.Time           `json:"expires_at,omitempty" example:"2024-12-31T23:59:59Z"`
+}
+
+// VariationFieldsDTO holds the writable fields of a variation of a product.
+// Its price is in the currency of the product and its attributes are the
+// ones that tell it apart from the other variations.
+type VariationFieldsDTO struct {
+	Attributes map[string]string `json:"attributes" example:"color:Titanium Blue,storage:512GB"`
+	Price      float64           `json:"price" example:"1499.99"`
+	Stock      int               `json:"stock" example:"15"`
+	Images     []string          `json:"images,omitempty" example:"https://images.unsplash.com/photo-1696446702230-a8ff49103cd1?w=800"`
+}
+
+type CreateProductInputDTO struct {
+	ID string `json:"id,omitempty" example:"MLB006"`
+	ProductFieldsDTO
+}
+
+// UpdateProductInputDTO replaces every writable field of a product. Version is
+// the UpdatedAt the caller last saw and is taken from the If-Match header.
+type UpdateProductInputDTO struct {
+	ID      string    `json:"-"`
+	Version time.Time `json:"-"`
+	ProductFieldsDTO
+}
+
+// PatchProductInputDTO carries a JSON merge patch (RFC 7396) for a product.
+type PatchProductInputDTO struct {
+	ID      string
+	Version time.Time
+	Patch   []byte
 }
 
 type ProductImageDTO struct {
@@ -15,25 +146,149 @@ type ProductImageDTO struct {
 	DisplayOrder int    `json:"display_order" example:"0"`
 }
 
+// ProductHighlightDTO shows where a search matched, with the matched terms
+// wrapped in <em> tags.
+type ProductHighlightDTO struct {
+	Title   string `json:"title" example:"<em>iPhone</em> 15 Pro Max 256GB - Titanium Blue"`
+	Snippet string `json:"snippet" example:"Latest Apple flagship smartphone…"`
+}
+
+// MoneyDTO is an exact price: Amount counts the minor unit of Currency, which
+// has Decimals decimal places, so 1299.99 USD is {129999, "USD", 2}.
+type MoneyDTO struct {
+	Amount   int64  `json:"amount" example:"129999"`
+	Currency string `json:"currency" example:"USD"`
+	Decimals int    `json:"decimals" example:"2"`
+}
+
+// ProductDTO renders a product. When the price was discounted by the active
+// promotion PromotionID, or converted to a requested currency, the stored
+// price is kept in OriginalPrice and OriginalCurrency. DiscountPercent is set
+// with a promotion and Converted with a conversion; NotConverted marks a price
+// left in its own currency because it has no exchange rate. PriceMoney and
+// OriginalPriceMoney repeat the prices exactly when the money price format
+// was requested. Installments is the best way to pay the price in
+// installments, in the currency it is rendered.
 type ProductDTO struct {
-	ID          string            `json:"id" example:"MLB001"`
-	Title       string            `json:"title" example:"iPhone 15 Pro Max 256GB - Titanium Blue"`
-	Description string            `json:"description,omitempty" example:"Latest Apple flagship smartphone with A17 Pro chip"`
-	Price       float64           `json:"price" example:"1299.99"`
-	Currency    string            `json:"currency" example:"USD"`
-	Condition   string            `json:"condition" example:"new"`
-	Stock       int               `json:"stock" example:"45"`
-	SellerID    string            `json:"seller_id,omitempty" example:"SELLER001"`
-	SellerName  string            `json:"seller_name,omitempty" example:"TechWorld Store"`
-	Category    string            `json:"category" example:"Electronics > Smartphones"`
-	Images      []ProductImageDTO `json:"images,omitempty"`
-	Thumbnail   string            `json:"thumbnail,omitempty" example:"https://images.unsplash.com/photo-1696446702230-a8ff49103cd1?w=800"`
-	CreatedAt   time.Time         `json:"created_at,omitempty" example:"2024-01-01T00:00:00Z"`
-	UpdatedAt   time.Time         `json:"updated_at,omitempty" example:"2024-01-01T00:00:00Z"`
+	ID                 string                      `json:"id" example:"MLB001"`
+	Title              string                      `json:"title" example:"iPhone 15 Pro Max 256GB - Titanium Blue"`
+	Description        string                      `json:"description,omitempty" example:"Latest Apple flagship smartphone with A17 Pro chip"`
+	Price              float64                     `json:"price" example:"1299.99"`
+	Currency           string                      `json:"currency" example:"USD"`
+	Converted          bool                        `json:"converted,omitempty" example:"true"`
+	NotConverted       bool                        `json:"not_converted,omitempty" example:"false"`
+	OriginalPrice      *float64                    `json:"original_price,omitempty" example:"1299.99"`
+	OriginalCurrency   string                      `json:"original_currency,omitempty" example:"USD"`
+	DiscountPercent    float64                     `json:"discount_percent,omitempty" example:"15"`
+	PromotionID        *int64                      `json:"promotion_id,omitempty" example:"1"`
+	PriceMoney         *MoneyDTO                   `json:"price_money,omitempty"`
+	OriginalPriceMoney *MoneyDTO                   `json:"original_price_money,omitempty"`
+	Condition          string                      `json:"condition" example:"new"`
+	Stock              int                         `json:"stock" example:"45"`
+	Status             string                      `json:"status" example:"active"`
+	PublishAt          *time.Time                  `json:"publish_at,omitempty" example:"2024-12-01T00:00:00Z"`
+	ExpiresAt          *time.Time                  `json:"expires_at,omitempty" example:"2024-12-31T23:59:59Z"`
+	SellerID           string                      `json:"seller_id,omitempty" example:"SELLER001"`
+	SellerName         string                      `json:"seller_name,omitempty" example:"TechWorld Store"`
+	Category           string                      `json:"category" example:"Electronics > Smartphones"`
+	CategoryID         *int64                      `json:"category_id,omitempty" example:"6"`
+	Breadcrumbs        []CategoryRefDTO            `json:"breadcrumbs,omitempty"`
+	Attributes         map[string]string           `json:"attributes,omitempty" example:"brand:Apple,storage:256GB"`
+	Seller             *SellerDTO                  `json:"seller,omitempty"`
+	SellerReputation   *SellerReputationSummaryDTO `json:"seller_reputation,omitempty"`
+	Rating             *ProductRatingDTO           `json:"rating,omitempty"`
+	Images             []ProductImageDTO           `json:"images,omitempty"`
+	Questions          []QuestionDTO               `json:"questions,omitempty"`
+	Variations         []VariationDTO              `json:"variations,omitempty"`
+	Shipping           *ShippingDTO                `json:"shipping,omitempty"`
+	Installments       *InstallmentDTO             `json:"installments,omitempty"`
+	Thumbnail          string                      `json:"thumbnail,omitempty" example:"https://images.unsplash.com/photo-1696446702230-a8ff49103cd1?w=800"`
+	CreatedAt          time.Time                   `json:"created_at,omitempty" example:"2024-01-01T00:00:00Z"`
+	UpdatedAt          time.Time                   `json:"updated_at,omitempty" example:"2024-01-01T00:00:00Z"`
+	DeletedAt          *time.Time                  `json:"deleted_at,omitempty" example:"2024-02-01T00:00:00Z"`
+	Highlight          *ProductHighlightDTO        `json:"highlight,omitempty"`
+}
+
+// VariationDTO renders a variation of a product, priced in the currency of
+// the product. PriceMoney repeats the price exactly when the money price
+// format was requested.
+type VariationDTO struct {
+	ID         int64             `json:"id" example:"2"`
+	Attributes map[string]string `json:"attributes" example:"color:Titanium Blue,storage:512GB"`
+	Price      float64           `json:"price" example:"1499.99"`
+	PriceMoney *MoneyDTO         `json:"price_money,omitempty"`
+	Stock      int               `json:"stock" example:"15"`
+	Images     []string          `json:"images,omitempty" example:"https://images.unsplash.com/photo-1696446702230-a8ff49103cd1?w=800"`
+}
+
+type PaginationDTO struct {
+	Limit      int    `json:"limit" example:"20"`
+	NextCursor string `json:"next_cursor,omitempty" example:"eyJhZnRlcl9pZCI6Ik1MQjAyMCJ9"`
+	HasMore    bool   `json:"has_more" example:"true"`
+}
+
+// FacetBucketDTO counts the products sharing a facet value.
+type FacetBucketDTO struct {
+	Value string `json:"value" example:"Electronics > Smartphones"`
+	Label string `json:"label,omitempty" example:"Smartphones"`
+	Count int    `json:"count" example:"12"`
+}
+
+// CategoryFacetDTO counts products per category one level below the category
+// filter, or per top-level category without one.
+type CategoryFacetDTO struct {
+	Level   int              `json:"level" example:"2"`
+	Buckets []FacetBucketDTO `json:"buckets"`
+}
+
+// PriceRangeBucketDTO counts the products priced in [Min, Max) of Currency.
+// The last range has no Max.
+type PriceRangeBucketDTO struct {
+	Min      float64  `json:"min" example:"100"`
+	Max      *float64 `json:"max,omitempty" example:"250"`
+	Currency string   `json:"currency" example:"USD"`
+	Count    int      `json:"count" example:"7"`
+}
+
+// ProductFacetsDTO summarizes every product matching the filters, not only
+// the current page.
+type ProductFacetsDTO struct {
+	Condition  []FacetBucketDTO      `json:"condition"`
+	Category   CategoryFacetDTO      `json:"category"`
+	Seller     []FacetBucketDTO      `json:"seller"`
+	PriceRange []PriceRangeBucketDTO `json:"price_range"`
+}
+
+// ProductListDTO is one page of products.
+type ProductListDTO struct {
+	Products   []ProductDTO
+	Pagination PaginationDTO
+	Facets     ProductFacetsDTO
 }
 
 type ProductListResponse struct {
-	Data []ProductDTO `json:"data"`
+	Data       []ProductDTO     `json:"data"`
+	Pagination PaginationDTO    `json:"pagination"`
+	Facets     ProductFacetsDTO `json:"facets"`
+}
+
+// ProductBatchErrorDTO reports why one requested ID was not returned.
+type ProductBatchErrorDTO struct {
+	ID    string `json:"id" example:"MLB999"`
+	Code  string `json:"code" example:"PRODUCT_NOT_FOUND"`
+	Error string `json:"error" example:"The requested product was not found"`
+}
+
+// ProductBatchDTO holds the products found by a batch get, in request order,
+// and one error per ID that was not found.
+type ProductBatchDTO struct {
+	Products []ProductDTO
+	Errors   []ProductBatchErrorDTO
+}
+
+type ProductBatchResponse struct {
+	Data   []ProductDTO           `json:"data"`
+	Errors []ProductBatchErrorDTO `json:"errors"`
 }
 
 type ProductResponse struct {
@@ -0,0 +1,39 @@
+package dto
+
+import "time"
+
+// ChangeProductStatusInputDTO changes the status of a listing on behalf of
+// SellerID, which must be the seller of the product, or of an administrator.
+// Reason is kept with the recorded change.
+type ChangeProductStatusInputDTO struct {
+	ProductID string `json:"-"`
+	SellerID  string `json:"-"`
+	IsAdmin   bool   `json:"-"`
+	Status    string `json:"status" example:"paused"`
+	Reason    string `json:"reason,omitempty" example:"Seller on vacation"`
+}
+
+// ListStatusChangesInputDTO selects the status history of a product, which
+// only its seller, SellerID, and administrators may read.
+type ListStatusChangesInputDTO struct {
+	ProductID string `json:"product_id"`
+	SellerID  string `json:"seller_id,omitempty"`
+	IsAdmin   bool   `json:"is_admin,omitempty"`
+}
+
+// StatusChangeDTO renders a transition of the status of a listing. Actor is
+// admin, seller or system; ActorID is the seller for a seller change.
+type StatusChangeDTO struct {
+	ID        int64     `json:"id" example:"1"`
+	ProductID string    `json:"product_id" example:"MLB001"`
+	From      string    `json:"from" example:"active"`
+	To        string    `json:"to" example:"paused"`
+	Actor     string    `json:"actor" example:"seller"`
+	ActorID   string    `json:"actor_id,omitempty" example:"SELLER001"`
+	Reason    string    `json:"reason,omitempty" example:"Seller on vacation"`
+	CreatedAt time.Time `json:"created_at" example:"2024-03-01T12:00:00Z"`
+}
+
+type StatusChangeListResponse struct {
+	Data []StatusChangeDTO `json:"data"`
+}
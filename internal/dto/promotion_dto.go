@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"time"
+)
+
+// CreatePromotionInputDTO discounts a product, or every product of a category
+// and its subcategories, from StartsAt until just before EndsAt. Value is a
+// percentage for a percentage promotion and an amount of Currency for a fixed
+// one. Among overlapping promotions of a product, the highest Priority wins.
+type CreatePromotionInputDTO struct {
+	ProductID  *string   `json:"product_id,omitempty" example:"MLB001"`
+	CategoryID *int64    `json:"category_id,omitempty" example:"6"`
+	Type       string    `json:"type" example:"percentage"`
+	Value      float64   `json:"value" example:"15"`
+	Currency   string    `json:"currency,omitempty" example:"USD"`
+	Priority   int       `json:"priority" example:"1"`
+	StartsAt   time.Time `json:"starts_at" example:"2024-11-29T00:00:00Z"`
+	EndsAt     time.Time `json:"ends_at" example:"2024-12-02T00:00:00Z"`
+}
+
+// ListPromotionsInputDTO selects the promotions to list. Active keeps the
+// ones active now.
+type ListPromotionsInputDTO struct {
+	Active bool `json:"active,omitempty"`
+}
+
+type DeletePromotionInputDTO struct {
+	ID int64 `json:"id"`
+}
+
+// PromotionDTO renders a promotion. Active tells whether it applies now.
+type PromotionDTO struct {
+	ID         int64     `json:"id" example:"1"`
+	ProductID  *string   `json:"product_id,omitempty" example:"MLB001"`
+	CategoryID *int64    `json:"category_id,omitempty" example:"6"`
+	Type       string    `json:"type" example:"percentage"`
+	Value      float64   `json:"value" example:"15"`
+	Currency   string    `json:"currency,omitempty" example:"USD"`
+	Priority   int       `json:"priority" example:"1"`
+	StartsAt   time.Time `json:"starts_at" example:"2024-11-29T00:00:00Z"`
+	EndsAt     time.Time `json:"ends_at" example:"2024-12-02T00:00:00Z"`
+	Active     bool      `json:"active" example:"true"`
+	CreatedAt  time.Time `json:"created_at" example:"2024-11-20T00:00:00Z"`
+}
+
+type PromotionResponse struct {
+	Data PromotionDTO `json:"data"`
+}
+
+type PromotionListResponse struct {
+	Data []PromotionDTO `json:"data"`
+}
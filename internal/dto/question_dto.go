@@ -0,0 +1,70 @@
+package dto
+
+import "time"
+
+// CreateQuestionInputDTO asks a question on a product page. A listing that
+// is not active only takes questions from administrators and from its
+// seller, SellerID.
+type CreateQuestionInputDTO struct {
+	ProductID string `json:"-"`
+	Text      string `json:"text" example:"Does it come with a power adapter?"`
+	SellerID  string `json:"-"`
+	IsAdmin   bool   `json:"-"`
+}
+
+// ListQuestionsInputDTO selects a page of the questions of a product. An
+// empty Status returns the unanswered and answered questions, Limit zero
+// means the default page size and an empty Cursor starts from the newest
+// question. The questions of a listing that is not active are only shown to
+// administrators and to its seller, SellerID.
+type ListQuestionsInputDTO struct {
+	ProductID string `json:"product_id"`
+	Status    string `json:"status,omitempty"`
+	Limit     int    `json:"limit,omitempty"`
+	Cursor    string `json:"cursor,omitempty"`
+	SellerID  string `json:"seller_id,omitempty"`
+	IsAdmin   bool   `json:"is_admin,omitempty"`
+}
+
+// AnswerQuestionInputDTO answers a question on behalf of SellerID, which must
+// be the seller of the product.
+type AnswerQuestionInputDTO struct {
+	ProductID  string `json:"-"`
+	QuestionID int64  `json:"-"`
+	SellerID   string `json:"-"`
+	Answer     string `json:"answer" example:"No, only the USB-C cable is included."`
+}
+
+// HideQuestionInputDTO hides a question on behalf of SellerID, which must be
+// the seller of the product, or of an administrator.
+type HideQuestionInputDTO struct {
+	ProductID  string `json:"product_id"`
+	QuestionID int64  `json:"question_id"`
+	SellerID   string `json:"seller_id,omitempty"`
+	IsAdmin    bool   `json:"is_admin,omitempty"`
+}
+
+type QuestionDTO struct {
+	ID         int64      `json:"id" example:"2"`
+	ProductID  string     `json:"product_id" example:"MLB001"`
+	Text       string     `json:"text" example:"Does it come with a power adapter?"`
+	Status     string     `json:"status" example:"answered"`
+	Answer     string     `json:"answer,omitempty" example:"No, only the USB-C cable is included."`
+	AnsweredAt *time.Time `json:"answered_at,omitempty" example:"2024-01-08T16:40:00Z"`
+	CreatedAt  time.Time  `json:"created_at" example:"2024-01-08T14:20:00Z"`
+}
+
+// QuestionListDTO is one page of questions, newest first.
+type QuestionListDTO struct {
+	Questions  []QuestionDTO
+	Pagination PaginationDTO
+}
+
+type QuestionListResponse struct {
+	Data       []QuestionDTO `json:"data"`
+	Pagination PaginationDTO `json:"pagination"`
+}
+
+type QuestionResponse struct {
+	Data QuestionDTO `json:"data"`
+}
@@ -0,0 +1,67 @@
+package dto
+
+import "time"
+
+// CreateReviewInputDTO rates a product from 1 to 5 stars. Title and Comment
+// are optional. A listing that is not active is only reviewed by
+// administrators and by its seller, SellerID.
+type CreateReviewInputDTO struct {
+	ProductID string `json:"-"`
+	Rating    int    `json:"rating" example:"5"`
+	Title     string `json:"title,omitempty" example:"Best iPhone so far"`
+	Comment   string `json:"comment,omitempty" example:"Battery lasts the whole day and the camera is outstanding."`
+	SellerID  string `json:"-"`
+	IsAdmin   bool   `json:"-"`
+}
+
+// ListReviewsInputDTO selects a page of the reviews of a product. Limit zero
+// means the default page size and an empty Cursor starts from the newest
+// review. The reviews of a listing that is not active are only shown to
+// administrators and to its seller, SellerID.
+type ListReviewsInputDTO struct {
+	ProductID string `json:"product_id"`
+	Limit     int    `json:"limit,omitempty"`
+	Cursor    string `json:"cursor,omitempty"`
+	SellerID  string `json:"seller_id,omitempty"`
+	IsAdmin   bool   `json:"is_admin,omitempty"`
+}
+
+type ReviewDTO struct {
+	ID        int64     `json:"id" example:"1"`
+	ProductID string    `json:"product_id" example:"MLB001"`
+	Rating    int       `json:"rating" example:"5"`
+	Title     string    `json:"title,omitempty" example:"Best iPhone so far"`
+	Comment   string    `json:"comment,omitempty" example:"Battery lasts the whole day and the camera is outstanding."`
+	CreatedAt time.Time `json:"created_at" example:"2024-01-10T12:00:00Z"`
+}
+
+// ReviewListDTO is one page of reviews, newest first.
+type ReviewListDTO struct {
+	Reviews    []ReviewDTO
+	Pagination PaginationDTO
+}
+
+type ReviewListResponse struct {
+	Data       []ReviewDTO   `json:"data"`
+	Pagination PaginationDTO `json:"pagination"`
+}
+
+type ReviewResponse struct {
+	Data ReviewDTO `json:"data"`
+}
+
+// ProductRatingDTO aggregates the reviews of a product. Stars counts the
+// reviews per number of stars.
+type ProductRatingDTO struct {
+	Average float64               `json:"average" example:"4.67"`
+	Count   int                   `json:"count" example:"3"`
+	Stars   ProductRatingStarsDTO `json:"stars"`
+}
+
+type ProductRatingStarsDTO struct {
+	One   int `json:"1" example:"0"`
+	Two   int `json:"2" example:"0"`
+	Three int `json:"3" example:"0"`
+	Four  int `json:"4" example:"1"`
+	Five  int `json:"5" example:"2"`
+}
@@ -0,0 +1,56 @@
+package dto
+
+import "time"
+
+// SellerDTO is the seller embedded in a product.
+type SellerDTO struct {
+	ID   string `json:"id" example:"SELLER001"`
+	Name string `json:"name" example:"TechWorld Store"`
+}
+
+// SellerReputationDTO is the reputation of a seller. CancellationRate is the
+// share of finished orders that were cancelled, between 0 and 1, and
+// RatingAverage the mean stars of the reviews of its products.
+type SellerReputationDTO struct {
+	Level            string  `json:"level" example:"green" enums:"green,light_green,yellow,orange,red,none"`
+	PowerSeller      string  `json:"power_seller,omitempty" example:"gold" enums:"platinum,gold,silver"`
+	CompletedSales   int     `json:"completed_sales" example:"60"`
+	CancelledSales   int     `json:"cancelled_sales" example:"1"`
+	CancellationRate float64 `json:"cancellation_rate" example:"0.0164"`
+	ReviewCount      int     `json:"review_count" example:"3"`
+	RatingAverage    float64 `json:"rating_average" example:"4.67"`
+}
+
+// SellerReputationSummaryDTO is the part of the seller reputation shown
+// next to the seller name on a product.
+type SellerReputationSummaryDTO struct {
+	Level          string `json:"level" example:"green" enums:"green,light_green,yellow,orange,red,none"`
+	PowerSeller    string `json:"power_seller,omitempty" example:"gold" enums:"platinum,gold,silver"`
+	CompletedSales int    `json:"completed_sales" example:"60"`
+}
+
+// SellerDetailDTO describes a seller. ProductCount only counts active
+// listings that are not deleted.
+type SellerDetailDTO struct {
+	ID           string              `json:"id" example:"SELLER001"`
+	Name         string              `json:"name" example:"TechWorld Store"`
+	ProductCount int                 `json:"product_count" example:"2"`
+	Reputation   SellerReputationDTO `json:"reputation"`
+	CreatedAt    time.Time           `json:"created_at" example:"2024-01-01T00:00:00Z"`
+	UpdatedAt    time.Time           `json:"updated_at" example:"2024-01-01T00:00:00Z"`
+}
+
+type SellerResponse struct {
+	Data SellerDetailDTO `json:"data"`
+}
+
+type SellerInputDTO struct {
+	ID string `json:"id"`
+}
+
+// ListSellerProductsInputDTO lists the products of one seller. SellerID
+// takes the place of the seller_id filter.
+type ListSellerProductsInputDTO struct {
+	SellerID string `json:"seller_id"`
+	ListProductInputDTO
+}
@@ -0,0 +1,44 @@
+package dto
+
+// ShippingDTO is the shipping profile of a product: the weight and
+// dimensions of its package, whether the seller pays for the shipping and
+// the zip code it ships from, as 8 digits or as 01310-100.
+type ShippingDTO struct {
+	WeightGrams  int    `json:"weight_grams" example:"240"`
+	LengthCm     int    `json:"length_cm" example:"18"`
+	WidthCm      int    `json:"width_cm" example:"10"`
+	HeightCm     int    `json:"height_cm" example:"6"`
+	FreeShipping bool   `json:"free_shipping" example:"true"`
+	OriginZip    string `json:"origin_zip" example:"01310100"`
+}
+
+// ShippingQuoteInputDTO asks how much shipping a product to Zip costs. A
+// listing that is not active is only quoted to administrators and to its
+// seller, SellerID.
+type ShippingQuoteInputDTO struct {
+	ProductID string `json:"product_id"`
+	Zip       string `json:"zip"`
+	SellerID  string `json:"seller_id,omitempty"`
+	IsAdmin   bool   `json:"is_admin,omitempty"`
+}
+
+// ShippingQuoteDTO is the cost of shipping a product and when it arrives.
+// The delivery is estimated in business days from today, as dates between
+// EstimatedDeliveryFrom and EstimatedDeliveryTo.
+type ShippingQuoteDTO struct {
+	ProductID             string  `json:"product_id" example:"MLB001"`
+	OriginZip             string  `json:"origin_zip" example:"01310100"`
+	DestinationZip        string  `json:"destination_zip" example:"20040002"`
+	FreeShipping          bool    `json:"free_shipping" example:"false"`
+	Cost                  float64 `json:"cost" example:"12.9"`
+	Currency              string  `json:"currency" example:"USD"`
+	BillableWeightGrams   int     `json:"billable_weight_grams" example:"240"`
+	MinDays               int     `json:"min_days" example:"3"`
+	MaxDays               int     `json:"max_days" example:"5"`
+	EstimatedDeliveryFrom string  `json:"estimated_delivery_from" example:"2024-01-04"`
+	EstimatedDeliveryTo   string  `json:"estimated_delivery_to" example:"2024-01-08"`
+}
+
+type ShippingQuoteResponse struct {
+	Data ShippingQuoteDTO `json:"data"`
+}
@@ -0,0 +1,169 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"project/internal/errors"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// Attribute types. Values are always stored as text; the type of an
+// attribute only restricts which text is accepted.
+const (
+	AttributeString  = "string"
+	AttributeNumber  = "number"
+	AttributeBoolean = "boolean"
+)
+
+// attributeNamePattern keeps attribute names usable as the attr.<name> query
+// parameter of product filters.
+var attributeNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// CategoryAttribute defines an attribute the products of a category, and of
+// its subcategories, may have. Required attributes must be present.
+type CategoryAttribute struct {
+	CategoryID int64  `json:"category_id" db:"category_id"`
+	Name       string `json:"name" db:"name"`
+	Type       string `json:"type" db:"type"`
+	Required   bool   `json:"required" db:"required"`
+}
+
+// ProductAttributes maps the attribute names of a product to their values,
+// like {"brand": "Apple", "storage": "256GB"}.
+type ProductAttributes map[string]string
+
+// Scan reads the attributes of a product aggregated by the database into a
+// JSON object.
+func (a *ProductAttributes) Scan(src any) error {
+	var raw []byte
+	switch value := src.(type) {
+	case nil:
+		*a = nil
+		return nil
+	case string:
+		raw = []byte(value)
+	case []byte:
+		raw = value
+	default:
+		return fmt.Errorf("cannot scan %T into product attributes", src)
+	}
+
+	attributes := ProductAttributes{}
+	if err := json.Unmarshal(raw, &attributes); err != nil {
+		return err
+	}
+	if len(attributes) == 0 {
+		attributes = nil
+	}
+
+	*a = attributes
+	return nil
+}
+
+// names returns the attribute names sorted.
+func (a ProductAttributes) names() []string {
+	names := make([]string, 0, len(a))
+	for name := range a {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// IsValidAttributeName reports whether name is lowercase letters, digits and
+// underscores, starting with a letter.
+func IsValidAttributeName(name string) bool {
+	return attributeNamePattern.MatchString(name)
+}
+
+// IsValidAttributeType reports whether attributeType is one of the attribute types.
+func IsValidAttributeType(attributeType string) bool {
+	return attributeType == AttributeString || attributeType == AttributeNumber || attributeType == AttributeBoolean
+}
+
+// ResolveCategoryAttributes merges the definitions of a category and of its
+// ancestors, given root first, into one definition per attribute sorted by
+// name. A subcategory overrides the definition of an ancestor.
+func ResolveCategoryAttributes(definitions []CategoryAttribute) []CategoryAttribute {
+	byName := map[string]CategoryAttribute{}
+	for _, definition := range definitions {
+		byName[definition.Name] = definition
+	}
+
+	resolved := make([]CategoryAttribute, 0, len(byName))
+	for _, definition := range byName {
+		resolved = append(resolved, definition)
+	}
+	sort.Slice(resolved, func(i, j int) bool { return resolved[i].Name < resolved[j].Name })
+
+	return resolved
+}
+
+// ValidateProductAttributes checks the attributes of a product and of its
+// variations against the definitions of its category and of its ancestors,
+// given root first: every attribute must be defined and every value of the
+// defined type. A required attribute must be set on the product or, when it
+// has variations, on each of them, and a variation cannot redefine an
+// attribute of the product. Failures are reported under attributes.<name> and
+// variations[i].attributes.<name>.
+func ValidateProductAttributes(attributes ProductAttributes, variations []Variation, definitions []CategoryAttribute) error {
+	validation := &errors.ValidationError{}
+	resolved := ResolveCategoryAttributes(definitions)
+
+	validateAttributes(validation, "", attributes, nil, resolved, len(variations) == 0)
+	for i, variation := range variations {
+		validateAttributes(validation, fmt.Sprintf("variations[%d].", i), variation.Attributes, attributes, resolved, true)
+	}
+
+	return validation.Err()
+}
+
+// validateAttributes records in validation why attributes, reported under
+// prefix, do not match their definitions. inherited are the attributes of the
+// product a variation belongs to: they satisfy required definitions but
+// cannot be set again. Required definitions are only checked when
+// checkRequired is set.
+func validateAttributes(validation *errors.ValidationError, prefix string, attributes, inherited ProductAttributes, resolved []CategoryAttribute, checkRequired bool) {
+	defined := make(map[string]bool, len(resolved))
+	for _, definition := range resolved {
+		defined[definition.Name] = true
+		field := prefix + "attributes." + definition.Name
+
+		value, ok := attributes[definition.Name]
+		if !ok {
+			if _, inheritedOk := inherited[definition.Name]; checkRequired && definition.Required && !inheritedOk {
+				validation.Add(field, errors.RuleRequired, fmt.Sprintf("%s is required", field))
+			}
+			continue
+		}
+
+		if !isAttributeValueOfType(value, definition.Type) {
+			validation.Add(field, errors.RuleType, fmt.Sprintf("%s must be a %s", field, definition.Type))
+		}
+	}
+
+	for _, name := range attributes.names() {
+		field := prefix + "attributes." + name
+		if !defined[name] {
+			validation.Add(field, errors.RuleAllowed, fmt.Sprintf("%s is not an attribute of the category", field))
+		} else if _, ok := inherited[name]; ok {
+			validation.Add(field, errors.RuleAllowed, fmt.Sprintf("%s is already an attribute of the product", field))
+		}
+	}
+}
+
+func isAttributeValueOfType(value, attributeType string) bool {
+	switch attributeType {
+	case AttributeNumber:
+		number, err := strconv.ParseFloat(value, 64)
+		return err == nil && !math.IsNaN(number) && !math.IsInf(number, 0)
+	case AttributeBoolean:
+		return value == "true" || value == "false"
+	default:
+		return true
+	}
+}
@@ -0,0 +1,99 @@
+package entity
+
+import (
+	"project/internal/errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// smartphoneAttributes are the definitions of Electronics, then of
+// Electronics > Smartphones, root first as the repository returns them.
+var smartphoneAttributes = []CategoryAttribute{
+	{CategoryID: 1, Name: "brand", Type: AttributeString},
+	{CategoryID: 1, Name: "wireless", Type: AttributeBoolean},
+	{CategoryID: 6, Name: "brand", Type: AttributeString, Required: true},
+	{CategoryID: 6, Name: "ram_gb", Type: AttributeNumber},
+	{CategoryID: 6, Name: "storage", Type: AttributeString, Required: true},
+}
+
+func Test_ResolveCategoryAttributes(t *testing.T) {
+	resolved := ResolveCategoryAttributes(smartphoneAttributes)
+
+	assert.Equal(t, []CategoryAttribute{
+		{CategoryID: 6, Name: "brand", Type: AttributeString, Required: true},
+		{CategoryID: 6, Name: "ram_gb", Type: AttributeNumber},
+		{CategoryID: 6, Name: "storage", Type: AttributeString, Required: true},
+		{CategoryID: 1, Name: "wireless", Type: AttributeBoolean},
+	}, resolved)
+}
+
+func Test_ValidateProductAttributes(t *testing.T) {
+	t.Run("Valid attributes", func(t *testing.T) {
+		err := ValidateProductAttributes(ProductAttributes{"brand": "Apple", "storage": "256GB", "ram_gb": "8", "wireless": "true"}, nil, smartphoneAttributes)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Reports every invalid attribute", func(t *testing.T) {
+		err := ValidateProductAttributes(ProductAttributes{"brand": "Apple", "ram_gb": "eight", "wireless": "yes", "size": "10"}, nil, smartphoneAttributes)
+
+		assert.ErrorIs(t, err, errors.ErrValidation)
+		assert.Equal(t, []errors.FieldError{
+			{Field: "attributes.ram_gb", Rule: errors.RuleType, Message: "attributes.ram_gb must be a number"},
+			{Field: "attributes.storage", Rule: errors.RuleRequired, Message: "attributes.storage is required"},
+			{Field: "attributes.wireless", Rule: errors.RuleType, Message: "attributes.wireless must be a boolean"},
+			{Field: "attributes.size", Rule: errors.RuleAllowed, Message: "attributes.size is not an attribute of the category"},
+		}, errors.GetErrorDetails(err))
+	})
+
+	t.Run("Required attributes set on every variation", func(t *testing.T) {
+		variations := []Variation{
+			{Attributes: ProductAttributes{"storage": "256GB"}},
+			{Attributes: ProductAttributes{"storage": "512GB", "ram_gb": "twelve"}},
+			{Attributes: ProductAttributes{"ram_gb": "8", "brand": "Apple"}},
+		}
+
+		err := ValidateProductAttributes(ProductAttributes{"brand": "Apple"}, variations, smartphoneAttributes)
+
+		assert.Equal(t, []errors.FieldError{
+			{Field: "variations[1].attributes.ram_gb", Rule: errors.RuleType, Message: "variations[1].attributes.ram_gb must be a number"},
+			{Field: "variations[2].attributes.storage", Rule: errors.RuleRequired, Message: "variations[2].attributes.storage is required"},
+			{Field: "variations[2].attributes.brand", Rule: errors.RuleAllowed, Message: "variations[2].attributes.brand is already an attribute of the product"},
+		}, errors.GetErrorDetails(err))
+	})
+
+	t.Run("Category without attributes", func(t *testing.T) {
+		assert.NoError(t, ValidateProductAttributes(nil, nil, nil))
+		assert.Error(t, ValidateProductAttributes(ProductAttributes{"brand": "Apple"}, nil, nil))
+	})
+}
+
+func Test_ProductAttributes_Scan(t *testing.T) {
+	var attributes ProductAttributes
+
+	assert.NoError(t, attributes.Scan(`{"brand":"Apple","storage":"256GB"}`))
+	assert.Equal(t, ProductAttributes{"brand": "Apple", "storage": "256GB"}, attributes)
+
+	assert.NoError(t, attributes.Scan([]byte(`{}`)))
+	assert.Nil(t, attributes)
+
+	assert.Error(t, attributes.Scan(42))
+}
+
+func Test_IsValidAttributeName(t *testing.T) {
+	assert.True(t, IsValidAttributeName("ram_gb"))
+	assert.False(t, IsValidAttributeName(""))
+	assert.False(t, IsValidAttributeName("RAM"))
+	assert.False(t, IsValidAttributeName("2g"))
+	assert.False(t, IsValidAttributeName("brand.name"))
+}
+
+func Test_Product_ValidateEmptyAttribute(t *testing.T) {
+	product, err := NewProduct("MLB001", "Product", "Desc", priceUSD(1000), New, 1, "seller-001", "Seller", "Cat", ProductAttributes{"brand": ""}, ShippingProfile{})
+
+	assert.Nil(t, product)
+	assert.Equal(t, []errors.FieldError{
+		{Field: "attributes.brand", Rule: errors.RuleRequired, Message: "attributes.brand must not be empty"},
+	}, errors.GetErrorDetails(err))
+}
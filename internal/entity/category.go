@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CategoryPathSeparator joins the level names of a category path, as in
+// "Electronics > Smartphones".
+const CategoryPathSeparator = " > "
+
+// Category is one node of the category tree. Path repeats the names of its
+// ancestors so that products can keep referring to categories by path.
+type Category struct {
+	ID       int64  `json:"id" db:"id"`
+	ParentID *int64 `json:"parent_id,omitempty" db:"parent_id"`
+	Name     string `json:"name" db:"name"`
+	Path     string `json:"path" db:"path"`
+}
+
+// SplitCategoryPath returns the level names of path, root first.
+func SplitCategoryPath(path string) []string {
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, CategoryPathSeparator)
+}
+
+// CategoryPathPrefixes returns the paths of path and all of its ancestors,
+// root first.
+func CategoryPathPrefixes(path string) []string {
+	names := SplitCategoryPath(path)
+	prefixes := make([]string, 0, len(names))
+
+	for i := range names {
+		prefixes = append(prefixes, strings.Join(names[:i+1], CategoryPathSeparator))
+	}
+
+	return prefixes
+}
+
+// ValidateCategoryPath checks that every level of path has a name without
+// surrounding spaces. An empty path means the product has no category.
+func ValidateCategoryPath(path string) error {
+	for _, name := range SplitCategoryPath(path) {
+		if name == "" || strings.TrimSpace(name) != name {
+			return fmt.Errorf("category must be a path of names separated by '%s', like 'Electronics%sSmartphones'", CategoryPathSeparator, CategoryPathSeparator)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SplitCategoryPath(t *testing.T) {
+	assert.Nil(t, SplitCategoryPath(""))
+	assert.Equal(t, []string{"Electronics"}, SplitCategoryPath("Electronics"))
+	assert.Equal(t, []string{"Electronics", "Audio", "Headphones"}, SplitCategoryPath("Electronics > Audio > Headphones"))
+}
+
+func Test_CategoryPathPrefixes(t *testing.T) {
+	assert.Empty(t, CategoryPathPrefixes(""))
+	assert.Equal(t, []string{
+		"Electronics",
+		"Electronics > Audio",
+		"Electronics > Audio > Headphones",
+	}, CategoryPathPrefixes("Electronics > Audio > Headphones"))
+}
+
+func Test_ValidateCategoryPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{"No category", "", false},
+		{"Top level", "Electronics", false},
+		{"Nested", "Fashion > Shoes > Sneakers", false},
+		{"Empty level", "Electronics >  > Audio", true},
+		{"Trailing separator", "Electronics > ", true},
+		{"Surrounding spaces", " Electronics", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCategoryPath(tt.path)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_NewProduct_InvalidCategory(t *testing.T) {
+	product, err := NewProduct("MLB001", "Product", "Desc", priceUSD(1000), New, 1, "seller-001", "Seller", "Electronics > ", nil, ShippingProfile{})
+
+	assert.Nil(t, product)
+	assert.Error(t, err)
+}
@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// BaseCurrency is the currency every exchange rate is quoted against. Its own
+// rate is always 1.
+const BaseCurrency = "USD"
+
+// ExchangeRate is how many units of Currency one unit of BaseCurrency buys.
+type ExchangeRate struct {
+	Currency  string    `json:"currency" db:"currency"`
+	Rate      float64   `json:"rate" db:"rate"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+func NewExchangeRate(currency string, rate float64) (*ExchangeRate, error) {
+	exchangeRate := &ExchangeRate{
+		Currency:  currency,
+		Rate:      rate,
+		UpdatedAt: time.Now(),
+	}
+
+	if err := exchangeRate.Validate(); err != nil {
+		return nil, err
+	}
+
+	return exchangeRate, nil
+}
+
+func (r *ExchangeRate) Validate() error {
+	if !IsValidCurrency(r.Currency) {
+		return fmt.Errorf("currency must be an ISO 4217 code")
+	}
+
+	if r.Rate <= 0 {
+		return fmt.Errorf("rate of %s must be greater than 0", r.Currency)
+	}
+
+	if r.Currency == BaseCurrency && r.Rate != 1 {
+		return fmt.Errorf("rate of %s must be 1", BaseCurrency)
+	}
+
+	return nil
+}
+
+// ConvertPrice converts price from the currency of from to the currency of
+// to, rounded to the minor unit of to.
+func ConvertPrice(price Money, from, to ExchangeRate) Money {
+	converted := Money{Currency: to.Currency}
+	scale := math.Pow10(converted.Decimals() - price.Decimals())
+	converted.Amount = int64(math.Round(float64(price.Amount) / from.Rate * to.Rate * scale))
+	return converted
+}
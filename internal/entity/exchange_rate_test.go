@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewExchangeRate_Success(t *testing.T) {
+	rate, err := NewExchangeRate("BRL", 4.92)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "BRL", rate.Currency)
+	assert.Equal(t, 4.92, rate.Rate)
+	assert.False(t, rate.UpdatedAt.IsZero())
+}
+
+func Test_NewExchangeRate_Validation(t *testing.T) {
+	tests := []struct {
+		name     string
+		currency string
+		rate     float64
+		expected string
+	}{
+		{"Lowercase code", "brl", 4.92, "currency must be an ISO 4217 code"},
+		{"Short code", "BR", 4.92, "currency must be an ISO 4217 code"},
+		{"Not an ISO 4217 code", "XYZ", 4.92, "currency must be an ISO 4217 code"},
+		{"Zero rate", "BRL", 0, "rate of BRL must be greater than 0"},
+		{"Negative rate", "BRL", -1, "rate of BRL must be greater than 0"},
+		{"Base currency not 1", "USD", 1.1, "rate of USD must be 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := NewExchangeRate(tt.currency, tt.rate)
+
+			assert.Nil(t, rate)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
+
+func Test_ConvertPrice(t *testing.T) {
+	usd := ExchangeRate{Currency: "USD", Rate: 1}
+	brl := ExchangeRate{Currency: "BRL", Rate: 4.92}
+	eur := ExchangeRate{Currency: "EUR", Rate: 0.91}
+
+	jpy := ExchangeRate{Currency: "JPY", Rate: 148.2}
+	kwd := ExchangeRate{Currency: "KWD", Rate: 0.3075}
+
+	assert.Equal(t, Money{Amount: 491995, Currency: "BRL"}, ConvertPrice(Money{Amount: 99999, Currency: "USD"}, usd, brl))
+	assert.Equal(t, Money{Amount: 10000, Currency: "USD"}, ConvertPrice(Money{Amount: 49200, Currency: "BRL"}, brl, usd))
+	assert.Equal(t, Money{Amount: 1850, Currency: "EUR"}, ConvertPrice(Money{Amount: 10000, Currency: "BRL"}, brl, eur))
+	// Rounded to the minor unit of the target currency
+	assert.Equal(t, Money{Amount: 192659, Currency: "JPY"}, ConvertPrice(Money{Amount: 129999, Currency: "USD"}, usd, jpy))
+	assert.Equal(t, Money{Amount: 399747, Currency: "KWD"}, ConvertPrice(Money{Amount: 129999, Currency: "USD"}, usd, kwd))
+	assert.Equal(t, Money{Amount: 877, Currency: "USD"}, ConvertPrice(Money{Amount: 1300, Currency: "JPY"}, jpy, usd))
+}
@@ -0,0 +1,92 @@
+package entity
+
+import (
+	"math/big"
+)
+
+// basisPointsPerUnit turns an interest rate in basis points into a fraction:
+// 199 basis points are 1.99%.
+const basisPointsPerUnit = 10000
+
+// InstallmentPlan lets a price in the currency of MinInstallment be paid in
+// Installments monthly installments. InterestRate is the monthly interest in
+// basis points, 199 for 1.99% a month, and 0 for a plan without interest. A
+// plan is only offered when each installment is at least MinInstallment.
+type InstallmentPlan struct {
+	ID             int64 `json:"id" db:"id"`
+	Installments   int   `json:"installments" db:"installments"`
+	InterestRate   int   `json:"interest_rate" db:"interest_rate_bp"`
+	MinInstallment Money `json:"min_installment" db:"min_installment"`
+}
+
+// Installment is a price split by an InstallmentPlan into Quantity
+// installments of Amount. Only the first one, of FirstAmount, differs: it
+// carries the minor units the split leaves over, so the installments add up
+// to Total exactly.
+type Installment struct {
+	Quantity     int
+	Amount       Money
+	FirstAmount  Money
+	Total        Money
+	InterestRate int
+}
+
+// InterestFree reports whether the buyer pays the price and nothing more.
+func (i Installment) InterestFree() bool {
+	return i.InterestRate == 0
+}
+
+// Split divides price into the installments of the plan. Without interest
+// the installments add up to the price. With interest every installment is
+// the fixed payment of a loan of price at the monthly rate, rounded to the
+// minor unit, and Total is what the buyer pays in the end. It reports false
+// when price is not in the currency of the plan or an installment would be
+// less than MinInstallment.
+func (p InstallmentPlan) Split(price Money) (Installment, bool) {
+	if p.Installments < 1 || price.Amount <= 0 || price.Currency != p.MinInstallment.Currency {
+		return Installment{}, false
+	}
+
+	quantity := int64(p.Installments)
+	amount := price.Amount / quantity
+	first := amount + price.Amount%quantity
+	if p.InterestRate > 0 {
+		amount = fixedPayment(price.Amount, p.InterestRate, p.Installments)
+		first = amount
+	}
+
+	if amount < p.MinInstallment.Amount {
+		return Installment{}, false
+	}
+
+	return Installment{
+		Quantity:     p.Installments,
+		Amount:       Money{Amount: amount, Currency: price.Currency},
+		FirstAmount:  Money{Amount: first, Currency: price.Currency},
+		Total:        Money{Amount: first + amount*(quantity-1), Currency: price.Currency},
+		InterestRate: p.InterestRate,
+	}, true
+}
+
+// fixedPayment is the installment that pays off principal in installments
+// months at rateBasisPoints a month, principal * r / (1 - (1 + r)^-n), rounded
+// half up to the minor unit. It is computed with exact fractions, so the
+// rounding is the only error.
+func fixedPayment(principal int64, rateBasisPoints, installments int) int64 {
+	one := big.NewRat(1, 1)
+	rate := big.NewRat(int64(rateBasisPoints), basisPointsPerUnit)
+	growth := new(big.Rat).Add(one, rate)
+
+	factor := big.NewRat(1, 1)
+	for range installments {
+		factor.Mul(factor, growth)
+	}
+
+	payment := new(big.Rat).SetInt64(principal)
+	payment.Mul(payment, rate)
+	payment.Mul(payment, factor)
+	payment.Quo(payment, new(big.Rat).Sub(factor, one))
+	payment.Add(payment, big.NewRat(1, 2))
+
+	return new(big.Int).Quo(payment.Num(), payment.Denom()).Int64()
+}
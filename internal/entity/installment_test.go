@@ -0,0 +1,73 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_InstallmentPlan_Split(t *testing.T) {
+	price := Money{Amount: 129999, Currency: "USD"}
+
+	t.Run("Interest-free split evenly", func(t *testing.T) {
+		plan := InstallmentPlan{Installments: 3, MinInstallment: Money{Amount: 1000, Currency: "USD"}}
+
+		installment, ok := plan.Split(price)
+
+		assert.True(t, ok)
+		assert.True(t, installment.InterestFree())
+		assert.Equal(t, Money{Amount: 43333, Currency: "USD"}, installment.Amount)
+		assert.Equal(t, Money{Amount: 43333, Currency: "USD"}, installment.FirstAmount)
+		assert.Equal(t, price, installment.Total)
+	})
+
+	t.Run("Interest-free first installment carries the remainder", func(t *testing.T) {
+		plan := InstallmentPlan{Installments: 12, MinInstallment: Money{Amount: 1000, Currency: "USD"}}
+
+		installment, ok := plan.Split(price)
+
+		assert.True(t, ok)
+		assert.Equal(t, 12, installment.Quantity)
+		assert.Equal(t, Money{Amount: 10833, Currency: "USD"}, installment.Amount)
+		assert.Equal(t, Money{Amount: 10836, Currency: "USD"}, installment.FirstAmount)
+		assert.Equal(t, price, installment.Total)
+	})
+
+	t.Run("With interest", func(t *testing.T) {
+		plan := InstallmentPlan{Installments: 12, InterestRate: 199, MinInstallment: Money{Amount: 1000, Currency: "USD"}}
+
+		installment, ok := plan.Split(price)
+
+		assert.True(t, ok)
+		assert.False(t, installment.InterestFree())
+		assert.Equal(t, Money{Amount: 12285, Currency: "USD"}, installment.Amount)
+		assert.Equal(t, installment.Amount, installment.FirstAmount)
+		assert.Equal(t, Money{Amount: 147420, Currency: "USD"}, installment.Total)
+	})
+
+	t.Run("Rounds the installment half up", func(t *testing.T) {
+		plan := InstallmentPlan{Installments: 2, InterestRate: 100, MinInstallment: Money{Currency: "USD"}}
+
+		installment, ok := plan.Split(Money{Amount: 10000, Currency: "USD"})
+
+		assert.True(t, ok)
+		assert.Equal(t, int64(5075), installment.Amount.Amount)
+		assert.Equal(t, int64(10150), installment.Total.Amount)
+	})
+
+	t.Run("Installment below the minimum", func(t *testing.T) {
+		plan := InstallmentPlan{Installments: 12, MinInstallment: Money{Amount: 20000, Currency: "USD"}}
+
+		_, ok := plan.Split(price)
+
+		assert.False(t, ok)
+	})
+
+	t.Run("Other currency", func(t *testing.T) {
+		plan := InstallmentPlan{Installments: 3, MinInstallment: Money{Amount: 500, Currency: "BRL"}}
+
+		_, ok := plan.Split(price)
+
+		assert.False(t, ok)
+	})
+}
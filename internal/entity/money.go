@@ -0,0 +1,105 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+	"project/internal/errors"
+	"strconv"
+	"strings"
+)
+
+// maxMoneyDigits bounds the significant digits of an amount so that it
+// survives the round trip through float64 used by JSON numbers.
+const maxMoneyDigits = 15
+
+// Money is an exact amount of an ISO 4217 currency, held as an integer number
+// of its minor unit: 1299.99 USD is Money{Amount: 129999, Currency: "USD"}
+// and 1500 JPY, which has no minor unit, is Money{Amount: 1500, Currency: "JPY"}.
+type Money struct {
+	Amount   int64  `json:"amount" db:"amount"`
+	Currency string `json:"currency" db:"currency"`
+}
+
+// NewMoney converts amount, given in major units as in a JSON number, to
+// Money. It fails when currency is not an ISO 4217 code or amount has more
+// decimal places than currency allows. As Money is the price of a product,
+// the errors.ValidationError it returns names the price and currency fields.
+func NewMoney(amount float64, currency string) (Money, error) {
+	if err := (Money{Currency: currency}).Validate(); err != nil {
+		return Money{}, err
+	}
+	decimals, _ := CurrencyDecimals(currency)
+
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return Money{}, invalidPrice(errors.RuleFormat, "amount must be a finite number")
+	}
+
+	// The shortest representation of amount is the decimal a client wrote,
+	// so 1299.99 gives 129999 without the rounding error of 1299.99 * 100.
+	whole, fraction, _ := strings.Cut(strconv.FormatFloat(amount, 'f', -1, 64), ".")
+	if len(fraction) > decimals {
+		return Money{}, invalidPrice(errors.RuleDecimals, fmt.Sprintf("%s amounts cannot have more than %d decimal places", currency, decimals))
+	}
+
+	digits := whole + fraction + strings.Repeat("0", decimals-len(fraction))
+	if len(strings.TrimLeft(digits, "-0")) > maxMoneyDigits {
+		return Money{}, invalidPrice(errors.RuleMaxDigits, fmt.Sprintf("amount must have at most %d digits", maxMoneyDigits))
+	}
+
+	minor, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return Money{}, invalidPrice(errors.RuleMaxDigits, fmt.Sprintf("amount must have at most %d digits", maxMoneyDigits))
+	}
+
+	return Money{Amount: minor, Currency: currency}, nil
+}
+
+func invalidPrice(rule, message string) error {
+	validation := &errors.ValidationError{}
+	validation.Add("price", rule, message)
+	return validation
+}
+
+// Decimals is the number of decimal places of the minor unit of the currency,
+// 2 for a currency that is not an ISO 4217 code.
+func (m Money) Decimals() int {
+	if decimals, ok := CurrencyDecimals(m.Currency); ok {
+		return decimals
+	}
+	return 2
+}
+
+// Float64 returns the amount in major units, as rendered in JSON numbers. It
+// is the float64 nearest to the exact amount, so NewMoney turns it back into
+// m.
+func (m Money) Float64() float64 {
+	return float64(m.Amount) / math.Pow10(m.Decimals())
+}
+
+// String renders m exactly, as in "1299.99 USD".
+func (m Money) String() string {
+	decimals := m.Decimals()
+	sign, digits := "", strconv.FormatInt(m.Amount, 10)
+	if m.Amount < 0 {
+		sign, digits = "-", digits[1:]
+	}
+	if decimals == 0 {
+		return sign + digits + " " + m.Currency
+	}
+
+	digits = strings.Repeat("0", max(0, decimals+1-len(digits))) + digits
+	split := len(digits) - decimals
+	return sign + digits[:split] + "." + digits[split:] + " " + m.Currency
+}
+
+func (m Money) Validate() error {
+	validation := &errors.ValidationError{}
+
+	if m.Currency == "" {
+		validation.Add("currency", errors.RuleRequired, "currency is required")
+	} else if !IsValidCurrency(m.Currency) {
+		validation.Add("currency", errors.RuleCurrency, "currency must be an ISO 4217 code")
+	}
+
+	return validation.Err()
+}
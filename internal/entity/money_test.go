@@ -0,0 +1,101 @@
+package entity
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewMoney_Success(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   float64
+		currency string
+		expected Money
+	}{
+		{"Cents", 1299.99, "USD", Money{Amount: 129999, Currency: "USD"}},
+		{"Fewer decimals than the currency", 1299.9, "USD", Money{Amount: 129990, Currency: "USD"}},
+		{"Whole amount", 1300, "BRL", Money{Amount: 130000, Currency: "BRL"}},
+		{"Currency without minor unit", 1500, "JPY", Money{Amount: 1500, Currency: "JPY"}},
+		{"Three decimal places", 12.345, "KWD", Money{Amount: 12345, Currency: "KWD"}},
+		{"Zero", 0, "USD", Money{Amount: 0, Currency: "USD"}},
+		{"Negative", -10.5, "EUR", Money{Amount: -1050, Currency: "EUR"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			money, err := NewMoney(tt.amount, tt.currency)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, money)
+		})
+	}
+}
+
+func Test_NewMoney_Validation(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   float64
+		currency string
+		expected string
+	}{
+		{"Missing currency", 10, "", "currency is required"},
+		{"Unknown currency", 10, "XYZ", "currency must be an ISO 4217 code"},
+		{"Lowercase currency", 10, "usd", "currency must be an ISO 4217 code"},
+		{"Fraction of a cent", 1299.999, "USD", "USD amounts cannot have more than 2 decimal places"},
+		{"Fraction of a yen", 1500.5, "JPY", "JPY amounts cannot have more than 0 decimal places"},
+		{"Too many digits", 1e15, "USD", "amount must have at most 15 digits"},
+		{"Not a number", math.NaN(), "USD", "amount must be a finite number"},
+		{"Infinite", math.Inf(1), "USD", "amount must be a finite number"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewMoney(tt.amount, tt.currency)
+
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
+
+func Test_Money_Float64RoundTrip(t *testing.T) {
+	for _, money := range []Money{
+		{Amount: 129999, Currency: "USD"},
+		{Amount: 1, Currency: "USD"},
+		{Amount: 999999999999999, Currency: "USD"},
+		{Amount: 1500, Currency: "JPY"},
+		{Amount: 12345, Currency: "KWD"},
+	} {
+		roundTrip, err := NewMoney(money.Float64(), money.Currency)
+
+		assert.NoError(t, err)
+		assert.Equal(t, money, roundTrip)
+	}
+
+	assert.Equal(t, 1299.99, Money{Amount: 129999, Currency: "USD"}.Float64())
+}
+
+func Test_Money_String(t *testing.T) {
+	assert.Equal(t, "1299.99 USD", Money{Amount: 129999, Currency: "USD"}.String())
+	assert.Equal(t, "0.05 USD", Money{Amount: 5, Currency: "USD"}.String())
+	assert.Equal(t, "-10.50 EUR", Money{Amount: -1050, Currency: "EUR"}.String())
+	assert.Equal(t, "1500 JPY", Money{Amount: 1500, Currency: "JPY"}.String())
+	assert.Equal(t, "0.007 KWD", Money{Amount: 7, Currency: "KWD"}.String())
+}
+
+func Test_CurrencyDecimals(t *testing.T) {
+	for code, expected := range map[string]int{"USD": 2, "BRL": 2, "JPY": 0, "CLP": 0, "KWD": 3, "CLF": 4} {
+		decimals, ok := CurrencyDecimals(code)
+
+		assert.True(t, ok, code)
+		assert.Equal(t, expected, decimals, code)
+	}
+
+	_, ok := CurrencyDecimals("XAU")
+	assert.False(t, ok)
+	assert.True(t, IsValidCurrency("EUR"))
+	assert.False(t, IsValidCurrency("eur"))
+	assert.Contains(t, Currencies(), "ARS")
+	assert.IsIncreasing(t, Currencies())
+}
@@ -1,7 +1,7 @@
 package entity
 
 import (
-	"fmt"
+	"project/internal/errors"
 	"time"
 )
 
@@ -12,22 +12,35 @@ const (
 )
 
 type Product struct {
-	ID          string    `json:"id" db:"id"`
-	Title       string    `json:"title" db:"title"`
-	Description string    `json:"description" db:"description"`
-	Price       float64   `json:"price" db:"price"`
-	Currency    string    `json:"currency" db:"currency"`
-	Condition   string    `json:"condition" db:"condition"`
-	Stock       int       `json:"stock" db:"stock"`
-	SellerID    string    `json:"seller_id" db:"seller_id"`
-	SellerName  string    `json:"seller_name" db:"seller_name"`
-	Category    string    `json:"category" db:"category"`
-	Thumbnail   string    `json:"thumbnail" db:"thumbnail"`
-	CreatedAt   time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+	ID               string            `json:"id" db:"id"`
+	Title            string            `json:"title" db:"title"`
+	Description      string            `json:"description" db:"description"`
+	Price            Money             `json:"price" db:"price"`
+	Condition        string            `json:"condition" db:"condition"`
+	Stock            int               `json:"stock" db:"stock"`
+	Status           string            `json:"status" db:"status"`
+	PublishAt        *time.Time        `json:"publish_at,omitempty" db:"publish_at"`
+	ExpiresAt        *time.Time        `json:"expires_at,omitempty" db:"expires_at"`
+	SellerID         string            `json:"seller_id" db:"seller_id"`
+	SellerName       string            `json:"seller_name" db:"seller_name"`
+	SellerReputation SellerReputation  `json:"seller_reputation" db:"seller"`
+	Category         string            `json:"category" db:"category"`
+	CategoryID       *int64            `json:"category_id,omitempty" db:"category_id"`
+	Attributes       ProductAttributes `json:"attributes,omitempty" db:"attributes"`
+	Variations       []Variation       `json:"variations,omitempty" db:"-"`
+	Shipping         ShippingProfile   `json:"shipping" db:"shipping"`
+	Thumbnail        string            `json:"thumbnail" db:"thumbnail"`
+	CreatedAt        time.Time         `json:"created_at" db:"created_at"`
+	UpdatedAt        time.Time         `json:"updated_at" db:"updated_at"`
+	DeletedAt        *time.Time        `json:"deleted_at,omitempty" db:"deleted_at"`
+	ProductRating
+
+	// StatusChanges are the status transitions made since the product was
+	// loaded, recorded when it is stored.
+	StatusChanges []StatusChange `json:"-" db:"-"`
 }
 
-func NewProduct(id, title, description string, price float64, currency, condition string, stock int, sellerID, sellerName, category string) (*Product, error) {
+func NewProduct(id, title, description string, price Money, condition string, stock int, sellerID, sellerName, category string, attributes ProductAttributes, shipping ShippingProfile) (*Product, error) {
 
 	now := time.Now()
 
@@ -36,12 +49,14 @@ func NewProduct(id, title, description string, price float64, currency, conditio
 		Title:       title,
 		Description: description,
 		Price:       price,
-		Currency:    currency,
 		Condition:   condition,
 		Stock:       stock,
+		Status:      ProductActive,
 		SellerID:    sellerID,
 		SellerName:  sellerName,
 		Category:    category,
+		Attributes:  attributes,
+		Shipping:    shipping,
 		CreatedAt:   now,
 		UpdatedAt:   now,
 	}
@@ -53,32 +68,71 @@ func NewProduct(id, title, description string, price float64, currency, conditio
 	return product, nil
 }
 
+// IsValidCondition reports whether condition is one of New, Used or Refurbished.
+func IsValidCondition(condition string) bool {
+	return condition == New || condition == Used || condition == Refurbished
+}
+
+// IsDeleted reports whether the product was soft deleted.
+func (p *Product) IsDeleted() bool {
+	return p.DeletedAt != nil
+}
+
+// Validate checks every field of the product and reports all the failing ones
+// in an errors.ValidationError. Attributes are only checked for empty values
+// here; ValidateProductAttributes checks them against their category. The
+// variations are checked by Variation.Validate and ValidateVariations.
 func (p *Product) Validate() error {
-	if p.Title == "" {
-		return fmt.Errorf("title is required")
+	validation := &errors.ValidationError{}
+
+	if p.ID == "" {
+		validation.Add("id", errors.RuleRequired, "id is required")
 	}
 
-	if p.Price < 0 {
-		return fmt.Errorf("price must be greater than or equal to 0")
+	if p.Title == "" {
+		validation.Add("title", errors.RuleRequired, "title is required")
 	}
 
-	if p.Currency == "" {
-		return fmt.Errorf("currency is required")
+	if p.Price.Amount < 0 {
+		validation.Add("price", errors.RuleMin, "price must be greater than or equal to 0")
 	}
 
-	if p.Condition != New && p.Condition != Used && p.Condition != Refurbished {
-		return fmt.Errorf("condition must be 'new', 'used', or 'refurbished'")
+	validation.Merge("", p.Price.Validate())
+
+	if !IsValidCondition(p.Condition) {
+		validation.Add("condition", errors.RuleOneOf, "condition must be 'new', 'used', or 'refurbished'")
 	}
 
 	if p.Stock < 0 {
-		return fmt.Errorf("stock must be greater than or equal to 0")
+		validation.Add("stock", errors.RuleMin, "stock must be greater than or equal to 0")
 	}
 
 	if p.SellerID == "" {
-		return fmt.Errorf("seller_id is required")
+		validation.Add("seller_id", errors.RuleRequired, "seller_id is required")
 	}
 
-	return nil
+	if err := ValidateCategoryPath(p.Category); err != nil {
+		validation.Add("category", errors.RuleFormat, err.Error())
+	}
+
+	for _, name := range p.Attributes.names() {
+		if p.Attributes[name] == "" {
+			field := "attributes." + name
+			validation.Add(field, errors.RuleRequired, field+" must not be empty")
+		}
+	}
+
+	validation.Merge("shipping.", p.Shipping.Validate())
+
+	return validation.Err()
+}
+
+// ProductSearchResult is a product matched by a full-text search, with the
+// matched terms highlighted.
+type ProductSearchResult struct {
+	Product
+	HighlightedTitle string `json:"highlighted_title" db:"highlighted_title"`
+	Snippet          string `json:"snippet" db:"snippet"`
 }
 
 type ProductImage struct {
@@ -103,17 +157,19 @@ func NewProductImage(productID, imageURL string, displayOrder int) (*ProductImag
 }
 
 func (p *ProductImage) Validate() error {
+	validation := &errors.ValidationError{}
+
 	if p.ProductID == "" {
-		return fmt.Errorf("product_id is required")
+		validation.Add("product_id", errors.RuleRequired, "product_id is required")
 	}
 
 	if p.ImageURL == "" {
-		return fmt.Errorf("image_url is required")
+		validation.Add("image_url", errors.RuleRequired, "image_url is required")
 	}
 
 	if p.DisplayOrder < 0 {
-		return fmt.Errorf("display_order must be greater than or equal to 0")
+		validation.Add("display_order", errors.RuleMin, "display_order must be greater than or equal to 0")
 	}
 
-	return nil
+	return validation.Err()
 }
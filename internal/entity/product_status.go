@@ -0,0 +1,213 @@
+package entity
+
+import (
+	"fmt"
+	"project/internal/errors"
+	"time"
+)
+
+// Listing states. A product starts active, the only state shown to buyers. A
+// paused listing can be activated again, a listing under review waits for an
+// administrator, a scheduled one waits for its PublishAt and a closed listing
+// is final.
+const (
+	ProductActive      = "active"
+	ProductPaused      = "paused"
+	ProductClosed      = "closed"
+	ProductUnderReview = "under_review"
+	ProductScheduled   = "scheduled"
+)
+
+// Who changed the status of a listing. The system pauses listings that run
+// out of stock.
+const (
+	ActorAdmin  = "admin"
+	ActorSeller = "seller"
+	ActorSystem = "system"
+)
+
+// Reasons of the changes made by the system: pausing a listing without stock,
+// and scheduling, publishing and expiring a listing by its publishing window.
+const (
+	ReasonOutOfStock = "out of stock"
+	ReasonScheduled  = "scheduled"
+	ReasonPublished  = "published"
+	ReasonExpired    = "expired"
+)
+
+// productStatusTransitions lists the states each state can change to.
+var productStatusTransitions = map[string][]string{
+	ProductActive:      {ProductPaused, ProductClosed, ProductUnderReview, ProductScheduled},
+	ProductPaused:      {ProductActive, ProductClosed, ProductUnderReview, ProductScheduled},
+	ProductUnderReview: {ProductActive, ProductPaused, ProductClosed},
+	ProductScheduled:   {ProductActive, ProductPaused, ProductClosed, ProductUnderReview},
+	ProductClosed:      {},
+}
+
+// IsValidProductStatus reports whether status is one of the listing states.
+func IsValidProductStatus(status string) bool {
+	_, ok := productStatusTransitions[status]
+	return ok
+}
+
+// CanChangeProductStatus reports whether a listing in state from may change
+// to state to.
+func CanChangeProductStatus(from, to string) bool {
+	for _, next := range productStatusTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// StatusChange records a transition of the status of a product: who made it,
+// when and why. ActorID is the seller for ActorSeller and empty otherwise.
+type StatusChange struct {
+	ID        int64     `json:"id" db:"id"`
+	ProductID string    `json:"product_id" db:"product_id"`
+	From      string    `json:"from" db:"from_status"`
+	To        string    `json:"to" db:"to_status"`
+	Actor     string    `json:"actor" db:"actor"`
+	ActorID   string    `json:"actor_id,omitempty" db:"actor_id"`
+	Reason    string    `json:"reason,omitempty" db:"reason"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// ChangeStatus moves the product to status to at the given time, recording
+// the change in StatusChanges. Changing to the current status does nothing.
+// An unknown status is a validation error; a transition the state machine
+// does not allow, activating a listing without stock or scheduling one
+// without a future PublishAt is ErrInvalidStatusTransition.
+func (p *Product) ChangeStatus(to, actor, actorID, reason string, at time.Time) error {
+	if !IsValidProductStatus(to) {
+		validation := &errors.ValidationError{}
+		validation.Add("status", errors.RuleOneOf, "status must be 'active', 'paused', 'closed', 'under_review', or 'scheduled'")
+		return validation
+	}
+
+	if to == p.Status {
+		return nil
+	}
+
+	if !CanChangeProductStatus(p.Status, to) {
+		if p.Status == ProductClosed {
+			return errors.NewStatusTransitionError("A closed listing cannot change status")
+		}
+		return errors.NewStatusTransitionError(fmt.Sprintf("A listing cannot change from %s to %s", p.Status, to))
+	}
+
+	if to == ProductActive && p.Stock == 0 {
+		return errors.NewStatusTransitionError("A listing without stock cannot be activated")
+	}
+
+	if to == ProductScheduled && (p.PublishAt == nil || !p.PublishAt.After(at)) {
+		return errors.NewStatusTransitionError("Only a listing with a future publish_at can be scheduled")
+	}
+
+	p.StatusChanges = append(p.StatusChanges, StatusChange{
+		ProductID: p.ID,
+		From:      p.Status,
+		To:        to,
+		Actor:     actor,
+		ActorID:   actorID,
+		Reason:    reason,
+		CreatedAt: at,
+	})
+	p.Status = to
+	p.UpdatedAt = at
+
+	return nil
+}
+
+// PauseIfOutOfStock pauses an active listing without stock on behalf of the
+// system. Restocking does not activate it again; the seller does.
+func (p *Product) PauseIfOutOfStock(at time.Time) {
+	if p.Status != ProductActive || p.Stock > 0 {
+		return
+	}
+
+	// An active listing can always be paused.
+	_ = p.ChangeStatus(ProductPaused, ActorSystem, "", ReasonOutOfStock, at)
+}
+
+// SetSchedule sets the publishing window of the product at now. expiresAt
+// must come after publishAt and, unless it is the stored one, after now. A
+// new future publishAt schedules an active or paused listing on behalf of the
+// system; ApplySchedule publishes and expires it.
+func (p *Product) SetSchedule(publishAt, expiresAt *time.Time, now time.Time) error {
+	validation := &errors.ValidationError{}
+	if expiresAt != nil {
+		if publishAt != nil && !expiresAt.After(*publishAt) {
+			validation.Add("expires_at", errors.RuleMin, "expires_at must be after publish_at")
+		} else if !sameTime(expiresAt, p.ExpiresAt) && !expiresAt.After(now) {
+			validation.Add("expires_at", errors.RuleMin, "expires_at must be in the future")
+		}
+	}
+	if err := validation.Err(); err != nil {
+		return err
+	}
+
+	rescheduled := publishAt != nil && !sameTime(publishAt, p.PublishAt) && publishAt.After(now)
+	p.PublishAt, p.ExpiresAt = utcTime(publishAt), utcTime(expiresAt)
+
+	if rescheduled && (p.Status == ProductActive || p.Status == ProductPaused) {
+		// PublishAt is in the future, so the listing can be scheduled.
+		_ = p.ChangeStatus(ProductScheduled, ActorSystem, "", ReasonScheduled, now)
+	}
+
+	return nil
+}
+
+// ListingStatus is the status the publishing window gives the product at
+// now: a listing past its ExpiresAt is closed, and a scheduled one past its
+// PublishAt is active, or paused when it has no stock. Otherwise it is the
+// stored status.
+func (p *Product) ListingStatus(now time.Time) string {
+	switch {
+	case p.Status != ProductClosed && p.ExpiresAt != nil && !now.Before(*p.ExpiresAt):
+		return ProductClosed
+	case p.Status == ProductScheduled && (p.PublishAt == nil || !now.Before(*p.PublishAt)):
+		if p.Stock > 0 {
+			return ProductActive
+		}
+		return ProductPaused
+	}
+	return p.Status
+}
+
+// ApplySchedule moves the product, on behalf of the system, to the status its
+// publishing window gives at now.
+func (p *Product) ApplySchedule(now time.Time) {
+	to := p.ListingStatus(now)
+	if to == p.Status {
+		return
+	}
+
+	reason := ReasonPublished
+	switch to {
+	case ProductClosed:
+		reason = ReasonExpired
+	case ProductPaused:
+		reason = ReasonOutOfStock
+	}
+
+	// Every state but closed can be closed, and a scheduled listing can be
+	// activated with stock or paused without.
+	_ = p.ChangeStatus(to, ActorSystem, "", reason, now)
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+func utcTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
@@ -0,0 +1,265 @@
+package entity
+
+import (
+	"project/internal/errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CanChangeProductStatus(t *testing.T) {
+	tests := []struct {
+		from     string
+		to       string
+		expected bool
+	}{
+		{ProductActive, ProductPaused, true},
+		{ProductActive, ProductClosed, true},
+		{ProductActive, ProductUnderReview, true},
+		{ProductPaused, ProductActive, true},
+		{ProductPaused, ProductClosed, true},
+		{ProductUnderReview, ProductActive, true},
+		{ProductUnderReview, ProductClosed, true},
+		{ProductClosed, ProductActive, false},
+		{ProductClosed, ProductPaused, false},
+		{ProductClosed, ProductUnderReview, false},
+		{ProductPaused, ProductScheduled, true},
+		{ProductScheduled, ProductActive, true},
+		{ProductUnderReview, ProductScheduled, false},
+		{ProductClosed, ProductScheduled, false},
+		{ProductActive, "deleted", false},
+		{"", ProductActive, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from+" to "+tt.to, func(t *testing.T) {
+			assert.Equal(t, tt.expected, CanChangeProductStatus(tt.from, tt.to))
+		})
+	}
+}
+
+func Test_Product_ChangeStatus(t *testing.T) {
+	at := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	product := &Product{ID: "MLB001", Status: ProductActive, Stock: 5}
+
+	err := product.ChangeStatus(ProductPaused, ActorSeller, "SELLER001", "vacation", at)
+
+	assert.NoError(t, err)
+	assert.Equal(t, ProductPaused, product.Status)
+	assert.Equal(t, at, product.UpdatedAt)
+	assert.Equal(t, []StatusChange{{
+		ProductID: "MLB001",
+		From:      ProductActive,
+		To:        ProductPaused,
+		Actor:     ActorSeller,
+		ActorID:   "SELLER001",
+		Reason:    "vacation",
+		CreatedAt: at,
+	}}, product.StatusChanges)
+}
+
+func Test_Product_ChangeStatus_SameStatus(t *testing.T) {
+	product := &Product{ID: "MLB001", Status: ProductPaused, Stock: 5}
+
+	err := product.ChangeStatus(ProductPaused, ActorAdmin, "", "", time.Now())
+
+	assert.NoError(t, err)
+	assert.Empty(t, product.StatusChanges)
+	assert.True(t, product.UpdatedAt.IsZero())
+}
+
+func Test_Product_ChangeStatus_Rejected(t *testing.T) {
+	tests := []struct {
+		name     string
+		product  Product
+		to       string
+		expected error
+		message  string
+	}{
+		{"Unknown status", Product{Status: ProductActive, Stock: 5}, "deleted", errors.ErrValidation, "status must be 'active', 'paused', 'closed', 'under_review', or 'scheduled'"},
+		{"Closed listing", Product{Status: ProductClosed, Stock: 5}, ProductActive, errors.ErrInvalidStatusTransition, "A closed listing cannot change status"},
+		{"Activating without stock", Product{Status: ProductPaused, Stock: 0}, ProductActive, errors.ErrInvalidStatusTransition, "A listing without stock cannot be activated"},
+		{"Scheduling without publish_at", Product{Status: ProductPaused, Stock: 5}, ProductScheduled, errors.ErrInvalidStatusTransition, "Only a listing with a future publish_at can be scheduled"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product := tt.product
+
+			err := product.ChangeStatus(tt.to, ActorAdmin, "", "", time.Now())
+
+			assert.ErrorIs(t, err, tt.expected)
+			assert.Equal(t, tt.message, errors.GetUserFriendlyMessage(err, errors.GetStatusCode(err)))
+			assert.Equal(t, tt.product.Status, product.Status)
+			assert.Empty(t, product.StatusChanges)
+		})
+	}
+}
+
+func Test_Product_PauseIfOutOfStock(t *testing.T) {
+	at := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		status   string
+		stock    int
+		expected string
+		recorded bool
+	}{
+		{"Active without stock", ProductActive, 0, ProductPaused, true},
+		{"Active with stock", ProductActive, 3, ProductActive, false},
+		{"Under review without stock", ProductUnderReview, 0, ProductUnderReview, false},
+		{"Closed without stock", ProductClosed, 0, ProductClosed, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product := &Product{ID: "MLB001", Status: tt.status, Stock: tt.stock}
+
+			product.PauseIfOutOfStock(at)
+
+			assert.Equal(t, tt.expected, product.Status)
+			if !tt.recorded {
+				assert.Empty(t, product.StatusChanges)
+				return
+			}
+			assert.Len(t, product.StatusChanges, 1)
+			assert.Equal(t, ActorSystem, product.StatusChanges[0].Actor)
+			assert.Equal(t, ReasonOutOfStock, product.StatusChanges[0].Reason)
+		})
+	}
+}
+
+func Test_Product_SetSchedule(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	publishAt := time.Date(2024, 3, 2, 0, 0, 0, 0, time.FixedZone("BRT", -3*60*60))
+	expiresAt := publishAt.Add(30 * 24 * time.Hour)
+	product := &Product{ID: "MLB001", Status: ProductActive, Stock: 5}
+
+	err := product.SetSchedule(&publishAt, &expiresAt, now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, ProductScheduled, product.Status)
+	assert.Equal(t, publishAt.UTC(), *product.PublishAt)
+	assert.Equal(t, expiresAt.UTC(), *product.ExpiresAt)
+	assert.Len(t, product.StatusChanges, 1)
+	assert.Equal(t, ActorSystem, product.StatusChanges[0].Actor)
+	assert.Equal(t, ReasonScheduled, product.StatusChanges[0].Reason)
+
+	// The same window does not schedule the listing again.
+	product.Status, product.StatusChanges = ProductActive, nil
+	assert.NoError(t, product.SetSchedule(&publishAt, &expiresAt, now))
+	assert.Equal(t, ProductActive, product.Status)
+	assert.Empty(t, product.StatusChanges)
+}
+
+func Test_Product_SetSchedule_PastPublishAt(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	publishAt := now.Add(-time.Hour)
+	product := &Product{ID: "MLB001", Status: ProductActive, Stock: 5}
+
+	assert.NoError(t, product.SetSchedule(&publishAt, nil, now))
+
+	assert.Equal(t, ProductActive, product.Status)
+	assert.Empty(t, product.StatusChanges)
+}
+
+func Test_Product_SetSchedule_Invalid(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	past, publishAt := now.Add(-time.Hour), now.Add(24*time.Hour)
+	beforePublishing := publishAt.Add(-time.Minute)
+
+	tests := []struct {
+		name      string
+		publishAt *time.Time
+		expiresAt *time.Time
+		stored    *time.Time
+		message   string
+	}{
+		{"Expiring before publishing", &publishAt, &beforePublishing, nil, "expires_at must be after publish_at"},
+		{"Expiring at publishing", &publishAt, &publishAt, nil, "expires_at must be after publish_at"},
+		{"Expiring in the past", nil, &past, nil, "expires_at must be in the future"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product := &Product{ID: "MLB001", Status: ProductActive, Stock: 5, ExpiresAt: tt.stored}
+
+			err := product.SetSchedule(tt.publishAt, tt.expiresAt, now)
+
+			var validation *errors.ValidationError
+			assert.ErrorAs(t, err, &validation)
+			assert.Equal(t, "expires_at", validation.Fields[0].Field)
+			assert.Equal(t, tt.message, validation.Fields[0].Message)
+			assert.Equal(t, ProductActive, product.Status)
+			assert.Nil(t, product.PublishAt)
+		})
+	}
+
+	// A stored expires_at already in the past is kept.
+	product := &Product{ID: "MLB001", Status: ProductClosed, ExpiresAt: &past}
+	assert.NoError(t, product.SetSchedule(nil, &past, now))
+}
+
+func Test_Product_ListingStatus(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	past, future := now.Add(-time.Minute), now.Add(time.Minute)
+
+	tests := []struct {
+		name     string
+		product  Product
+		expected string
+	}{
+		{"Active", Product{Status: ProductActive, Stock: 5, ExpiresAt: &future}, ProductActive},
+		{"Expired", Product{Status: ProductActive, Stock: 5, ExpiresAt: &past}, ProductClosed},
+		{"Expiring now", Product{Status: ProductPaused, Stock: 5, ExpiresAt: &now}, ProductClosed},
+		{"Scheduled", Product{Status: ProductScheduled, Stock: 5, PublishAt: &future}, ProductScheduled},
+		{"Published", Product{Status: ProductScheduled, Stock: 5, PublishAt: &now}, ProductActive},
+		{"Published without stock", Product{Status: ProductScheduled, Stock: 0, PublishAt: &past}, ProductPaused},
+		{"Published and expired", Product{Status: ProductScheduled, Stock: 5, PublishAt: &past, ExpiresAt: &past}, ProductClosed},
+		{"Paused past publish_at", Product{Status: ProductPaused, Stock: 5, PublishAt: &past}, ProductPaused},
+		{"Closed", Product{Status: ProductClosed, ExpiresAt: &past}, ProductClosed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.product.ListingStatus(now))
+		})
+	}
+}
+
+func Test_Product_ApplySchedule(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Minute)
+
+	tests := []struct {
+		name     string
+		product  Product
+		expected string
+		reason   string
+	}{
+		{"Published", Product{Status: ProductScheduled, Stock: 5, PublishAt: &past}, ProductActive, ReasonPublished},
+		{"Published without stock", Product{Status: ProductScheduled, PublishAt: &past}, ProductPaused, ReasonOutOfStock},
+		{"Expired", Product{Status: ProductUnderReview, Stock: 5, ExpiresAt: &past}, ProductClosed, ReasonExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product := tt.product
+			product.ID = "MLB001"
+
+			product.ApplySchedule(now)
+
+			assert.Equal(t, tt.expected, product.Status)
+			assert.Equal(t, now, product.UpdatedAt)
+			assert.Len(t, product.StatusChanges, 1)
+			assert.Equal(t, ActorSystem, product.StatusChanges[0].Actor)
+			assert.Equal(t, tt.reason, product.StatusChanges[0].Reason)
+		})
+	}
+
+	product := &Product{ID: "MLB001", Status: ProductActive, Stock: 5}
+	product.ApplySchedule(now)
+	assert.Empty(t, product.StatusChanges)
+}
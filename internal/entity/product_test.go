@@ -2,31 +2,40 @@ package entity
 
 import (
 	"fmt"
+	"project/internal/errors"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 )
 
+func priceUSD(cents int64) Money {
+	return Money{Amount: cents, Currency: "USD"}
+}
+
 func Test_NewProduct_Success(t *testing.T) {
 	product, err := NewProduct(
 		"MLB001",
 		"iPhone 15 Pro Max",
 		"Latest Apple smartphone with A17 Pro chip",
-		1299.99,
-		"USD",
+		Money{Amount: 129999, Currency: "USD"},
 		New,
 		25,
 		"seller-001",
 		"Apple Store",
 		"Electronics",
+		ProductAttributes{"brand": "Apple"},
+		ShippingProfile{WeightGrams: 240, LengthCm: 18, WidthCm: 10, HeightCm: 6, OriginZip: "01310100"},
 	)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, product)
 	assert.Equal(t, "iPhone 15 Pro Max", product.Title)
-	assert.Equal(t, 1299.99, product.Price)
+	assert.Equal(t, Money{Amount: 129999, Currency: "USD"}, product.Price)
 	assert.Equal(t, New, product.Condition)
+	assert.Equal(t, ProductActive, product.Status)
+	assert.Equal(t, ProductAttributes{"brand": "Apple"}, product.Attributes)
+	assert.Equal(t, "01310100", product.Shipping.OriginZip)
 	assert.True(t, strings.HasPrefix(product.ID, "MLB"))
 	assert.False(t, product.CreatedAt.IsZero())
 	assert.False(t, product.UpdatedAt.IsZero())
@@ -44,7 +53,7 @@ func Test_NewProduct_AllConditions(t *testing.T) {
 
 	for idx, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			product, err := NewProduct(fmt.Sprintf("%s%d", "MLB00", idx), "Test", "Desc", 99.99, "USD", tt.condition, 10, "seller-001", "Seller", "Cat")
+			product, err := NewProduct(fmt.Sprintf("%s%d", "MLB00", idx), "Test", "Desc", priceUSD(9999), tt.condition, 10, "seller-001", "Seller", "Cat", nil, ShippingProfile{})
 			assert.NoError(t, err)
 			assert.Equal(t, tt.condition, product.Condition)
 		})
@@ -52,8 +61,8 @@ func Test_NewProduct_AllConditions(t *testing.T) {
 }
 
 func Test_NewProduct_UniqueIDs(t *testing.T) {
-	product1, _ := NewProduct("MLB001", "Product 1", "Desc", 10.0, "USD", New, 5, "seller1", "Seller", "Cat")
-	product2, _ := NewProduct("MLB002", "Product 2", "Desc", 20.0, "USD", New, 10, "seller2", "Seller", "Cat")
+	product1, _ := NewProduct("MLB001", "Product 1", "Desc", priceUSD(1000), New, 5, "seller1", "Seller", "Cat", nil, ShippingProfile{})
+	product2, _ := NewProduct("MLB002", "Product 2", "Desc", priceUSD(2000), New, 10, "seller2", "Seller", "Cat", nil, ShippingProfile{})
 
 	assert.NotEqual(t, product1.ID, product2.ID)
 }
@@ -62,24 +71,24 @@ func Test_NewProduct_ValidationErrors(t *testing.T) {
 	tests := []struct {
 		name      string
 		title     string
-		price     float64
-		currency  string
+		price     Money
 		condition string
 		stock     int
 		sellerID  string
 		wantErr   string
 	}{
-		{"Empty title", "", 99.99, "USD", New, 10, "seller-001", "title is required"},
-		{"Negative price", "Product", -10.0, "USD", New, 10, "seller-001", "price must be greater than or equal to 0"},
-		{"Empty currency", "Product", 99.99, "", New, 10, "seller-001", "currency is required"},
-		{"Invalid condition", "Product", 99.99, "USD", "broken", 10, "seller-001", "condition must be 'new', 'used', or 'refurbished'"},
-		{"Negative stock", "Product", 99.99, "USD", New, -5, "seller-001", "stock must be greater than or equal to 0"},
-		{"Empty seller ID", "Product", 99.99, "USD", New, 10, "", "seller_id is required"},
+		{"Empty title", "", priceUSD(9999), New, 10, "seller-001", "title is required"},
+		{"Negative price", "Product", priceUSD(-1000), New, 10, "seller-001", "price must be greater than or equal to 0"},
+		{"Empty currency", "Product", Money{Amount: 9999}, New, 10, "seller-001", "currency is required"},
+		{"Unknown currency", "Product", Money{Amount: 9999, Currency: "XYZ"}, New, 10, "seller-001", "currency must be an ISO 4217 code"},
+		{"Invalid condition", "Product", priceUSD(9999), "broken", 10, "seller-001", "condition must be 'new', 'used', or 'refurbished'"},
+		{"Negative stock", "Product", priceUSD(9999), New, -5, "seller-001", "stock must be greater than or equal to 0"},
+		{"Empty seller ID", "Product", priceUSD(9999), New, 10, "", "seller_id is required"},
 	}
 
 	for idx, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			product, err := NewProduct(fmt.Sprintf("%s%d", "MLB00", idx), tt.title, "Desc", tt.price, tt.currency, tt.condition, tt.stock, tt.sellerID, "Seller", "Cat")
+			product, err := NewProduct(fmt.Sprintf("%s%d", "MLB00", idx), tt.title, "Desc", tt.price, tt.condition, tt.stock, tt.sellerID, "Seller", "Cat", nil, ShippingProfile{})
 			assert.Error(t, err)
 			assert.Nil(t, product)
 			assert.Equal(t, tt.wantErr, err.Error())
@@ -87,21 +96,46 @@ func Test_NewProduct_ValidationErrors(t *testing.T) {
 	}
 }
 
+func Test_NewProduct_ReportsEveryInvalidField(t *testing.T) {
+	product, err := NewProduct("MLB001", "", "Desc", Money{Amount: -1, Currency: "XYZ"}, "broken", 10, "", "Seller", "Electronics > ", nil, ShippingProfile{WeightGrams: -1, OriginZip: "0131"})
+
+	assert.Nil(t, product)
+	assert.ErrorIs(t, err, errors.ErrValidation)
+	assert.Equal(t, []errors.FieldError{
+		{Field: "title", Rule: errors.RuleRequired, Message: "title is required"},
+		{Field: "price", Rule: errors.RuleMin, Message: "price must be greater than or equal to 0"},
+		{Field: "currency", Rule: errors.RuleCurrency, Message: "currency must be an ISO 4217 code"},
+		{Field: "condition", Rule: errors.RuleOneOf, Message: "condition must be 'new', 'used', or 'refurbished'"},
+		{Field: "seller_id", Rule: errors.RuleRequired, Message: "seller_id is required"},
+		{Field: "category", Rule: errors.RuleFormat, Message: "category must be a path of names separated by ' > ', like 'Electronics > Smartphones'"},
+		{Field: "shipping.weight_grams", Rule: errors.RuleMin, Message: "shipping: weight_grams must be greater than or equal to 0"},
+		{Field: "shipping.origin_zip", Rule: errors.RuleFormat, Message: "shipping: origin_zip must be a zip code of 8 digits"},
+	}, errors.GetErrorDetails(err))
+}
+
+func Test_NewProduct_EmptyID(t *testing.T) {
+	product, err := NewProduct("", "Product", "Desc", priceUSD(9999), New, 10, "seller-001", "Seller", "Cat", nil, ShippingProfile{})
+
+	assert.Error(t, err)
+	assert.Nil(t, product)
+	assert.Equal(t, "id is required", err.Error())
+}
+
 func Test_NewProduct_EdgeCases(t *testing.T) {
 	tests := []struct {
 		name        string
 		description string
-		price       float64
+		price       Money
 		stock       int
 	}{
-		{"Empty description", "", 99.99, 10},
-		{"Zero price", "Free item", 0.0, 10},
-		{"Zero stock", "Out of stock", 99.99, 0},
+		{"Empty description", "", priceUSD(9999), 10},
+		{"Zero price", "Free item", priceUSD(0), 10},
+		{"Zero stock", "Out of stock", priceUSD(9999), 0},
 	}
 
 	for idx, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			product, err := NewProduct(fmt.Sprintf("%s%d", "MLB00", idx), "Product", tt.description, tt.price, "USD", New, tt.stock, "seller-001", "Seller", "Cat")
+			product, err := NewProduct(fmt.Sprintf("%s%d", "MLB00", idx), "Product", tt.description, tt.price, New, tt.stock, "seller-001", "Seller", "Cat", nil, ShippingProfile{})
 			assert.NoError(t, err)
 			assert.NotNil(t, product)
 		})
@@ -0,0 +1,199 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+	"project/internal/errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Promotion types. A percentage promotion takes a share of the price off and
+// a fixed one takes an amount off.
+const (
+	PromotionPercentage = "percentage"
+	PromotionFixed      = "fixed"
+)
+
+// maxPercentOff is 100% in basis points, which a promotion cannot reach.
+const maxPercentOff = 10000
+
+// Promotion discounts the price of a product, or of every product of a
+// category and its subcategories, from StartsAt until just before EndsAt.
+// PercentOff is in basis points, 1500 for 15%. AmountOff only discounts the
+// prices in its currency and never below zero. When several promotions are
+// active for a product, the one with the highest Priority wins.
+type Promotion struct {
+	ID         int64     `json:"id" db:"id"`
+	ProductID  *string   `json:"product_id,omitempty" db:"product_id"`
+	CategoryID *int64    `json:"category_id,omitempty" db:"category_id"`
+	Type       string    `json:"type" db:"type"`
+	PercentOff int       `json:"percent_off" db:"percent_off_bp"`
+	AmountOff  Money     `json:"amount_off" db:"amount_off"`
+	Priority   int       `json:"priority" db:"priority"`
+	StartsAt   time.Time `json:"starts_at" db:"starts_at"`
+	EndsAt     time.Time `json:"ends_at" db:"ends_at"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+}
+
+// NewPromotion builds a promotion of discountType from value, a percentage
+// such as 15 or 12.5, or an amount of currency for a fixed promotion. Times
+// are kept in UTC.
+func NewPromotion(productID *string, categoryID *int64, discountType string, value float64, currency string, priority int, startsAt, endsAt time.Time) (*Promotion, error) {
+	promotion := &Promotion{
+		ProductID:  productID,
+		CategoryID: categoryID,
+		Type:       discountType,
+		Priority:   priority,
+		StartsAt:   startsAt.UTC(),
+		EndsAt:     endsAt.UTC(),
+		CreatedAt:  time.Now().UTC(),
+	}
+
+	validation := &errors.ValidationError{}
+
+	switch discountType {
+	case PromotionPercentage:
+		percentOff, err := percentToBasisPoints(value)
+		if err != nil {
+			validation.Add("value", errors.RuleDecimals, err.Error())
+		}
+		promotion.PercentOff = percentOff
+	case PromotionFixed:
+		amountOff, err := NewMoney(value, currency)
+		if err != nil {
+			validation.Merge("", renamePriceField(err))
+			amountOff = Money{Currency: currency}
+		}
+		promotion.AmountOff = amountOff
+	}
+
+	validation.Merge("", promotion.Validate())
+
+	if err := validation.Err(); err != nil {
+		return nil, err
+	}
+
+	return promotion, nil
+}
+
+// percentToBasisPoints converts a percentage with at most two decimal places
+// to basis points, exactly.
+func percentToBasisPoints(percent float64) (int, error) {
+	if math.IsNaN(percent) || math.IsInf(percent, 0) {
+		return 0, fmt.Errorf("value must be a finite number")
+	}
+
+	_, fraction, _ := strings.Cut(strconv.FormatFloat(percent, 'f', -1, 64), ".")
+	if len(fraction) > 2 {
+		return 0, fmt.Errorf("value cannot have more than 2 decimal places")
+	}
+
+	return int(math.Round(percent * 100)), nil
+}
+
+// renamePriceField reports the errors NewMoney gives for a price as errors
+// of the value of the promotion.
+func renamePriceField(err error) error {
+	renamed := &errors.ValidationError{}
+	for _, field := range errors.GetErrorDetails(err) {
+		if field.Field == "price" {
+			field.Field = "value"
+		}
+		renamed.Add(field.Field, field.Rule, field.Message)
+	}
+	return renamed.Err()
+}
+
+func (p *Promotion) Validate() error {
+	validation := &errors.ValidationError{}
+
+	switch {
+	case p.ProductID == nil && p.CategoryID == nil:
+		validation.Add("product_id", errors.RuleRequired, "product_id or category_id is required")
+	case p.ProductID != nil && p.CategoryID != nil:
+		validation.Add("category_id", errors.RuleAllowed, "category_id cannot be set together with product_id")
+	case p.ProductID != nil && strings.TrimSpace(*p.ProductID) == "":
+		validation.Add("product_id", errors.RuleRequired, "product_id cannot be empty")
+	}
+
+	switch p.Type {
+	case PromotionPercentage:
+		if p.PercentOff <= 0 || p.PercentOff >= maxPercentOff {
+			validation.Add("value", errors.RuleMin, "value must be a percentage greater than 0 and less than 100")
+		}
+	case PromotionFixed:
+		if err := p.AmountOff.Validate(); err != nil {
+			validation.Merge("", err)
+		} else if p.AmountOff.Amount <= 0 {
+			validation.Add("value", errors.RuleMin, "value must be greater than 0")
+		}
+	default:
+		validation.Add("type", errors.RuleOneOf, "type must be '"+PromotionPercentage+"' or '"+PromotionFixed+"'")
+	}
+
+	if p.Priority < 0 {
+		validation.Add("priority", errors.RuleMin, "priority must be greater than or equal to 0")
+	}
+
+	if p.StartsAt.IsZero() {
+		validation.Add("starts_at", errors.RuleRequired, "starts_at is required")
+	}
+	if p.EndsAt.IsZero() {
+		validation.Add("ends_at", errors.RuleRequired, "ends_at is required")
+	} else if !p.EndsAt.After(p.StartsAt) {
+		validation.Add("ends_at", errors.RuleMin, "ends_at must be after starts_at")
+	}
+
+	return validation.Err()
+}
+
+// IsActive reports whether the promotion applies at t.
+func (p Promotion) IsActive(t time.Time) bool {
+	return !t.Before(p.StartsAt) && t.Before(p.EndsAt)
+}
+
+// Overlaps reports whether the promotion and other are active at some common
+// time.
+func (p Promotion) Overlaps(other Promotion) bool {
+	return p.StartsAt.Before(other.EndsAt) && other.StartsAt.Before(p.EndsAt)
+}
+
+// Apply returns price with the discount of the promotion, rounded to the
+// minor unit. It reports false when the promotion does not discount price: a
+// free price, or a fixed promotion in another currency.
+func (p Promotion) Apply(price Money) (Money, bool) {
+	if price.Amount <= 0 {
+		return price, false
+	}
+
+	discounted := price
+	switch p.Type {
+	case PromotionPercentage:
+		// amount * percent / 10000, rounded half up, without overflowing for
+		// the largest amounts.
+		whole, rest := price.Amount/maxPercentOff, price.Amount%maxPercentOff
+		off := whole*int64(p.PercentOff) + (rest*int64(p.PercentOff)+maxPercentOff/2)/maxPercentOff
+		discounted.Amount -= off
+	case PromotionFixed:
+		if p.AmountOff.Currency != price.Currency {
+			return price, false
+		}
+		discounted.Amount = max(0, price.Amount-p.AmountOff.Amount)
+	default:
+		return price, false
+	}
+
+	return discounted, discounted.Amount < price.Amount
+}
+
+// DiscountPercent is how much of original was taken off to get discounted,
+// as a percentage rounded to two decimal places.
+func DiscountPercent(original, discounted Money) float64 {
+	if original.Amount <= 0 {
+		return 0
+	}
+	percent := float64(original.Amount-discounted.Amount) / float64(original.Amount) * 100
+	return math.Round(percent*100) / 100
+}
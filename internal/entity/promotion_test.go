@@ -0,0 +1,161 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"project/internal/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	promotionStart = time.Date(2024, 11, 29, 0, 0, 0, 0, time.UTC)
+	promotionEnd   = time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC)
+)
+
+func Test_NewPromotion(t *testing.T) {
+	productID := "MLB001"
+
+	t.Run("Percentage", func(t *testing.T) {
+		promotion, err := NewPromotion(&productID, nil, PromotionPercentage, 12.5, "", 1, promotionStart, promotionEnd)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1250, promotion.PercentOff)
+		assert.Equal(t, 1, promotion.Priority)
+	})
+
+	t.Run("Fixed amount", func(t *testing.T) {
+		categoryID := int64(6)
+
+		promotion, err := NewPromotion(nil, &categoryID, PromotionFixed, 100, "USD", 0, promotionStart, promotionEnd)
+
+		assert.NoError(t, err)
+		assert.Equal(t, Money{Amount: 10000, Currency: "USD"}, promotion.AmountOff)
+	})
+
+	t.Run("Times are kept in UTC", func(t *testing.T) {
+		saoPaulo := time.FixedZone("BRT", -3*60*60)
+
+		promotion, err := NewPromotion(&productID, nil, PromotionPercentage, 10, "", 0, promotionStart.In(saoPaulo), promotionEnd)
+
+		assert.NoError(t, err)
+		assert.Equal(t, time.UTC, promotion.StartsAt.Location())
+		assert.True(t, promotion.StartsAt.Equal(promotionStart))
+	})
+
+	t.Run("Reports every invalid field", func(t *testing.T) {
+		_, err := NewPromotion(nil, nil, PromotionPercentage, 100, "", -1, promotionEnd, promotionStart)
+
+		assert.ErrorIs(t, err, errors.ErrValidation)
+		assert.Equal(t, []errors.FieldError{
+			{Field: "product_id", Rule: errors.RuleRequired, Message: "product_id or category_id is required"},
+			{Field: "value", Rule: errors.RuleMin, Message: "value must be a percentage greater than 0 and less than 100"},
+			{Field: "priority", Rule: errors.RuleMin, Message: "priority must be greater than or equal to 0"},
+			{Field: "ends_at", Rule: errors.RuleMin, Message: "ends_at must be after starts_at"},
+		}, errors.GetErrorDetails(err))
+	})
+
+	t.Run("Product and category", func(t *testing.T) {
+		categoryID := int64(6)
+
+		_, err := NewPromotion(&productID, &categoryID, PromotionPercentage, 10, "", 0, promotionStart, promotionEnd)
+
+		assert.Equal(t, []errors.FieldError{
+			{Field: "category_id", Rule: errors.RuleAllowed, Message: "category_id cannot be set together with product_id"},
+		}, errors.GetErrorDetails(err))
+	})
+
+	t.Run("Invalid type", func(t *testing.T) {
+		_, err := NewPromotion(&productID, nil, "bogo", 10, "", 0, promotionStart, promotionEnd)
+
+		assert.Equal(t, []errors.FieldError{
+			{Field: "type", Rule: errors.RuleOneOf, Message: "type must be 'percentage' or 'fixed'"},
+		}, errors.GetErrorDetails(err))
+	})
+
+	t.Run("Percentage with too many decimals", func(t *testing.T) {
+		_, err := NewPromotion(&productID, nil, PromotionPercentage, 12.345, "", 0, promotionStart, promotionEnd)
+
+		assert.Equal(t, []errors.FieldError{
+			{Field: "value", Rule: errors.RuleDecimals, Message: "value cannot have more than 2 decimal places"},
+		}, errors.GetErrorDetails(err))
+	})
+
+	t.Run("Fixed amount with too many decimals", func(t *testing.T) {
+		_, err := NewPromotion(&productID, nil, PromotionFixed, 10.005, "USD", 0, promotionStart, promotionEnd)
+
+		assert.Equal(t, []errors.FieldError{
+			{Field: "value", Rule: errors.RuleDecimals, Message: "USD amounts cannot have more than 2 decimal places"},
+		}, errors.GetErrorDetails(err))
+	})
+
+	t.Run("Fixed amount without currency", func(t *testing.T) {
+		_, err := NewPromotion(&productID, nil, PromotionFixed, 10, "", 0, promotionStart, promotionEnd)
+
+		assert.Equal(t, []errors.FieldError{
+			{Field: "currency", Rule: errors.RuleRequired, Message: "currency is required"},
+		}, errors.GetErrorDetails(err))
+	})
+}
+
+func Test_Promotion_IsActive(t *testing.T) {
+	promotion := Promotion{StartsAt: promotionStart, EndsAt: promotionEnd}
+
+	assert.False(t, promotion.IsActive(promotionStart.Add(-time.Second)))
+	assert.True(t, promotion.IsActive(promotionStart))
+	assert.True(t, promotion.IsActive(promotionEnd.Add(-time.Second)))
+	assert.False(t, promotion.IsActive(promotionEnd))
+}
+
+func Test_Promotion_Overlaps(t *testing.T) {
+	promotion := Promotion{StartsAt: promotionStart, EndsAt: promotionEnd}
+
+	assert.True(t, promotion.Overlaps(Promotion{StartsAt: promotionEnd.Add(-time.Hour), EndsAt: promotionEnd.Add(time.Hour)}))
+	assert.False(t, promotion.Overlaps(Promotion{StartsAt: promotionEnd, EndsAt: promotionEnd.Add(time.Hour)}))
+}
+
+func Test_Promotion_Apply(t *testing.T) {
+	price := Money{Amount: 129999, Currency: "USD"}
+
+	t.Run("Percentage rounds half up", func(t *testing.T) {
+		discounted, ok := Promotion{Type: PromotionPercentage, PercentOff: 1500}.Apply(price)
+
+		assert.True(t, ok)
+		assert.Equal(t, Money{Amount: 110499, Currency: "USD"}, discounted)
+	})
+
+	t.Run("Percentage of the largest amount", func(t *testing.T) {
+		discounted, ok := Promotion{Type: PromotionPercentage, PercentOff: 5000}.Apply(Money{Amount: 999999999999999, Currency: "JPY"})
+
+		assert.True(t, ok)
+		assert.Equal(t, int64(499999999999999), discounted.Amount)
+	})
+
+	t.Run("Fixed amount", func(t *testing.T) {
+		discounted, ok := Promotion{Type: PromotionFixed, AmountOff: Money{Amount: 10000, Currency: "USD"}}.Apply(price)
+
+		assert.True(t, ok)
+		assert.Equal(t, Money{Amount: 119999, Currency: "USD"}, discounted)
+	})
+
+	t.Run("Fixed amount never goes below zero", func(t *testing.T) {
+		discounted, ok := Promotion{Type: PromotionFixed, AmountOff: Money{Amount: 200000, Currency: "USD"}}.Apply(price)
+
+		assert.True(t, ok)
+		assert.Equal(t, int64(0), discounted.Amount)
+	})
+
+	t.Run("Fixed amount in another currency", func(t *testing.T) {
+		discounted, ok := Promotion{Type: PromotionFixed, AmountOff: Money{Amount: 10000, Currency: "BRL"}}.Apply(price)
+
+		assert.False(t, ok)
+		assert.Equal(t, price, discounted)
+	})
+}
+
+func Test_DiscountPercent(t *testing.T) {
+	assert.Equal(t, 15.0, DiscountPercent(Money{Amount: 129999}, Money{Amount: 110499}))
+	assert.Equal(t, 7.69, DiscountPercent(Money{Amount: 129999}, Money{Amount: 119999}))
+	assert.Equal(t, 0.0, DiscountPercent(Money{}, Money{}))
+}
@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// Question states. A question starts unanswered and becomes answered once the
+// seller replies; a hidden question is left out of public listings whatever
+// its answer.
+const (
+	QuestionUnanswered = "unanswered"
+	QuestionAnswered   = "answered"
+	QuestionHidden     = "hidden"
+)
+
+// Question is asked by a buyer on a product page and answered by the seller
+// of the product. Answer and AnsweredAt are set together.
+type Question struct {
+	ID         int64      `json:"id" db:"id"`
+	ProductID  string     `json:"product_id" db:"product_id"`
+	Text       string     `json:"text" db:"text"`
+	Status     string     `json:"status" db:"status"`
+	Answer     *string    `json:"answer,omitempty" db:"answer"`
+	AnsweredAt *time.Time `json:"answered_at,omitempty" db:"answered_at"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+}
+
+func NewQuestion(productID, text string) (*Question, error) {
+	question := &Question{
+		ProductID: productID,
+		Text:      text,
+		Status:    QuestionUnanswered,
+		CreatedAt: time.Now(),
+	}
+
+	if err := question.Validate(); err != nil {
+		return nil, err
+	}
+
+	return question, nil
+}
+
+// IsValidQuestionStatus reports whether status is one of the question states.
+func IsValidQuestionStatus(status string) bool {
+	return status == QuestionUnanswered || status == QuestionAnswered || status == QuestionHidden
+}
+
+func (q *Question) Validate() error {
+	if q.ProductID == "" {
+		return fmt.Errorf("product_id is required")
+	}
+
+	if q.Text == "" {
+		return fmt.Errorf("text is required")
+	}
+
+	if !IsValidQuestionStatus(q.Status) {
+		return fmt.Errorf("status must be 'unanswered', 'answered', or 'hidden'")
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewQuestion_Success(t *testing.T) {
+	question, err := NewQuestion("MLB001", "Is it unlocked?")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "MLB001", question.ProductID)
+	assert.Equal(t, QuestionUnanswered, question.Status)
+	assert.Nil(t, question.Answer)
+	assert.False(t, question.CreatedAt.IsZero())
+}
+
+func Test_NewQuestion_Validation(t *testing.T) {
+	tests := []struct {
+		name      string
+		productID string
+		text      string
+		expected  string
+	}{
+		{"Missing product", "", "Is it unlocked?", "product_id is required"},
+		{"Missing text", "MLB001", "", "text is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			question, err := NewQuestion(tt.productID, tt.text)
+
+			assert.Nil(t, question)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
+
+func Test_Question_ValidateStatus(t *testing.T) {
+	question := Question{ProductID: "MLB001", Text: "Is it unlocked?", Status: "deleted"}
+
+	assert.EqualError(t, question.Validate(), "status must be 'unanswered', 'answered', or 'hidden'")
+
+	for _, status := range []string{QuestionUnanswered, QuestionAnswered, QuestionHidden} {
+		question.Status = status
+		assert.NoError(t, question.Validate())
+	}
+}
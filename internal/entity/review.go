@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	MinRating = 1
+	MaxRating = 5
+)
+
+// Review is the rating a buyer gave a product, with an optional title and
+// comment.
+type Review struct {
+	ID        int64     `json:"id" db:"id"`
+	ProductID string    `json:"product_id" db:"product_id"`
+	Rating    int       `json:"rating" db:"rating"`
+	Title     string    `json:"title" db:"title"`
+	Comment   string    `json:"comment" db:"comment"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+func NewReview(productID string, rating int, title, comment string) (*Review, error) {
+	review := &Review{
+		ProductID: productID,
+		Rating:    rating,
+		Title:     title,
+		Comment:   comment,
+		CreatedAt: time.Now(),
+	}
+
+	if err := review.Validate(); err != nil {
+		return nil, err
+	}
+
+	return review, nil
+}
+
+func (r *Review) Validate() error {
+	if r.ProductID == "" {
+		return fmt.Errorf("product_id is required")
+	}
+
+	if r.Rating < MinRating || r.Rating > MaxRating {
+		return fmt.Errorf("rating must be between %d and %d", MinRating, MaxRating)
+	}
+
+	return nil
+}
+
+// ProductRating counts the reviews of a product per number of stars. The
+// counters are kept on the product and incremented as reviews are added, so
+// reading the rating never scans the reviews.
+type ProductRating struct {
+	OneStar    int `json:"one_star" db:"rating_1"`
+	TwoStars   int `json:"two_stars" db:"rating_2"`
+	ThreeStars int `json:"three_stars" db:"rating_3"`
+	FourStars  int `json:"four_stars" db:"rating_4"`
+	FiveStars  int `json:"five_stars" db:"rating_5"`
+}
+
+// RatingCount returns how many reviews the product has.
+func (r ProductRating) RatingCount() int {
+	return r.OneStar + r.TwoStars + r.ThreeStars + r.FourStars + r.FiveStars
+}
+
+// RatingAverage returns the mean number of stars rounded to two decimal
+// places, or 0 when the product has no reviews.
+func (r ProductRating) RatingAverage() float64 {
+	count := r.RatingCount()
+	if count == 0 {
+		return 0
+	}
+
+	stars := r.OneStar + 2*r.TwoStars + 3*r.ThreeStars + 4*r.FourStars + 5*r.FiveStars
+	return math.Round(float64(stars)/float64(count)*100) / 100
+}
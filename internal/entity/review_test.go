@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewReview_Success(t *testing.T) {
+	review, err := NewReview("MLB001", 5, "Great", "Works well")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "MLB001", review.ProductID)
+	assert.Equal(t, 5, review.Rating)
+	assert.False(t, review.CreatedAt.IsZero())
+}
+
+func Test_NewReview_Validation(t *testing.T) {
+	tests := []struct {
+		name      string
+		productID string
+		rating    int
+		expected  string
+	}{
+		{"Missing product", "", 5, "product_id is required"},
+		{"Rating below 1", "MLB001", 0, "rating must be between 1 and 5"},
+		{"Rating above 5", "MLB001", 6, "rating must be between 1 and 5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			review, err := NewReview(tt.productID, tt.rating, "", "")
+
+			assert.Nil(t, review)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
+
+func Test_ProductRating(t *testing.T) {
+	assert.Equal(t, 0, ProductRating{}.RatingCount())
+	assert.Equal(t, 0.0, ProductRating{}.RatingAverage())
+
+	rating := ProductRating{FourStars: 1, FiveStars: 2}
+	assert.Equal(t, 3, rating.RatingCount())
+	assert.Equal(t, 4.67, rating.RatingAverage())
+
+	rating = ProductRating{OneStar: 1, ThreeStars: 1}
+	assert.Equal(t, 2.0, rating.RatingAverage())
+}
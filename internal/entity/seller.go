@@ -0,0 +1,184 @@
+package entity
+
+import (
+	"math"
+	"slices"
+	"time"
+)
+
+// Reputation levels, from best to worst. A seller with fewer than
+// MinOrdersForReputation finished orders and fewer than
+// MinReviewsForReputation reviews has ReputationNone.
+const (
+	ReputationGreen      = "green"
+	ReputationLightGreen = "light_green"
+	ReputationYellow     = "yellow"
+	ReputationOrange     = "orange"
+	ReputationRed        = "red"
+	ReputationNone       = "none"
+)
+
+// Power seller tiers, from highest to lowest.
+const (
+	PowerSellerPlatinum = "platinum"
+	PowerSellerGold     = "gold"
+	PowerSellerSilver   = "silver"
+)
+
+// MinOrdersForReputation is the number of completed or cancelled orders a
+// seller needs before its cancellation rate says anything.
+const MinOrdersForReputation = 5
+
+// MinReviewsForReputation is the number of reviews of its products a seller
+// needs before their average rating says anything.
+const MinReviewsForReputation = 5
+
+// reputationLevels lists the levels from best to worst.
+var reputationLevels = []string{
+	ReputationGreen,
+	ReputationLightGreen,
+	ReputationYellow,
+	ReputationOrange,
+	ReputationRed,
+}
+
+// cancellationLevels maps the cancellation rate to a level: the first level
+// whose limit is above the rate applies.
+var cancellationLevels = []struct {
+	maxCancellationRate float64
+	level               string
+}{
+	{0.02, ReputationGreen},
+	{0.04, ReputationLightGreen},
+	{0.07, ReputationYellow},
+	{0.10, ReputationOrange},
+}
+
+// ratingLevels maps the average rating to a level: the first level whose
+// minimum the average reaches applies.
+var ratingLevels = []struct {
+	minAverageRating float64
+	level            string
+}{
+	{4.5, ReputationGreen},
+	{4.0, ReputationLightGreen},
+	{3.5, ReputationYellow},
+	{3.0, ReputationOrange},
+}
+
+// powerSellerTiers lists the completed sales each tier needs, highest first.
+// Only sellers with a green or light green reputation have a tier.
+var powerSellerTiers = []struct {
+	minCompletedSales int
+	tier              string
+}{
+	{200, PowerSellerPlatinum},
+	{50, PowerSellerGold},
+	{20, PowerSellerSilver},
+}
+
+// Seller owns products. Products refer to it by ID only, so a rename is
+// reflected on every listing at once.
+type Seller struct {
+	ID   string `json:"id" db:"id"`
+	Name string `json:"name" db:"name"`
+	SellerReputation
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// SellerReputation holds the sales and review counters of a seller; the
+// level and tier are derived from them. The database recounts the sales
+// whenever an order of the seller changes, and the review counters are
+// recounted by the writes of reviews and of the products they belong to.
+// The API has no order endpoints: orders are written to the orders table by
+// the order service.
+type SellerReputation struct {
+	CompletedSales int `json:"completed_sales" db:"completed_sales"`
+	CancelledSales int `json:"cancelled_sales" db:"cancelled_sales"`
+	// ReviewCount is how many reviews the products of the seller have, and
+	// ReviewStars the sum of their stars.
+	ReviewCount int `json:"review_count" db:"review_count"`
+	ReviewStars int `json:"review_stars" db:"review_stars"`
+}
+
+// CancellationRate returns the share of finished orders that were cancelled,
+// between 0 and 1, rounded to four decimal places.
+func (r SellerReputation) CancellationRate() float64 {
+	finished := r.CompletedSales + r.CancelledSales
+	if finished == 0 {
+		return 0
+	}
+	return math.Round(float64(r.CancelledSales)/float64(finished)*10000) / 10000
+}
+
+// RatingAverage returns the mean number of stars of the reviews of the
+// seller rounded to two decimal places, or 0 when there are none.
+func (r SellerReputation) RatingAverage() float64 {
+	if r.ReviewCount == 0 {
+		return 0
+	}
+	return math.Round(float64(r.ReviewStars)/float64(r.ReviewCount)*100) / 100
+}
+
+// Level returns the reputation level of the seller: the worse of the levels
+// given by its cancellation rate and by its average rating, counting only
+// the ones with enough orders or reviews behind them.
+func (r SellerReputation) Level() string {
+	level := ReputationNone
+	if r.CompletedSales+r.CancelledSales >= MinOrdersForReputation {
+		level = r.cancellationLevel()
+	}
+	if r.ReviewCount >= MinReviewsForReputation {
+		level = worseLevel(level, r.ratingLevel())
+	}
+	return level
+}
+
+func (r SellerReputation) cancellationLevel() string {
+	rate := r.CancellationRate()
+	for _, level := range cancellationLevels {
+		if rate < level.maxCancellationRate {
+			return level.level
+		}
+	}
+	return ReputationRed
+}
+
+func (r SellerReputation) ratingLevel() string {
+	average := r.RatingAverage()
+	for _, level := range ratingLevels {
+		if average >= level.minAverageRating {
+			return level.level
+		}
+	}
+	return ReputationRed
+}
+
+// worseLevel returns the worse of two levels, where ReputationNone gives way
+// to any other.
+func worseLevel(a, b string) string {
+	if a == ReputationNone {
+		return b
+	}
+	if slices.Index(reputationLevels, b) > slices.Index(reputationLevels, a) {
+		return b
+	}
+	return a
+}
+
+// PowerSellerTier returns the power seller tier of the seller, or an empty
+// string when it has none.
+func (r SellerReputation) PowerSellerTier() string {
+	level := r.Level()
+	if level != ReputationGreen && level != ReputationLightGreen {
+		return ""
+	}
+
+	for _, tier := range powerSellerTiers {
+		if r.CompletedSales >= tier.minCompletedSales {
+			return tier.tier
+		}
+	}
+	return ""
+}
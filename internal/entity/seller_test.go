@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SellerReputation_CancellationRate(t *testing.T) {
+	assert.Equal(t, 0.0, SellerReputation{}.CancellationRate())
+	assert.Equal(t, 0.0164, SellerReputation{CompletedSales: 60, CancelledSales: 1}.CancellationRate())
+	assert.Equal(t, 1.0, SellerReputation{CancelledSales: 3}.CancellationRate())
+}
+
+func Test_SellerReputation_RatingAverage(t *testing.T) {
+	assert.Equal(t, 0.0, SellerReputation{}.RatingAverage())
+	assert.Equal(t, 4.33, SellerReputation{ReviewCount: 3, ReviewStars: 13}.RatingAverage())
+}
+
+func Test_SellerReputation_Level(t *testing.T) {
+	tests := []struct {
+		name       string
+		reputation SellerReputation
+		expected   string
+	}{
+		{"No sales", SellerReputation{}, ReputationNone},
+		{"Too few sales", SellerReputation{CompletedSales: 3, CancelledSales: 1}, ReputationNone},
+		{"No cancellations", SellerReputation{CompletedSales: 5}, ReputationGreen},
+		{"Below 2%", SellerReputation{CompletedSales: 60, CancelledSales: 1}, ReputationGreen},
+		{"Exactly 2%", SellerReputation{CompletedSales: 49, CancelledSales: 1}, ReputationLightGreen},
+		{"Below 7%", SellerReputation{CompletedSales: 20, CancelledSales: 1}, ReputationYellow},
+		{"Below 10%", SellerReputation{CompletedSales: 12, CancelledSales: 1}, ReputationOrange},
+		{"10% or more", SellerReputation{CompletedSales: 12, CancelledSales: 2}, ReputationRed},
+		{"Too few reviews", SellerReputation{CompletedSales: 60, CancelledSales: 1, ReviewCount: 4, ReviewStars: 4}, ReputationGreen},
+		{"Reviews only", SellerReputation{ReviewCount: 5, ReviewStars: 21}, ReputationLightGreen},
+		{"Low rating lowers the level", SellerReputation{CompletedSales: 60, CancelledSales: 1, ReviewCount: 10, ReviewStars: 32}, ReputationOrange},
+		{"Rating below 3", SellerReputation{CompletedSales: 60, CancelledSales: 1, ReviewCount: 10, ReviewStars: 29}, ReputationRed},
+		{"High rating keeps the sales level", SellerReputation{CompletedSales: 20, CancelledSales: 1, ReviewCount: 10, ReviewStars: 50}, ReputationYellow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.reputation.Level())
+		})
+	}
+}
+
+func Test_SellerReputation_PowerSellerTier(t *testing.T) {
+	tests := []struct {
+		name       string
+		reputation SellerReputation
+		expected   string
+	}{
+		{"Platinum", SellerReputation{CompletedSales: 200, CancelledSales: 2}, PowerSellerPlatinum},
+		{"Gold", SellerReputation{CompletedSales: 60, CancelledSales: 1}, PowerSellerGold},
+		{"Silver with light green", SellerReputation{CompletedSales: 49, CancelledSales: 1}, PowerSellerSilver},
+		{"Too few sales", SellerReputation{CompletedSales: 19}, ""},
+		{"Yellow reputation", SellerReputation{CompletedSales: 300, CancelledSales: 15}, ""},
+		{"Yellow rating", SellerReputation{CompletedSales: 300, CancelledSales: 2, ReviewCount: 20, ReviewStars: 72}, ""},
+		{"No reputation", SellerReputation{}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.reputation.PowerSellerTier())
+		})
+	}
+}
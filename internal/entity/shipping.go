@@ -0,0 +1,132 @@
+package entity
+
+import (
+	"project/internal/errors"
+	"strings"
+	"time"
+)
+
+// ZipLength is the number of digits of a zip code, as a Brazilian CEP.
+const ZipLength = 8
+
+// cubicCmPerGram turns the volume of a package into the weight carriers
+// charge for it: 6000 cm³ count as 1 kg.
+const cubicCmPerGram = 6
+
+// ShippingProfile is how a product ships: the weight and dimensions of its
+// package, whether the seller pays for the shipping and the zip code it
+// ships from. Only a complete profile can be quoted.
+type ShippingProfile struct {
+	WeightGrams  int    `json:"weight_grams" db:"weight_grams"`
+	LengthCm     int    `json:"length_cm" db:"length_cm"`
+	WidthCm      int    `json:"width_cm" db:"width_cm"`
+	HeightCm     int    `json:"height_cm" db:"height_cm"`
+	FreeShipping bool   `json:"free_shipping" db:"free_shipping"`
+	OriginZip    string `json:"origin_zip" db:"origin_zip"`
+}
+
+// Validate checks the fields of the profile, which may all be left empty.
+func (s ShippingProfile) Validate() error {
+	validation := &errors.ValidationError{}
+
+	for _, dimension := range []struct {
+		field string
+		value int
+	}{
+		{"weight_grams", s.WeightGrams},
+		{"length_cm", s.LengthCm},
+		{"width_cm", s.WidthCm},
+		{"height_cm", s.HeightCm},
+	} {
+		if dimension.value < 0 {
+			validation.Add(dimension.field, errors.RuleMin, dimension.field+" must be greater than or equal to 0")
+		}
+	}
+
+	if s.OriginZip != "" && !IsValidZip(s.OriginZip) {
+		validation.Add("origin_zip", errors.RuleFormat, "origin_zip must be a zip code of 8 digits")
+	}
+
+	return validation.Err()
+}
+
+// IsComplete reports whether the profile has everything a quote needs.
+func (s ShippingProfile) IsComplete() bool {
+	return s.WeightGrams > 0 && s.LengthCm > 0 && s.WidthCm > 0 && s.HeightCm > 0 && s.OriginZip != ""
+}
+
+// BillableWeightGrams is the weight carriers charge for: the actual weight
+// of the package or, for a light and bulky one, the weight of its volume.
+func (s ShippingProfile) BillableWeightGrams() int {
+	volumetric := (s.LengthCm*s.WidthCm*s.HeightCm + cubicCmPerGram - 1) / cubicCmPerGram
+	return max(s.WeightGrams, volumetric)
+}
+
+// NormalizeZip removes the spaces and the hyphen of a zip code written as
+// 01310-100. Anything else is returned as it is, for IsValidZip to reject.
+func NormalizeZip(zip string) string {
+	zip = strings.TrimSpace(zip)
+	if len(zip) == ZipLength+1 && zip[5] == '-' {
+		zip = zip[:5] + zip[6:]
+	}
+	return zip
+}
+
+// IsValidZip reports whether zip is a normalized zip code of ZipLength
+// digits.
+func IsValidZip(zip string) bool {
+	if len(zip) != ZipLength {
+		return false
+	}
+	for _, r := range zip {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// ShippingRate prices the shipping from the zip codes of an origin range to
+// the ones of a destination range. BaseCost pays for the first kilogram and
+// CostPerKg for each further kilogram started. A package arrives between
+// MinDays and MaxDays business days after the order.
+type ShippingRate struct {
+	ID                 int64  `json:"id" db:"id"`
+	OriginZipFrom      string `json:"origin_zip_from" db:"origin_zip_from"`
+	OriginZipTo        string `json:"origin_zip_to" db:"origin_zip_to"`
+	DestinationZipFrom string `json:"destination_zip_from" db:"destination_zip_from"`
+	DestinationZipTo   string `json:"destination_zip_to" db:"destination_zip_to"`
+	BaseCost           Money  `json:"base_cost" db:"base_cost"`
+	CostPerKg          Money  `json:"cost_per_kg" db:"cost_per_kg"`
+	MinDays            int    `json:"min_days" db:"min_days"`
+	MaxDays            int    `json:"max_days" db:"max_days"`
+}
+
+// Cost is the price of shipping a package of weightGrams.
+func (r ShippingRate) Cost(weightGrams int) Money {
+	extraKg := max(0, (weightGrams+999)/1000-1)
+	return Money{
+		Amount:   r.BaseCost.Amount + int64(extraKg)*r.CostPerKg.Amount,
+		Currency: r.BaseCost.Currency,
+	}
+}
+
+// AddBusinessDays returns the date days business days after from, skipping
+// weekends and holidays. Holidays are compared by date only.
+func AddBusinessDays(from time.Time, days int, holidays []time.Time) time.Time {
+	closed := make(map[string]bool, len(holidays))
+	for _, holiday := range holidays {
+		closed[holiday.Format(time.DateOnly)] = true
+	}
+
+	date := from
+	for days > 0 {
+		date = date.AddDate(0, 0, 1)
+		if date.Weekday() == time.Saturday || date.Weekday() == time.Sunday || closed[date.Format(time.DateOnly)] {
+			continue
+		}
+		days--
+	}
+
+	return date
+}
@@ -0,0 +1,62 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ShippingProfile_IsComplete(t *testing.T) {
+	profile := ShippingProfile{WeightGrams: 240, LengthCm: 18, WidthCm: 10, HeightCm: 6, OriginZip: "01310100"}
+	assert.True(t, profile.IsComplete())
+
+	profile.HeightCm = 0
+	assert.False(t, profile.IsComplete())
+	assert.False(t, ShippingProfile{FreeShipping: true}.IsComplete())
+}
+
+func Test_ShippingProfile_BillableWeightGrams(t *testing.T) {
+	t.Run("Heavy package", func(t *testing.T) {
+		profile := ShippingProfile{WeightGrams: 2600, LengthCm: 45, WidthCm: 32, HeightCm: 8}
+
+		assert.Equal(t, 2600, profile.BillableWeightGrams())
+	})
+
+	t.Run("Light and bulky package", func(t *testing.T) {
+		profile := ShippingProfile{WeightGrams: 500, LengthCm: 24, WidthCm: 20, HeightCm: 10}
+
+		assert.Equal(t, 800, profile.BillableWeightGrams())
+	})
+}
+
+func Test_NormalizeZip(t *testing.T) {
+	assert.Equal(t, "01310100", NormalizeZip(" 01310-100 "))
+	assert.Equal(t, "01310100", NormalizeZip("01310100"))
+	assert.True(t, IsValidZip(NormalizeZip("01310-100")))
+	assert.False(t, IsValidZip(NormalizeZip("0131-0100")))
+	assert.False(t, IsValidZip("0131010"))
+	assert.False(t, IsValidZip("0131010a"))
+}
+
+func Test_ShippingRate_Cost(t *testing.T) {
+	rate := ShippingRate{
+		BaseCost:  Money{Amount: 790, Currency: "USD"},
+		CostPerKg: Money{Amount: 150, Currency: "USD"},
+	}
+
+	assert.Equal(t, Money{Amount: 790, Currency: "USD"}, rate.Cost(240))
+	assert.Equal(t, Money{Amount: 790, Currency: "USD"}, rate.Cost(1000))
+	assert.Equal(t, Money{Amount: 940, Currency: "USD"}, rate.Cost(1001))
+	assert.Equal(t, Money{Amount: 1090, Currency: "USD"}, rate.Cost(2600))
+}
+
+func Test_AddBusinessDays(t *testing.T) {
+	thursday := time.Date(2024, 12, 19, 15, 30, 0, 0, time.UTC)
+	christmas := time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "2024-12-20", AddBusinessDays(thursday, 1, nil).Format(time.DateOnly))
+	assert.Equal(t, "2024-12-23", AddBusinessDays(thursday, 2, nil).Format(time.DateOnly), "the weekend is skipped")
+	assert.Equal(t, "2024-12-26", AddBusinessDays(thursday, 4, []time.Time{christmas}).Format(time.DateOnly), "holidays are skipped")
+	assert.Equal(t, thursday, AddBusinessDays(thursday, 0, nil))
+}
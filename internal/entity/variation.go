@@ -0,0 +1,145 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"project/internal/errors"
+	"strings"
+)
+
+// Variation is one purchasable combination of a product, like the 512GB
+// Natural Titanium model of an iPhone listing, with its own price, stock and
+// images. Its price is in the currency of the product, and its attributes are
+// the ones that vary between the variations of the product.
+type Variation struct {
+	ID         int64             `json:"id" db:"id"`
+	ProductID  string            `json:"product_id" db:"product_id"`
+	Attributes ProductAttributes `json:"attributes" db:"attributes"`
+	Price      Money             `json:"price" db:"price"`
+	Stock      int               `json:"stock" db:"stock"`
+	Images     ImageURLs         `json:"images" db:"images"`
+}
+
+// ImageURLs are the images of a variation in display order.
+type ImageURLs []string
+
+// Scan reads the images of a variation aggregated by the database into a
+// JSON array.
+func (u *ImageURLs) Scan(src any) error {
+	var raw []byte
+	switch value := src.(type) {
+	case nil:
+		*u = nil
+		return nil
+	case string:
+		raw = []byte(value)
+	case []byte:
+		raw = value
+	default:
+		return fmt.Errorf("cannot scan %T into image URLs", src)
+	}
+
+	urls := ImageURLs{}
+	if err := json.Unmarshal(raw, &urls); err != nil {
+		return err
+	}
+	if len(urls) == 0 {
+		urls = nil
+	}
+
+	*u = urls
+	return nil
+}
+
+// Validate checks the fields of the variation on their own. The currency of
+// its price is the one of the product, which validates it; ValidateVariations
+// compares the variations of a product with each other.
+func (v *Variation) Validate() error {
+	validation := &errors.ValidationError{}
+
+	if len(v.Attributes) == 0 {
+		validation.Add("attributes", errors.RuleRequired, "attributes is required")
+	}
+
+	for _, name := range v.Attributes.names() {
+		if v.Attributes[name] == "" {
+			field := "attributes." + name
+			validation.Add(field, errors.RuleRequired, field+" must not be empty")
+		}
+	}
+
+	if v.Price.Amount < 0 {
+		validation.Add("price", errors.RuleMin, "price must be greater than or equal to 0")
+	}
+
+	if v.Stock < 0 {
+		validation.Add("stock", errors.RuleMin, "stock must be greater than or equal to 0")
+	}
+
+	for i, url := range v.Images {
+		if url == "" {
+			field := fmt.Sprintf("images[%d]", i)
+			validation.Add(field, errors.RuleRequired, field+" is required")
+		}
+	}
+
+	return validation.Err()
+}
+
+// ValidateVariations checks that the variations of a product vary by the same
+// attributes and that no two of them have the same values, ignoring case.
+// Failures are reported under variations[i].attributes.
+func ValidateVariations(variations []Variation) error {
+	validation := &errors.ValidationError{}
+	if len(variations) == 0 {
+		return nil
+	}
+
+	names := variations[0].Attributes.names()
+	seen := map[string]int{}
+
+	for i, variation := range variations {
+		field := fmt.Sprintf("variations[%d].attributes", i)
+		if len(variation.Attributes) == 0 {
+			continue
+		}
+
+		if strings.Join(variation.Attributes.names(), ",") != strings.Join(names, ",") {
+			validation.Add(field, errors.RuleFormat, fmt.Sprintf("%s must set the attributes of variations[0]: %s", field, strings.Join(names, ", ")))
+			continue
+		}
+
+		values := make([]string, 0, len(names))
+		for _, name := range names {
+			values = append(values, strings.ToLower(variation.Attributes[name]))
+		}
+		combination := strings.Join(values, "\x00")
+
+		if first, ok := seen[combination]; ok {
+			validation.Add(field, errors.RuleUnique, fmt.Sprintf("%s must differ from variations[%d]", field, first))
+			continue
+		}
+		seen[combination] = i
+	}
+
+	return validation.Err()
+}
+
+// SetVariations replaces the variations of the product. A product with
+// variations is priced at its cheapest variation and has the stock of all of
+// them, so that listings, price filters and facets need not read them.
+func (p *Product) SetVariations(variations []Variation) {
+	p.Variations = variations
+	if len(variations) == 0 {
+		return
+	}
+
+	p.Price = Money{Amount: variations[0].Price.Amount, Currency: p.Price.Currency}
+	p.Stock = 0
+	for i := range variations {
+		variations[i].ProductID = p.ID
+		variations[i].Price.Currency = p.Price.Currency
+		p.Price.Amount = min(p.Price.Amount, variations[i].Price.Amount)
+		p.Stock += variations[i].Stock
+	}
+}
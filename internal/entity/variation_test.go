@@ -0,0 +1,91 @@
+package entity
+
+import (
+	"project/internal/errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Variation_Validate_Success(t *testing.T) {
+	variation := Variation{ProductID: "MLB001", Attributes: ProductAttributes{"storage": "512GB"}, Price: priceUSD(149999), Stock: 15, Images: ImageURLs{"https://example.com/512.jpg"}}
+
+	assert.NoError(t, variation.Validate())
+}
+
+func Test_Variation_Validate_ReportsEveryInvalidField(t *testing.T) {
+	variation := Variation{ProductID: "MLB001", Attributes: ProductAttributes{}, Price: priceUSD(-1), Stock: -2, Images: ImageURLs{"https://example.com/1.jpg", ""}}
+
+	err := variation.Validate()
+
+	assert.Equal(t, []errors.FieldError{
+		{Field: "attributes", Rule: errors.RuleRequired, Message: "attributes is required"},
+		{Field: "price", Rule: errors.RuleMin, Message: "price must be greater than or equal to 0"},
+		{Field: "stock", Rule: errors.RuleMin, Message: "stock must be greater than or equal to 0"},
+		{Field: "images[1]", Rule: errors.RuleRequired, Message: "images[1] is required"},
+	}, errors.GetErrorDetails(err))
+}
+
+func Test_ValidateVariations(t *testing.T) {
+	t.Run("Distinct combinations", func(t *testing.T) {
+		err := ValidateVariations([]Variation{
+			{Attributes: ProductAttributes{"color": "Blue", "storage": "256GB"}},
+			{Attributes: ProductAttributes{"color": "Blue", "storage": "512GB"}},
+			{Attributes: ProductAttributes{"color": "Natural", "storage": "256GB"}},
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Repeated and mismatched combinations", func(t *testing.T) {
+		err := ValidateVariations([]Variation{
+			{Attributes: ProductAttributes{"color": "Blue", "storage": "256GB"}},
+			{Attributes: ProductAttributes{"color": "blue", "storage": "256gb"}},
+			{Attributes: ProductAttributes{"color": "Natural"}},
+		})
+
+		assert.Equal(t, []errors.FieldError{
+			{Field: "variations[1].attributes", Rule: errors.RuleUnique, Message: "variations[1].attributes must differ from variations[0]"},
+			{Field: "variations[2].attributes", Rule: errors.RuleFormat, Message: "variations[2].attributes must set the attributes of variations[0]: color, storage"},
+		}, errors.GetErrorDetails(err))
+	})
+
+	t.Run("No variations", func(t *testing.T) {
+		assert.NoError(t, ValidateVariations(nil))
+	})
+}
+
+func Test_Product_SetVariations(t *testing.T) {
+	product := &Product{ID: "MLB001", Price: Money{Amount: 0, Currency: "USD"}, Stock: 99}
+
+	product.SetVariations([]Variation{
+		{Attributes: ProductAttributes{"storage": "512GB"}, Price: Money{Amount: 149999}, Stock: 15},
+		{Attributes: ProductAttributes{"storage": "256GB"}, Price: Money{Amount: 129999}, Stock: 30},
+	})
+
+	assert.Equal(t, Money{Amount: 129999, Currency: "USD"}, product.Price)
+	assert.Equal(t, 45, product.Stock)
+	assert.Equal(t, "MLB001", product.Variations[0].ProductID)
+	assert.Equal(t, "USD", product.Variations[1].Price.Currency)
+}
+
+func Test_Product_SetVariations_Empty(t *testing.T) {
+	product := &Product{ID: "MLB001", Price: priceUSD(1000), Stock: 5}
+
+	product.SetVariations(nil)
+
+	assert.Equal(t, priceUSD(1000), product.Price)
+	assert.Equal(t, 5, product.Stock)
+}
+
+func Test_ImageURLs_Scan(t *testing.T) {
+	var urls ImageURLs
+
+	assert.NoError(t, urls.Scan(`["https://example.com/1.jpg","https://example.com/2.jpg"]`))
+	assert.Equal(t, ImageURLs{"https://example.com/1.jpg", "https://example.com/2.jpg"}, urls)
+
+	assert.NoError(t, urls.Scan([]byte(`[]`)))
+	assert.Nil(t, urls)
+
+	assert.Error(t, urls.Scan(42))
+}
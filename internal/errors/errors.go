@@ -7,11 +7,25 @@ import (
 )
 
 var (
-	ErrProductNotFound     = errors.New("product not found")
-	ErrInvalidProductID    = errors.New("invalid product id")
-	ErrInvalidInput        = errors.New("invalid input")
-	ErrDatabaseError       = errors.New("database error")
-	ErrInternalServerError = errors.New("internal server error")
+	ErrProductNotFound         = errors.New("product not found")
+	ErrProductAlreadyExists    = errors.New("product already exists")
+	ErrCategoryNotFound        = errors.New("category not found")
+	ErrSellerNotFound          = errors.New("seller not found")
+	ErrQuestionNotFound        = errors.New("question not found")
+	ErrQuestionAnswered        = errors.New("question already answered")
+	ErrPromotionNotFound       = errors.New("promotion not found")
+	ErrPromotionConflict       = errors.New("promotion conflict")
+	ErrVersionConflict         = errors.New("product version conflict")
+	ErrPreconditionRequired    = errors.New("precondition required")
+	ErrInvalidStatusTransition = errors.New("invalid status transition")
+	ErrForbidden               = errors.New("forbidden")
+	ErrSearchUnavailable       = errors.New("full-text search unavailable")
+	ErrUnknownCurrency         = errors.New("unknown currency")
+	ErrShippingUnavailable     = errors.New("shipping unavailable")
+	ErrInvalidProductID        = errors.New("invalid product id")
+	ErrInvalidInput            = errors.New("invalid input")
+	ErrDatabaseError           = errors.New("database error")
+	ErrInternalServerError     = errors.New("internal server error")
 )
 
 type AppError struct {
@@ -41,11 +55,28 @@ func NewAppError(err error, message string, statusCode int, code string) *AppErr
 	}
 }
 
+// NewInvalidInputError wraps ErrInvalidInput with a message that is safe to return to the client.
+func NewInvalidInputError(message string) *AppError {
+	return NewAppError(ErrInvalidInput, message, http.StatusBadRequest, "INVALID_INPUT")
+}
+
+// NewStatusTransitionError wraps ErrInvalidStatusTransition with a message
+// that tells the client why the listing cannot take the requested status.
+func NewStatusTransitionError(message string) *AppError {
+	return NewAppError(ErrInvalidStatusTransition, message, http.StatusConflict, "INVALID_STATUS_TRANSITION")
+}
+
+// NewForbiddenError wraps ErrForbidden with a message that tells the client why it was refused.
+func NewForbiddenError(message string) *AppError {
+	return NewAppError(ErrForbidden, message, http.StatusForbidden, "FORBIDDEN")
+}
+
 type ErrorResponse struct {
-	Error     string    `json:"error" example:"product not found"`
-	Message   string    `json:"message,omitempty" example:"The requested product does not exist"`
-	Code      string    `json:"code,omitempty" example:"PRODUCT_NOT_FOUND"`
-	Timestamp time.Time `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+	Error     string       `json:"error" example:"product not found"`
+	Message   string       `json:"message,omitempty" example:"The requested product does not exist"`
+	Code      string       `json:"code,omitempty" example:"PRODUCT_NOT_FOUND"`
+	Details   []FieldError `json:"details,omitempty"`
+	Timestamp time.Time    `json:"timestamp" example:"2024-01-01T00:00:00Z"`
 }
 
 func GetStatusCode(err error) int {
@@ -55,10 +86,22 @@ func GetStatusCode(err error) int {
 	}
 
 	switch {
-	case errors.Is(err, ErrProductNotFound):
+	case errors.Is(err, ErrProductNotFound), errors.Is(err, ErrCategoryNotFound), errors.Is(err, ErrSellerNotFound), errors.Is(err, ErrQuestionNotFound), errors.Is(err, ErrPromotionNotFound):
 		return http.StatusNotFound
-	case errors.Is(err, ErrInvalidProductID), errors.Is(err, ErrInvalidInput):
+	case errors.Is(err, ErrProductAlreadyExists), errors.Is(err, ErrQuestionAnswered), errors.Is(err, ErrPromotionConflict), errors.Is(err, ErrInvalidStatusTransition):
+		return http.StatusConflict
+	case errors.Is(err, ErrVersionConflict):
+		return http.StatusPreconditionFailed
+	case errors.Is(err, ErrPreconditionRequired):
+		return http.StatusPreconditionRequired
+	case errors.Is(err, ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, ErrInvalidProductID), errors.Is(err, ErrInvalidInput), errors.Is(err, ErrUnknownCurrency):
 		return http.StatusBadRequest
+	case errors.Is(err, ErrValidation), errors.Is(err, ErrShippingUnavailable):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, ErrSearchUnavailable):
+		return http.StatusNotImplemented
 	case errors.Is(err, ErrDatabaseError):
 		return http.StatusInternalServerError
 	default:
@@ -75,10 +118,40 @@ func GetErrorCode(err error) string {
 	switch {
 	case errors.Is(err, ErrProductNotFound):
 		return "PRODUCT_NOT_FOUND"
+	case errors.Is(err, ErrCategoryNotFound):
+		return "CATEGORY_NOT_FOUND"
+	case errors.Is(err, ErrSellerNotFound):
+		return "SELLER_NOT_FOUND"
+	case errors.Is(err, ErrQuestionNotFound):
+		return "QUESTION_NOT_FOUND"
+	case errors.Is(err, ErrQuestionAnswered):
+		return "QUESTION_ALREADY_ANSWERED"
+	case errors.Is(err, ErrPromotionNotFound):
+		return "PROMOTION_NOT_FOUND"
+	case errors.Is(err, ErrPromotionConflict):
+		return "PROMOTION_CONFLICT"
+	case errors.Is(err, ErrProductAlreadyExists):
+		return "PRODUCT_ALREADY_EXISTS"
+	case errors.Is(err, ErrInvalidStatusTransition):
+		return "INVALID_STATUS_TRANSITION"
+	case errors.Is(err, ErrVersionConflict):
+		return "PRECONDITION_FAILED"
+	case errors.Is(err, ErrPreconditionRequired):
+		return "PRECONDITION_REQUIRED"
+	case errors.Is(err, ErrForbidden):
+		return "FORBIDDEN"
+	case errors.Is(err, ErrUnknownCurrency):
+		return "UNKNOWN_CURRENCY"
+	case errors.Is(err, ErrShippingUnavailable):
+		return "SHIPPING_UNAVAILABLE"
 	case errors.Is(err, ErrInvalidProductID):
 		return "INVALID_PRODUCT_ID"
 	case errors.Is(err, ErrInvalidInput):
 		return "INVALID_INPUT"
+	case errors.Is(err, ErrValidation):
+		return "VALIDATION_FAILED"
+	case errors.Is(err, ErrSearchUnavailable):
+		return "SEARCH_UNAVAILABLE"
 	case errors.Is(err, ErrDatabaseError):
 		return "DATABASE_ERROR"
 	default:
@@ -96,6 +169,9 @@ func GetUserFriendlyMessage(err error, statusCode int) string {
 		if errors.As(err, &appErr) && appErr.Message != "" {
 			return appErr.Message
 		}
+		if errors.Is(err, ErrSearchUnavailable) {
+			return "Full-text search is not available on this server"
+		}
 		return "An internal error occurred. Please try again later."
 	}
 
@@ -111,10 +187,42 @@ func GetUserFriendlyMessage(err error, statusCode int) string {
 		}
 	}
 
+	// A validation error lists the message of every failing field
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return validationErr.Error()
+	}
+
 	// For known application errors, return their message
 	switch {
 	case errors.Is(err, ErrProductNotFound):
 		return "The requested product was not found"
+	case errors.Is(err, ErrCategoryNotFound):
+		return "The requested category was not found"
+	case errors.Is(err, ErrSellerNotFound):
+		return "The requested seller was not found"
+	case errors.Is(err, ErrQuestionNotFound):
+		return "The requested question was not found"
+	case errors.Is(err, ErrQuestionAnswered):
+		return "The question has already been answered"
+	case errors.Is(err, ErrPromotionNotFound):
+		return "The requested promotion was not found"
+	case errors.Is(err, ErrPromotionConflict):
+		return "Another promotion for the same product or category overlaps this one with the same priority"
+	case errors.Is(err, ErrProductAlreadyExists):
+		return "A product with the given ID already exists"
+	case errors.Is(err, ErrInvalidStatusTransition):
+		return "The listing cannot change to the requested status"
+	case errors.Is(err, ErrVersionConflict):
+		return "The product was modified by another request. Fetch it again and retry with the new ETag"
+	case errors.Is(err, ErrPreconditionRequired):
+		return "The If-Match header with the product ETag is required"
+	case errors.Is(err, ErrForbidden):
+		return "Administrator privileges are required for this operation"
+	case errors.Is(err, ErrUnknownCurrency):
+		return "The requested currency has no exchange rate"
+	case errors.Is(err, ErrShippingUnavailable):
+		return "The product cannot be shipped to the given zip code"
 	case errors.Is(err, ErrInvalidProductID):
 		return "The provided product ID is invalid"
 	case errors.Is(err, ErrInvalidInput):
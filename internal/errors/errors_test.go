@@ -19,6 +19,76 @@ func TestGetStatusCode(t *testing.T) {
 			err:            ErrProductNotFound,
 			expectedStatus: http.StatusNotFound,
 		},
+		{
+			name:           "Product already exists returns 409",
+			err:            ErrProductAlreadyExists,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "Version conflict returns 412",
+			err:            ErrVersionConflict,
+			expectedStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:           "Missing precondition returns 428",
+			err:            ErrPreconditionRequired,
+			expectedStatus: http.StatusPreconditionRequired,
+		},
+		{
+			name:           "Category not found returns 404",
+			err:            ErrCategoryNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Seller not found returns 404",
+			err:            ErrSellerNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Question not found returns 404",
+			err:            ErrQuestionNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Question already answered returns 409",
+			err:            ErrQuestionAnswered,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "Forbidden returns 403",
+			err:            ErrForbidden,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Unknown currency returns 400",
+			err:            ErrUnknownCurrency,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Promotion not found returns 404",
+			err:            ErrPromotionNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Promotion conflict returns 409",
+			err:            ErrPromotionConflict,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "Invalid status transition returns 409",
+			err:            ErrInvalidStatusTransition,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "Shipping unavailable returns 422",
+			err:            ErrShippingUnavailable,
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Validation error returns 422",
+			err:            &ValidationError{Fields: []FieldError{{Field: "title", Rule: RuleRequired, Message: "title is required"}}},
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
 		{
 			name:           "Invalid product ID returns 400",
 			err:            ErrInvalidProductID,
@@ -29,6 +99,11 @@ func TestGetStatusCode(t *testing.T) {
 			err:            ErrInvalidInput,
 			expectedStatus: http.StatusBadRequest,
 		},
+		{
+			name:           "Search unavailable returns 501",
+			err:            ErrSearchUnavailable,
+			expectedStatus: http.StatusNotImplemented,
+		},
 		{
 			name:           "Database error returns 500",
 			err:            ErrDatabaseError,
@@ -65,6 +140,76 @@ func TestGetErrorCode(t *testing.T) {
 			err:          ErrProductNotFound,
 			expectedCode: "PRODUCT_NOT_FOUND",
 		},
+		{
+			name:         "Product already exists",
+			err:          ErrProductAlreadyExists,
+			expectedCode: "PRODUCT_ALREADY_EXISTS",
+		},
+		{
+			name:         "Version conflict",
+			err:          ErrVersionConflict,
+			expectedCode: "PRECONDITION_FAILED",
+		},
+		{
+			name:         "Precondition required",
+			err:          ErrPreconditionRequired,
+			expectedCode: "PRECONDITION_REQUIRED",
+		},
+		{
+			name:         "Category not found",
+			err:          ErrCategoryNotFound,
+			expectedCode: "CATEGORY_NOT_FOUND",
+		},
+		{
+			name:         "Seller not found",
+			err:          ErrSellerNotFound,
+			expectedCode: "SELLER_NOT_FOUND",
+		},
+		{
+			name:         "Question not found",
+			err:          ErrQuestionNotFound,
+			expectedCode: "QUESTION_NOT_FOUND",
+		},
+		{
+			name:         "Question already answered",
+			err:          ErrQuestionAnswered,
+			expectedCode: "QUESTION_ALREADY_ANSWERED",
+		},
+		{
+			name:         "Forbidden",
+			err:          ErrForbidden,
+			expectedCode: "FORBIDDEN",
+		},
+		{
+			name:         "Unknown currency",
+			err:          ErrUnknownCurrency,
+			expectedCode: "UNKNOWN_CURRENCY",
+		},
+		{
+			name:         "Promotion not found",
+			err:          ErrPromotionNotFound,
+			expectedCode: "PROMOTION_NOT_FOUND",
+		},
+		{
+			name:         "Promotion conflict",
+			err:          ErrPromotionConflict,
+			expectedCode: "PROMOTION_CONFLICT",
+		},
+		{
+			name:         "Invalid status transition",
+			err:          ErrInvalidStatusTransition,
+			expectedCode: "INVALID_STATUS_TRANSITION",
+		},
+		{
+			name:         "Shipping unavailable",
+			err:          ErrShippingUnavailable,
+			expectedCode: "SHIPPING_UNAVAILABLE",
+		},
+		{
+			name:         "Validation error",
+			err:          &ValidationError{Fields: []FieldError{{Field: "title", Rule: RuleRequired, Message: "title is required"}}},
+			expectedCode: "VALIDATION_FAILED",
+		},
 		{
 			name:         "Invalid product ID",
 			err:          ErrInvalidProductID,
@@ -75,6 +220,11 @@ func TestGetErrorCode(t *testing.T) {
 			err:          ErrInvalidInput,
 			expectedCode: "INVALID_INPUT",
 		},
+		{
+			name:         "Search unavailable",
+			err:          ErrSearchUnavailable,
+			expectedCode: "SEARCH_UNAVAILABLE",
+		},
 		{
 			name:         "Database error",
 			err:          ErrDatabaseError,
@@ -132,6 +282,33 @@ func TestAppError(t *testing.T) {
 	})
 }
 
+func TestNewInvalidInputError(t *testing.T) {
+	err := NewInvalidInputError("title is required")
+
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	assert.Equal(t, http.StatusBadRequest, GetStatusCode(err))
+	assert.Equal(t, "INVALID_INPUT", GetErrorCode(err))
+	assert.Equal(t, "title is required", GetUserFriendlyMessage(err, http.StatusBadRequest))
+}
+
+func TestNewForbiddenError(t *testing.T) {
+	err := NewForbiddenError("Only the seller of the product can answer its questions")
+
+	assert.ErrorIs(t, err, ErrForbidden)
+	assert.Equal(t, http.StatusForbidden, GetStatusCode(err))
+	assert.Equal(t, "FORBIDDEN", GetErrorCode(err))
+	assert.Equal(t, "Only the seller of the product can answer its questions", GetUserFriendlyMessage(err, http.StatusForbidden))
+}
+
+func TestNewStatusTransitionError(t *testing.T) {
+	err := NewStatusTransitionError("A closed listing cannot change status")
+
+	assert.ErrorIs(t, err, ErrInvalidStatusTransition)
+	assert.Equal(t, http.StatusConflict, GetStatusCode(err))
+	assert.Equal(t, "INVALID_STATUS_TRANSITION", GetErrorCode(err))
+	assert.Equal(t, "A closed listing cannot change status", GetUserFriendlyMessage(err, http.StatusConflict))
+}
+
 func TestWrappedErrors(t *testing.T) {
 	t.Run("GetStatusCode works with wrapped errors", func(t *testing.T) {
 		wrappedErr := errors.Join(ErrProductNotFound, errors.New("additional context"))
@@ -25,6 +25,12 @@ func TestGetUserFriendlyMessage_ServerErrors(t *testing.T) {
 			statusCode: http.StatusInternalServerError,
 			want:       "An internal error occurred. Please try again later.",
 		},
+		{
+			name:       "search unavailable should explain itself",
+			err:        ErrSearchUnavailable,
+			statusCode: http.StatusNotImplemented,
+			want:       "Full-text search is not available on this server",
+		},
 		{
 			name: "AppError with custom message should use it for 500",
 			err: &AppError{
@@ -71,6 +77,12 @@ func TestGetUserFriendlyMessage_ClientErrors(t *testing.T) {
 			statusCode: http.StatusNotFound,
 			want:       "The requested product was not found",
 		},
+		{
+			name:       "product already exists should return friendly message",
+			err:        ErrProductAlreadyExists,
+			statusCode: http.StatusConflict,
+			want:       "A product with the given ID already exists",
+		},
 		{
 			name:       "invalid product ID should return friendly message",
 			err:        ErrInvalidProductID,
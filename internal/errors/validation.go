@@ -0,0 +1,103 @@
+package errors
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrValidation is wrapped by every ValidationError.
+var ErrValidation = errors.New("validation failed")
+
+// Validation rules, the machine-readable reason a field failed.
+const (
+	RuleRequired  = "required"
+	RuleMin       = "min"
+	RuleOneOf     = "oneof"
+	RuleFormat    = "format"
+	RuleCurrency  = "iso4217"
+	RuleDecimals  = "decimals"
+	RuleMaxDigits = "max_digits"
+	RuleType      = "type"
+	RuleAllowed   = "allowed"
+	RuleUnique    = "unique"
+)
+
+// FieldError is one failing field of a ValidationError. Field is the JSON
+// name of the field, with a prefix such as "images[0]." for nested values.
+type FieldError struct {
+	Field   string `json:"field" example:"title"`
+	Rule    string `json:"rule" example:"required"`
+	Message string `json:"message" example:"title is required"`
+}
+
+// ValidationError collects every failing field of an entity instead of the
+// first one only, so that a form can highlight all of them at once.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Fields))
+	for _, field := range e.Fields {
+		messages = append(messages, field.Message)
+	}
+	return strings.Join(messages, "; ")
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrValidation
+}
+
+// Add records a failing field. Only the first failure of each field is kept.
+func (e *ValidationError) Add(field, rule, message string) {
+	for _, existing := range e.Fields {
+		if existing.Field == field {
+			return
+		}
+	}
+	e.Fields = append(e.Fields, FieldError{Field: field, Rule: rule, Message: message})
+}
+
+// Merge adds the fields of err, a ValidationError returned by a nested value,
+// with prefix prepended to their names and messages, as in
+// "images[0].image_url" and "images[0]: image_url is required". Any other
+// error is recorded as a failure of the prefix itself; a nil err adds nothing.
+func (e *ValidationError) Merge(prefix string, err error) {
+	if err == nil {
+		return
+	}
+
+	name := strings.TrimSuffix(prefix, ".")
+
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		e.Add(name, RuleFormat, err.Error())
+		return
+	}
+
+	for _, field := range validationErr.Fields {
+		message := field.Message
+		if name != "" {
+			message = name + ": " + message
+		}
+		e.Add(prefix+field.Field, field.Rule, message)
+	}
+}
+
+// Err returns e when a field failed, and nil otherwise.
+func (e *ValidationError) Err() error {
+	if len(e.Fields) == 0 {
+		return nil
+	}
+	return e
+}
+
+// GetErrorDetails returns the failing fields of a validation error, or nil for
+// any other error.
+func GetErrorDetails(err error) []FieldError {
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return validationErr.Fields
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidationError(t *testing.T) {
+	t.Run("Collects every failing field once", func(t *testing.T) {
+		validation := &ValidationError{}
+		validation.Add("title", RuleRequired, "title is required")
+		validation.Add("stock", RuleMin, "stock must be greater than or equal to 0")
+		validation.Add("title", RuleFormat, "title is too long")
+
+		err := validation.Err()
+
+		assert.ErrorIs(t, err, ErrValidation)
+		assert.Equal(t, "title is required; stock must be greater than or equal to 0", err.Error())
+		assert.Equal(t, []FieldError{
+			{Field: "title", Rule: RuleRequired, Message: "title is required"},
+			{Field: "stock", Rule: RuleMin, Message: "stock must be greater than or equal to 0"},
+		}, GetErrorDetails(fmt.Errorf("failed to create product: %w", err)))
+	})
+
+	t.Run("Err is nil without failing fields", func(t *testing.T) {
+		assert.NoError(t, (&ValidationError{}).Err())
+	})
+
+	t.Run("Merge prefixes nested fields", func(t *testing.T) {
+		image := &ValidationError{}
+		image.Add("image_url", RuleRequired, "image_url is required")
+
+		validation := &ValidationError{}
+		validation.Merge("images[2].", image)
+		validation.Merge("images[3].", nil)
+		validation.Merge("category.", errors.New("category is malformed"))
+
+		assert.Equal(t, []FieldError{
+			{Field: "images[2].image_url", Rule: RuleRequired, Message: "images[2]: image_url is required"},
+			{Field: "category", Rule: RuleFormat, Message: "category is malformed"},
+		}, validation.Fields)
+	})
+
+	t.Run("Other errors have no details", func(t *testing.T) {
+		assert.Nil(t, GetErrorDetails(ErrInvalidInput))
+	})
+}
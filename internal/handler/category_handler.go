@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"project/internal/dto"
+	"project/internal/errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ListCategoriesUseCase interface {
+	Execute(ctx context.Context) ([]dto.CategoryNodeDTO, error)
+}
+
+type GetCategoryUseCase interface {
+	Execute(ctx context.Context, input dto.CategoryInputDTO) (*dto.CategoryDTO, error)
+}
+
+type CategoryHandler struct {
+	listCategoriesUseCase ListCategoriesUseCase
+	getCategoryUseCase    GetCategoryUseCase
+}
+
+func NewCategoryHandler(
+	listCategoriesUseCase ListCategoriesUseCase,
+	getCategoryUseCase GetCategoryUseCase,
+) *CategoryHandler {
+	return &CategoryHandler{
+		listCategoriesUseCase: listCategoriesUseCase,
+		getCategoryUseCase:    getCategoryUseCase,
+	}
+}
+
+// ListCategories godoc
+// @Summary List categories
+// @Description Get the whole category tree. Each category counts the products in it and in its subcategories.
+// @Tags categories
+// @Produce json
+// @Success 200 {object} dto.CategoryTreeResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Router /api/v1/categories [get]
+func (h *CategoryHandler) ListCategories(c *gin.Context) {
+	result, err := h.listCategoriesUseCase.Execute(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": result,
+	})
+}
+
+// GetCategory godoc
+// @Summary Get a category by ID
+// @Description Get a category with its breadcrumbs from the root, its direct children and the attributes its products may have, including those of its ancestors. List its products with GET /api/v1/products?category_id={id}.
+// @Tags categories
+// @Produce json
+// @Param id path int true "Category ID" example(6)
+// @Success 200 {object} dto.CategoryResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Router /api/v1/categories/{id} [get]
+func (h *CategoryHandler) GetCategory(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.NewInvalidInputError("category id must be an integer"))
+		return
+	}
+
+	result, err := h.getCategoryUseCase.Execute(c.Request.Context(), dto.CategoryInputDTO{ID: id})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": result,
+	})
+}
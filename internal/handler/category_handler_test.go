@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"project/internal/dto"
+	"project/internal/errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockListCategoriesUseCase struct {
+	mock.Mock
+}
+
+func (m *MockListCategoriesUseCase) Execute(ctx context.Context) ([]dto.CategoryNodeDTO, error) {
+	args := m.Called(ctx)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dto.CategoryNodeDTO), nil
+}
+
+type MockGetCategoryUseCase struct {
+	mock.Mock
+}
+
+func (m *MockGetCategoryUseCase) Execute(ctx context.Context, input dto.CategoryInputDTO) (*dto.CategoryDTO, error) {
+	args := m.Called(ctx, input)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.CategoryDTO), nil
+}
+
+func setupCategoryTestRouter(handler *CategoryHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	r.Use(testErrorHandler)
+
+	r.GET("/categories", handler.ListCategories)
+	r.GET("/categories/:id", handler.GetCategory)
+
+	return r
+}
+
+func TestCategoryHandler_ListCategories_Success(t *testing.T) {
+	tree := []dto.CategoryNodeDTO{{
+		ID: 1, Name: "Electronics", Path: "Electronics", ProductCount: 1,
+		Children: []dto.CategoryNodeDTO{
+			{ID: 2, Name: "Smartphones", Path: "Electronics > Smartphones", ProductCount: 1, Children: []dto.CategoryNodeDTO{}},
+		},
+	}}
+
+	mockListUseCase := new(MockListCategoriesUseCase)
+	mockListUseCase.On("Execute", mock.Anything).Return(tree, nil)
+
+	router := setupCategoryTestRouter(NewCategoryHandler(mockListUseCase, nil))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/categories", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"data":[{"id":1,"name":"Electronics","path":"Electronics","product_count":1,"children":[
+		{"id":2,"name":"Smartphones","path":"Electronics > Smartphones","product_count":1,"children":[]}
+	]}]}`, w.Body.String())
+}
+
+func TestCategoryHandler_ListCategories_Error(t *testing.T) {
+	mockListUseCase := new(MockListCategoriesUseCase)
+	mockListUseCase.On("Execute", mock.Anything).Return(nil, fmt.Errorf("%w: connection timeout", errors.ErrDatabaseError))
+
+	router := setupCategoryTestRouter(NewCategoryHandler(mockListUseCase, nil))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/categories", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestCategoryHandler_GetCategory_Success(t *testing.T) {
+	mockGetUseCase := new(MockGetCategoryUseCase)
+	mockGetUseCase.On("Execute", mock.Anything, dto.CategoryInputDTO{ID: 2}).Return(&dto.CategoryDTO{
+		ID:          2,
+		Name:        "Smartphones",
+		Path:        "Electronics > Smartphones",
+		Breadcrumbs: []dto.CategoryRefDTO{{ID: 1, Name: "Electronics"}, {ID: 2, Name: "Smartphones"}},
+		Children:    []dto.CategoryRefDTO{},
+	}, nil)
+
+	router := setupCategoryTestRouter(NewCategoryHandler(nil, mockGetUseCase))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/categories/2", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"breadcrumbs":[{"id":1,"name":"Electronics"},{"id":2,"name":"Smartphones"}]`)
+}
+
+func TestCategoryHandler_GetCategory_Errors(t *testing.T) {
+	tests := []struct {
+		name           string
+		path           string
+		useCaseErr     error
+		expectedStatus int
+	}{
+		{name: "not an integer", path: "/categories/abc", expectedStatus: http.StatusBadRequest},
+		{name: "not found", path: "/categories/99", useCaseErr: errors.ErrCategoryNotFound, expectedStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGetUseCase := new(MockGetCategoryUseCase)
+			mockGetUseCase.On("Execute", mock.Anything, mock.Anything).Return(nil, tt.useCaseErr)
+
+			router := setupCategoryTestRouter(NewCategoryHandler(nil, mockGetUseCase))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", tt.path, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"project/internal/dto"
+	"project/internal/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ListExchangeRatesUseCase interface {
+	Execute(ctx context.Context) (*dto.ExchangeRatesDTO, error)
+}
+
+type LoadExchangeRatesUseCase interface {
+	Execute(ctx context.Context, input dto.LoadExchangeRatesInputDTO) (*dto.ExchangeRatesDTO, error)
+}
+
+type ExchangeRateHandler struct {
+	listExchangeRatesUseCase ListExchangeRatesUseCase
+	loadExchangeRatesUseCase LoadExchangeRatesUseCase
+}
+
+func NewExchangeRateHandler(
+	listExchangeRatesUseCase ListExchangeRatesUseCase,
+	loadExchangeRatesUseCase LoadExchangeRatesUseCase,
+) *ExchangeRateHandler {
+	return &ExchangeRateHandler{
+		listExchangeRatesUseCase: listExchangeRatesUseCase,
+		loadExchangeRatesUseCase: loadExchangeRatesUseCase,
+	}
+}
+
+// ListExchangeRates godoc
+// @Summary List exchange rates
+// @Description Get every exchange rate, quoted against the base currency. These are the currencies accepted in ?currency= by the product endpoints.
+// @Tags exchange-rates
+// @Produce json
+// @Success 200 {object} dto.ExchangeRatesResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Router /api/v1/exchange-rates [get]
+func (h *ExchangeRateHandler) ListExchangeRates(c *gin.Context) {
+	result, err := h.listExchangeRatesUseCase.Execute(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": result,
+	})
+}
+
+// LoadExchangeRates godoc
+// @Summary Load exchange rates
+// @Description Add or replace exchange rates, quoted against the base currency (USD). Currencies left out keep their rate. Administrators only.
+// @Tags exchange-rates
+// @Accept json
+// @Produce json
+// @Param X-Admin-Token header string true "Administrator token"
+// @Param rates body dto.LoadExchangeRatesInputDTO true "Rates by currency code"
+// @Success 200 {object} dto.ExchangeRatesResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Router /api/v1/exchange-rates [put]
+func (h *ExchangeRateHandler) LoadExchangeRates(c *gin.Context) {
+	var input dto.LoadExchangeRatesInputDTO
+	if err := c.ShouldBindJSON(&input); err != nil {
+		_ = c.Error(errors.NewInvalidInputError("The request body is not a valid set of exchange rates"))
+		return
+	}
+
+	result, err := h.loadExchangeRatesUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": result,
+	})
+}
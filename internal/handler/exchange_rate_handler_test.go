@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"project/internal/dto"
+	"project/internal/infra/http/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockListExchangeRatesUseCase struct {
+	mock.Mock
+}
+
+func (m *MockListExchangeRatesUseCase) Execute(ctx context.Context) (*dto.ExchangeRatesDTO, error) {
+	args := m.Called(ctx)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ExchangeRatesDTO), nil
+}
+
+type MockLoadExchangeRatesUseCase struct {
+	mock.Mock
+}
+
+func (m *MockLoadExchangeRatesUseCase) Execute(ctx context.Context, input dto.LoadExchangeRatesInputDTO) (*dto.ExchangeRatesDTO, error) {
+	args := m.Called(ctx, input)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ExchangeRatesDTO), nil
+}
+
+func setupExchangeRateTestRouter(handler *ExchangeRateHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	r.Use(testErrorHandler)
+	r.Use(middleware.AdminMiddleware(testAdminToken))
+
+	r.GET("/exchange-rates", handler.ListExchangeRates)
+	r.PUT("/exchange-rates", middleware.RequireAdmin(), handler.LoadExchangeRates)
+
+	return r
+}
+
+func TestExchangeRateHandler_ListExchangeRates_Success(t *testing.T) {
+	mockListUseCase := new(MockListExchangeRatesUseCase)
+	mockListUseCase.On("Execute", mock.Anything).Return(&dto.ExchangeRatesDTO{
+		Base:  "USD",
+		Rates: []dto.ExchangeRateDTO{{Currency: "BRL", Rate: 4.92}},
+	}, nil)
+
+	router := setupExchangeRateTestRouter(NewExchangeRateHandler(mockListUseCase, nil))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/exchange-rates", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"base":"USD"`)
+	assert.Contains(t, w.Body.String(), `"currency":"BRL","rate":4.92`)
+}
+
+func TestExchangeRateHandler_LoadExchangeRates_Success(t *testing.T) {
+	mockLoadUseCase := new(MockLoadExchangeRatesUseCase)
+	mockLoadUseCase.On("Execute", mock.Anything, dto.LoadExchangeRatesInputDTO{Rates: map[string]float64{"BRL": 5.1}}).
+		Return(&dto.ExchangeRatesDTO{Base: "USD", Rates: []dto.ExchangeRateDTO{{Currency: "BRL", Rate: 5.1}}}, nil)
+
+	router := setupExchangeRateTestRouter(NewExchangeRateHandler(nil, mockLoadUseCase))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("PUT", "/exchange-rates", strings.NewReader(`{"rates": {"BRL": 5.1}}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(middleware.AdminTokenHeader, testAdminToken)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"rate":5.1`)
+	mockLoadUseCase.AssertExpectations(t)
+}
+
+func TestExchangeRateHandler_LoadExchangeRates_RequiresAdmin(t *testing.T) {
+	mockLoadUseCase := new(MockLoadExchangeRatesUseCase)
+
+	router := setupExchangeRateTestRouter(NewExchangeRateHandler(nil, mockLoadUseCase))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("PUT", "/exchange-rates", strings.NewReader(`{"rates": {"BRL": 5.1}}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	mockLoadUseCase.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
+
+func TestExchangeRateHandler_LoadExchangeRates_InvalidBody(t *testing.T) {
+	for _, body := range []string{`{"rates": {"BRL": "5.1"}}`, `{"rates": [5.1]}`, `not json`} {
+		t.Run(body, func(t *testing.T) {
+			mockLoadUseCase := new(MockLoadExchangeRatesUseCase)
+
+			router := setupExchangeRateTestRouter(NewExchangeRateHandler(nil, mockLoadUseCase))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("PUT", "/exchange-rates", strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set(middleware.AdminTokenHeader, testAdminToken)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			mockLoadUseCase.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"project/internal/dto"
+	"project/internal/infra/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GetProductInstallmentsUseCase interface {
+	Execute(ctx context.Context, input dto.ProductInstallmentsInputDTO) (*dto.ProductInstallmentsDTO, error)
+}
+
+type InstallmentHandler struct {
+	getProductInstallmentsUseCase GetProductInstallmentsUseCase
+}
+
+func NewInstallmentHandler(getProductInstallmentsUseCase GetProductInstallmentsUseCase) *InstallmentHandler {
+	return &InstallmentHandler{
+		getProductInstallmentsUseCase: getProductInstallmentsUseCase,
+	}
+}
+
+// GetProductInstallments godoc
+// @Summary List the installment options of a product
+// @Description Split the price of a product, discounted by its active promotion, by every installment plan of its currency, by ascending number of installments. Interest-free options add up to the price; the first installment carries the cents the split leaves over. Options with interest charge a monthly rate and report the total paid. Plans whose installments would be below their minimum are left out. A listing that is not active is only returned to administrators and to its seller, identified by X-Seller-ID.
+// @Tags installments
+// @Produce json
+// @Param id path string true "Product ID" example(MLB001)
+// @Param currency query string false "Convert the price to this currency, which must have an exchange rate, before splitting it" example(BRL)
+// @Param X-Admin-Token header string false "Administrator token"
+// @Param X-Seller-ID header string false "ID of the calling seller, who may get its own listings in any status" example(SELLER001)
+// @Success 200 {object} dto.ProductInstallmentsResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Router /api/v1/products/{id}/installments [get]
+func (h *InstallmentHandler) GetProductInstallments(c *gin.Context) {
+	result, err := h.getProductInstallmentsUseCase.Execute(c.Request.Context(), dto.ProductInstallmentsInputDTO{
+		ProductID: c.Param("id"),
+		Currency:  c.Query("currency"),
+		SellerID:  c.GetString(middleware.SellerContextKey),
+		IsAdmin:   c.GetBool(middleware.AdminContextKey),
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": result,
+	})
+}
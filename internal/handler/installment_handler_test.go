@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"project/internal/dto"
+	"project/internal/errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockGetProductInstallmentsUseCase struct {
+	mock.Mock
+}
+
+func (m *MockGetProductInstallmentsUseCase) Execute(ctx context.Context, input dto.ProductInstallmentsInputDTO) (*dto.ProductInstallmentsDTO, error) {
+	args := m.Called(ctx, input)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ProductInstallmentsDTO), nil
+}
+
+func setupInstallmentTestRouter(handler *InstallmentHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	r.Use(testErrorHandler)
+
+	r.GET("/products/:id/installments", handler.GetProductInstallments)
+
+	return r
+}
+
+func TestInstallmentHandler_GetProductInstallments_Success(t *testing.T) {
+	mockUseCase := new(MockGetProductInstallmentsUseCase)
+	mockUseCase.On("Execute", mock.Anything, dto.ProductInstallmentsInputDTO{ProductID: "MLB001", Currency: "BRL"}).Return(&dto.ProductInstallmentsDTO{
+		ProductID: "MLB001",
+		Price:     6395.95,
+		Currency:  "BRL",
+		Options: []dto.InstallmentDTO{
+			{Quantity: 12, Amount: 532.99, FirstAmount: 533.06, Total: 6395.95, Currency: "BRL", InterestFree: true},
+		},
+	}, nil)
+
+	router := setupInstallmentTestRouter(NewInstallmentHandler(mockUseCase))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products/MLB001/installments?currency=BRL", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"price":6395.95,"currency":"BRL"`)
+	assert.Contains(t, w.Body.String(), `"quantity":12,"amount":532.99,"first_amount":533.06`)
+}
+
+func TestInstallmentHandler_GetProductInstallments_NotFound(t *testing.T) {
+	mockUseCase := new(MockGetProductInstallmentsUseCase)
+	mockUseCase.On("Execute", mock.Anything, mock.Anything).Return(nil, errors.ErrProductNotFound)
+
+	router := setupInstallmentTestRouter(NewInstallmentHandler(mockUseCase))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products/MLB999/installments", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "PRODUCT_NOT_FOUND")
+}
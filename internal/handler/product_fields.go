@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"encoding/json"
+	"project/internal/dto"
+	"project/internal/errors"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// productFieldNames are the JSON names of dto.ProductDTO, the values accepted
+// in ?fields=.
+var productFieldNames = jsonFieldNames(reflect.TypeOf(dto.ProductDTO{}))
+
+func jsonFieldNames(t reflect.Type) map[string]bool {
+	names := map[string]bool{}
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			names[name] = true
+		}
+	}
+	return names
+}
+
+// fieldsParam reads the sparse fieldset in ?fields=id,title,price, nil when
+// absent so that products are rendered whole.
+func fieldsParam(c *gin.Context) ([]string, error) {
+	value := c.Query("fields")
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+
+	fields := []string{}
+	for _, field := range strings.Split(value, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if !productFieldNames[field] {
+			return nil, errors.NewInvalidInputError("fields contains unknown field '" + field + "'")
+		}
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+// listExpandNames are the values accepted in ?expand= by product listings.
+var listExpandNames = []string{"images", "seller"}
+
+// productExpandNames are the values accepted in ?expand= when getting a single
+// product. It always embeds its images and seller, so only questions changes
+// the response.
+var productExpandNames = []string{"images", "seller", "questions"}
+
+// expandParam reads the related data to embed from ?expand=images,seller,
+// rejecting any name not in allowed.
+func expandParam(c *gin.Context, allowed []string) (dto.ProductExpandDTO, error) {
+	var expand dto.ProductExpandDTO
+
+	value := c.Query("expand")
+	if strings.TrimSpace(value) == "" {
+		return expand, nil
+	}
+
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if !slices.Contains(allowed, name) {
+			return dto.ProductExpandDTO{}, errors.NewInvalidInputError("expand must be a comma-separated list of " + quotedList(allowed))
+		}
+
+		switch name {
+		case "images":
+			expand.Images = true
+		case "seller":
+			expand.Seller = true
+		case "questions":
+			expand.Questions = true
+		}
+	}
+
+	return expand, nil
+}
+
+// quotedList renders names as 'a', 'b' and 'c'.
+func quotedList(names []string) string {
+	quoted := make([]string, 0, len(names))
+	for _, name := range names {
+		quoted = append(quoted, "'"+name+"'")
+	}
+
+	last := len(quoted) - 1
+	if last == 0 {
+		return quoted[0]
+	}
+	return strings.Join(quoted[:last], ", ") + " and " + quoted[last]
+}
+
+// selectFields renders product with only fields, or whole when fields is nil.
+// Fields that are empty on product stay omitted as in the full rendering.
+func selectFields(product dto.ProductDTO, fields []string) (any, error) {
+	if fields == nil {
+		return product, nil
+	}
+
+	encoded, err := json.Marshal(product)
+	if err != nil {
+		return nil, err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &all); err != nil {
+		return nil, err
+	}
+
+	selected := make(map[string]json.RawMessage, len(fields))
+	for _, field := range fields {
+		if value, ok := all[field]; ok {
+			selected[field] = value
+		}
+	}
+
+	return selected, nil
+}
+
+// selectProductsFields applies selectFields to every product of a page.
+func selectProductsFields(products []dto.ProductDTO, fields []string) (any, error) {
+	if fields == nil {
+		return products, nil
+	}
+
+	selected := make([]any, 0, len(products))
+	for _, product := range products {
+		trimmed, err := selectFields(product, fields)
+		if err != nil {
+			return nil, err
+		}
+		selected = append(selected, trimmed)
+	}
+
+	return selected, nil
+}
@@ -2,75 +2,735 @@ package handler
 
 import (
 	"context"
+	"math"
 	"net/http"
+	"net/url"
+	"path"
 	"project/internal/dto"
+	"project/internal/errors"
+	"project/internal/infra/http/middleware"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
 type ListProductUseCase interface {
-	Execute(ctx context.Context) ([]dto.ProductDTO, error)
+	Execute(ctx context.Context, input dto.ListProductInputDTO) (*dto.ProductListDTO, error)
+}
+
+type SearchProductsUseCase interface {
+	Execute(ctx context.Context, input dto.ProductSearchInputDTO) (*dto.ProductListDTO, error)
+}
+
+type BatchGetProductsUseCase interface {
+	Execute(ctx context.Context, input dto.BatchGetProductsInputDTO) (*dto.ProductBatchDTO, error)
 }
 
 type GetProductUseCase interface {
 	Execute(ctx context.Context, input dto.ProductInputDTO) (*dto.ProductDTO, error)
 }
 
+type CreateProductUseCase interface {
+	Execute(ctx context.Context, input dto.CreateProductInputDTO) (*dto.ProductDTO, error)
+}
+
+type UpdateProductUseCase interface {
+	Execute(ctx context.Context, input dto.UpdateProductInputDTO) (*dto.ProductDTO, error)
+}
+
+type PatchProductUseCase interface {
+	Execute(ctx context.Context, input dto.PatchProductInputDTO) (*dto.ProductDTO, error)
+}
+
+type DeleteProductUseCase interface {
+	Execute(ctx context.Context, input dto.ProductInputDTO) error
+}
+
+type RestoreProductUseCase interface {
+	Execute(ctx context.Context, input dto.ProductInputDTO) (*dto.ProductDTO, error)
+}
+
 type ProductHandler struct {
-	listProductUseCase ListProductUseCase
-	getProductUseCase  GetProductUseCase
+	listProductUseCase    ListProductUseCase
+	getProductUseCase     GetProductUseCase
+	createProductUseCase  CreateProductUseCase
+	updateProductUseCase  UpdateProductUseCase
+	patchProductUseCase   PatchProductUseCase
+	deleteProductUseCase  DeleteProductUseCase
+	restoreProductUseCase RestoreProductUseCase
+	searchProductsUseCase SearchProductsUseCase
+	batchGetUseCase       BatchGetProductsUseCase
 }
 
-func NewProductHandler(listProductUseCase ListProductUseCase, getProductUseCase GetProductUseCase) *ProductHandler {
+func NewProductHandler(
+	listProductUseCase ListProductUseCase,
+	getProductUseCase GetProductUseCase,
+	createProductUseCase CreateProductUseCase,
+	updateProductUseCase UpdateProductUseCase,
+	patchProductUseCase PatchProductUseCase,
+	deleteProductUseCase DeleteProductUseCase,
+	restoreProductUseCase RestoreProductUseCase,
+	searchProductsUseCase SearchProductsUseCase,
+	batchGetUseCase BatchGetProductsUseCase,
+) *ProductHandler {
 	return &ProductHandler{
-		listProductUseCase: listProductUseCase,
-		getProductUseCase:  getProductUseCase,
+		listProductUseCase:    listProductUseCase,
+		getProductUseCase:     getProductUseCase,
+		createProductUseCase:  createProductUseCase,
+		updateProductUseCase:  updateProductUseCase,
+		patchProductUseCase:   patchProductUseCase,
+		deleteProductUseCase:  deleteProductUseCase,
+		restoreProductUseCase: restoreProductUseCase,
+		searchProductsUseCase: searchProductsUseCase,
+		batchGetUseCase:       batchGetUseCase,
 	}
 }
 
 // ListProducts godoc
-// @Summary List all products
-// @Description Get a list of all products with thumbnails
+// @Summary List products
+// @Description Get a page of products with thumbnails, ordered by ID. Follow pagination.next_cursor to read the next page. Facets count every product matching the filters.
+// @Description Filter by attribute with attr.<name>=<value>, as in attr.brand=Apple&attr.storage=256GB; every attribute must match, ignoring case, on the product or on one of its variations.
+// @Description During an active promotion, price is discounted and original_price, original_currency and discount_percent show the list price and the discount. Price filters and facets use the list price.
+// @Description Price filters and price ranges compare prices in currency, or in USD without it, converting the other currencies through the exchange rates; a product priced in a currency without an exchange rate matches no price filter and is counted in no price range.
+// @Description Only active listings are listed unless status asks for another one, which only administrators and a seller listing its own products, with seller_id and X-Seller-ID, may do.
+// @Description With ids, returns those products with all their images instead, in the requested order, as a dto.ProductBatchResponse. Each ID not found, or not active and not of the seller in X-Seller-ID, is reported in errors; ids cannot be combined with pagination, filters or expand.
 // @Tags products
 // @Accept json
 // @Produce json
+// @Param ids query string false "Comma-separated product IDs to get at once (at most 100)" example(MLB001,MLB002)
+// @Param limit query int false "Page size (1-100)" default(20)
+// @Param cursor query string false "Opaque cursor from pagination.next_cursor"
+// @Param status query string false "Listing status" Enums(active, paused, closed, under_review, scheduled) default(active)
+// @Param category query string false "Category path; also matches its subcategories" example(Electronics > Smartphones)
+// @Param category_id query int false "Category ID; also matches its subcategories" example(6)
+// @Param condition query string false "Product condition" Enums(new, used, refurbished)
+// @Param seller_id query string false "Seller ID" example(SELLER001)
+// @Param min_price query number false "Minimum price, inclusive, in currency or in USD without it"
+// @Param max_price query number false "Maximum price, inclusive, in currency or in USD without it"
+// @Param in_stock query bool false "Only products with (true) or without (false) stock"
+// @Param fields query string false "Comma-separated product fields to return" example(id,title,price)
+// @Param expand query string false "Comma-separated related data to embed in each product: images, seller" example(images,seller)
+// @Param currency query string false "Convert prices to this currency, which must have an exchange rate" example(BRL)
+// @Param price_format query string false "Also render prices exactly, in minor units, as price_money" Enums(number, money) default(number)
+// @Param include_deleted query bool false "Include soft deleted products (administrators only)"
+// @Param X-Admin-Token header string false "Administrator token"
+// @Param X-Seller-ID header string false "ID of the calling seller, who may list its own listings in any status" example(SELLER001)
 // @Success 200 {object} dto.ProductListResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 403 {object} errors.ErrorResponse
 // @Failure 500 {object} errors.ErrorResponse
 // @Router /api/v1/products [get]
 func (h *ProductHandler) ListProducts(c *gin.Context) {
-	result, err := h.listProductUseCase.Execute(c.Request.Context())
+	if _, ok := c.GetQuery("ids"); ok {
+		h.batchGetProducts(c)
+		return
+	}
+
+	input, fields, err := listProductsParams(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	if err := listingStatusAllowed(c, input.Status, input.SellerID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	result, err := h.listProductUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	writeProductList(c, result, fields)
+}
+
+// listProductsParams reads the query of a product list, returning the use
+// case input and the requested sparse fields.
+func listProductsParams(c *gin.Context) (dto.ListProductInputDTO, []string, error) {
+	includeDeleted, err := includeDeletedParam(c)
+	if err != nil {
+		return dto.ListProductInputDTO{}, nil, err
+	}
+
+	limit, err := intQueryParam(c, "limit")
+	if err != nil {
+		return dto.ListProductInputDTO{}, nil, err
+	}
+
+	filters, err := productFiltersParams(c)
+	if err != nil {
+		return dto.ListProductInputDTO{}, nil, err
+	}
+
+	expand, err := expandParam(c, listExpandNames)
+	if err != nil {
+		return dto.ListProductInputDTO{}, nil, err
+	}
+
+	fields, err := fieldsParam(c)
+	if err != nil {
+		return dto.ListProductInputDTO{}, nil, err
+	}
+
+	return dto.ListProductInputDTO{
+		IncludeDeleted:    includeDeleted,
+		Limit:             limit,
+		Cursor:            c.Query("cursor"),
+		Expand:            expand,
+		Currency:          c.Query("currency"),
+		PriceFormat:       c.Query("price_format"),
+		ProductFiltersDTO: filters,
+	}, fields, nil
+}
+
+// writeProductList renders a page of products trimmed to fields.
+func writeProductList(c *gin.Context, result *dto.ProductListDTO, fields []string) {
+	products, err := selectProductsFields(result.Products, fields)
 	if err != nil {
 		_ = c.Error(err)
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"data": result,
+		"data":       products,
+		"pagination": result.Pagination,
+		"facets":     result.Facets,
 	})
 }
 
+// batchListParams are the list parameters that make no sense for a batch get.
+var batchListParams = []string{"limit", "cursor", "status", "category", "category_id", "condition", "seller_id", "min_price", "max_price", "in_stock", "expand"}
+
+// batchGetProducts serves GET /products?ids=, which returns the listed
+// products with their images and reports every ID that was not found.
+func (h *ProductHandler) batchGetProducts(c *gin.Context) {
+	for _, name := range batchListParams {
+		if _, ok := c.GetQuery(name); ok {
+			_ = c.Error(errors.NewInvalidInputError("ids cannot be combined with " + name))
+			return
+		}
+	}
+	if len(attributeQueryParams(c)) > 0 {
+		_ = c.Error(errors.NewInvalidInputError("ids cannot be combined with " + attributeQueryParamPrefix + "<name>"))
+		return
+	}
+
+	includeDeleted, err := includeDeletedParam(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	fields, err := fieldsParam(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	result, err := h.batchGetUseCase.Execute(c.Request.Context(), dto.BatchGetProductsInputDTO{
+		IDs:            strings.Split(c.Query("ids"), ","),
+		IncludeDeleted: includeDeleted,
+		Currency:       c.Query("currency"),
+		PriceFormat:    c.Query("price_format"),
+		SellerID:       c.GetString(middleware.SellerContextKey),
+		IsAdmin:        c.GetBool(middleware.AdminContextKey),
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	products, err := selectProductsFields(result.Products, fields)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":   products,
+		"errors": result.Errors,
+	})
+}
+
+// SearchProducts godoc
+// @Summary Search products
+// @Description Full-text search over title, description and category, best matches first. Matched terms are wrapped in <em> in the highlight of each result. Accepts the same filters as the product list, status included, and returns the same facets, counted over every match. Price filters and price ranges compare prices in currency, or in USD without it, as in the product list.
+// @Tags products
+// @Produce json
+// @Param q query string true "Search text; the last word also matches as a prefix" example(iphone pro)
+// @Param limit query int false "Page size (1-100)" default(20)
+// @Param cursor query string false "Opaque cursor from pagination.next_cursor"
+// @Param status query string false "Listing status" Enums(active, paused, closed, under_review, scheduled) default(active)
+// @Param category query string false "Category path; also matches its subcategories"
+// @Param category_id query int false "Category ID; also matches its subcategories"
+// @Param condition query string false "Product condition" Enums(new, used, refurbished)
+// @Param seller_id query string false "Seller ID"
+// @Param min_price query number false "Minimum price, inclusive, in currency or in USD without it"
+// @Param max_price query number false "Maximum price, inclusive, in currency or in USD without it"
+// @Param in_stock query bool false "Only products with (true) or without (false) stock"
+// @Param fields query string false "Comma-separated product fields to return" example(id,title,price)
+// @Param expand query string false "Comma-separated related data to embed in each product: images, seller" example(images,seller)
+// @Param currency query string false "Convert prices to this currency, which must have an exchange rate" example(BRL)
+// @Param price_format query string false "Also render prices exactly, in minor units, as price_money" Enums(number, money) default(number)
+// @Param X-Admin-Token header string false "Administrator token"
+// @Param X-Seller-ID header string false "ID of the calling seller, who may search its own listings in any status" example(SELLER001)
+// @Success 200 {object} dto.ProductListResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Failure 501 {object} errors.ErrorResponse
+// @Router /api/v1/products/search [get]
+func (h *ProductHandler) SearchProducts(c *gin.Context) {
+	limit, err := intQueryParam(c, "limit")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	filters, err := productFiltersParams(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	if err := listingStatusAllowed(c, filters.Status, filters.SellerID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	expand, err := expandParam(c, listExpandNames)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	fields, err := fieldsParam(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	result, err := h.searchProductsUseCase.Execute(c.Request.Context(), dto.ProductSearchInputDTO{
+		Query:             c.Query("q"),
+		Limit:             limit,
+		Cursor:            c.Query("cursor"),
+		Expand:            expand,
+		Currency:          c.Query("currency"),
+		PriceFormat:       c.Query("price_format"),
+		ProductFiltersDTO: filters,
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	writeProductList(c, result, fields)
+}
+
 // GetProduct godoc
 // @Summary Get a product by ID
-// @Description Get product details by product ID including all images
+// @Description Get product details by product ID including all images, the variations, the seller and the category breadcrumbs. During an active promotion, price is discounted and original_price, original_currency and discount_percent show the list price and the discount. With expand=questions, the latest answered questions are embedded too. A listing that is not active is only returned to administrators and to its seller, identified by X-Seller-ID.
 // @Tags products
 // @Accept json
 // @Produce json
 // @Param id path string true "Product ID" example(MLB001)
+// @Param fields query string false "Comma-separated product fields to return" example(id,title,price)
+// @Param expand query string false "Comma-separated related data to embed: questions (images and seller are always embedded)" example(questions)
+// @Param currency query string false "Convert prices to this currency, which must have an exchange rate" example(BRL)
+// @Param price_format query string false "Also render prices exactly, in minor units, as price_money" Enums(number, money) default(number)
+// @Param include_deleted query bool false "Return the product even if soft deleted (administrators only)"
+// @Param X-Admin-Token header string false "Administrator token"
+// @Param X-Seller-ID header string false "ID of the calling seller, who may get its own listings in any status" example(SELLER001)
 // @Success 200 {object} dto.ProductResponse
+// @Header 200 {string} ETag "Product version, to be sent back in If-Match on updates"
 // @Failure 400 {object} errors.ErrorResponse
+// @Failure 403 {object} errors.ErrorResponse
 // @Failure 404 {object} errors.ErrorResponse
 // @Failure 500 {object} errors.ErrorResponse
 // @Router /api/v1/products/{id} [get]
 func (h *ProductHandler) GetProduct(c *gin.Context) {
 	id := c.Param("id")
 
-	result, err := h.getProductUseCase.Execute(c.Request.Context(), dto.ProductInputDTO{ID: id})
+	includeDeleted, err := includeDeletedParam(c)
 	if err != nil {
 		_ = c.Error(err)
 		return
 	}
 
+	expand, err := expandParam(c, productExpandNames)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	fields, err := fieldsParam(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	result, err := h.getProductUseCase.Execute(c.Request.Context(), dto.ProductInputDTO{
+		ID:             id,
+		IncludeDeleted: includeDeleted,
+		Expand:         expand,
+		Currency:       c.Query("currency"),
+		PriceFormat:    c.Query("price_format"),
+		SellerID:       c.GetString(middleware.SellerContextKey),
+		IsAdmin:        c.GetBool(middleware.AdminContextKey),
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	product, err := selectFields(*result, fields)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Header("ETag", etag(result.UpdatedAt))
+	c.JSON(http.StatusOK, gin.H{
+		"data": product,
+	})
+}
+
+// CreateProduct godoc
+// @Summary Create a product
+// @Description Create a product together with its images. The ID is generated when omitted. Attributes must be defined by the category or one of its ancestors, and the required ones must be present. A product with variations is priced at its cheapest variation and has the stock of all of them. A future publish_at schedules the listing, which goes live at that time, and the listing closes at expires_at.
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param product body dto.CreateProductInputDTO true "Product to create"
+// @Success 201 {object} dto.ProductResponse
+// @Header 201 {string} Location "URL of the created product"
+// @Header 201 {string} ETag "Product version"
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
+// @Failure 422 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Router /api/v1/products [post]
+func (h *ProductHandler) CreateProduct(c *gin.Context) {
+	var input dto.CreateProductInputDTO
+	if err := c.ShouldBindJSON(&input); err != nil {
+		_ = c.Error(errors.NewInvalidInputError("The request body is not a valid product"))
+		return
+	}
+
+	result, err := h.createProductUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Header("Location", path.Join(c.Request.URL.Path, url.PathEscape(result.ID)))
+	c.Header("ETag", etag(result.UpdatedAt))
+	c.JSON(http.StatusCreated, gin.H{
+		"data": result,
+	})
+}
+
+// UpdateProduct godoc
+// @Summary Replace a product
+// @Description Replace every writable field of a product, including its images and variations. Requires the ETag from a previous read in If-Match.
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID" example(MLB001)
+// @Param If-Match header string true "ETag of the product version being replaced"
+// @Param product body dto.ProductFieldsDTO true "New product representation"
+// @Success 200 {object} dto.ProductResponse
+// @Header 200 {string} ETag "New product version"
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 412 {object} errors.ErrorResponse
+// @Failure 422 {object} errors.ErrorResponse
+// @Failure 428 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Router /api/v1/products/{id} [put]
+func (h *ProductHandler) UpdateProduct(c *gin.Context) {
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	input := dto.UpdateProductInputDTO{ID: c.Param("id"), Version: version}
+	if err := c.ShouldBindJSON(&input.ProductFieldsDTO); err != nil {
+		_ = c.Error(errors.NewInvalidInputError("The request body is not a valid product"))
+		return
+	}
+
+	result, err := h.updateProductUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Header("ETag", etag(result.UpdatedAt))
+	c.JSON(http.StatusOK, gin.H{
+		"data": result,
+	})
+}
+
+// PatchProduct godoc
+// @Summary Partially update a product
+// @Description Apply a JSON merge patch (RFC 7396) to a product. Requires the ETag from a previous read in If-Match.
+// @Tags products
+// @Accept json
+// @Accept application/merge-patch+json
+// @Produce json
+// @Param id path string true "Product ID" example(MLB001)
+// @Param If-Match header string true "ETag of the product version being patched"
+// @Param patch body object true "JSON merge patch document"
+// @Success 200 {object} dto.ProductResponse
+// @Header 200 {string} ETag "New product version"
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 412 {object} errors.ErrorResponse
+// @Failure 422 {object} errors.ErrorResponse
+// @Failure 428 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Router /api/v1/products/{id} [patch]
+func (h *ProductHandler) PatchProduct(c *gin.Context) {
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	patch, err := c.GetRawData()
+	if err != nil {
+		_ = c.Error(errors.NewInvalidInputError("The request body could not be read"))
+		return
+	}
+
+	result, err := h.patchProductUseCase.Execute(c.Request.Context(), dto.PatchProductInputDTO{
+		ID:      c.Param("id"),
+		Version: version,
+		Patch:   patch,
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Header("ETag", etag(result.UpdatedAt))
+	c.JSON(http.StatusOK, gin.H{
+		"data": result,
+	})
+}
+
+// DeleteProduct godoc
+// @Summary Delete a product
+// @Description Soft delete a product. It disappears from reads but can be restored by an administrator until it is purged.
+// @Tags products
+// @Param id path string true "Product ID" example(MLB001)
+// @Success 204
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Router /api/v1/products/{id} [delete]
+func (h *ProductHandler) DeleteProduct(c *gin.Context) {
+	if err := h.deleteProductUseCase.Execute(c.Request.Context(), dto.ProductInputDTO{ID: c.Param("id")}); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RestoreProduct godoc
+// @Summary Restore a deleted product
+// @Description Undo the soft delete of a product that has not been purged yet. Administrators only.
+// @Tags products
+// @Produce json
+// @Param id path string true "Product ID" example(MLB001)
+// @Param X-Admin-Token header string true "Administrator token"
+// @Success 200 {object} dto.ProductResponse
+// @Header 200 {string} ETag "Product version"
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Router /api/v1/products/{id}/restore [post]
+func (h *ProductHandler) RestoreProduct(c *gin.Context) {
+	result, err := h.restoreProductUseCase.Execute(c.Request.Context(), dto.ProductInputDTO{ID: c.Param("id")})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Header("ETag", etag(result.UpdatedAt))
 	c.JSON(http.StatusOK, gin.H{
 		"data": result,
 	})
 }
+
+// includeDeletedParam reads the include_deleted query flag, which only
+// administrators may turn on.
+func includeDeletedParam(c *gin.Context) (bool, error) {
+	includeDeleted, err := boolQueryParam(c, "include_deleted")
+	if err != nil || includeDeleted == nil {
+		return false, err
+	}
+
+	if *includeDeleted && !c.GetBool(middleware.AdminContextKey) {
+		return false, errors.ErrForbidden
+	}
+
+	return *includeDeleted, nil
+}
+
+// listingStatusAllowed checks that the caller may list the products of
+// sellerID in status: active listings are public, the others are only listed
+// to administrators and to a seller listing its own products.
+func listingStatusAllowed(c *gin.Context, status, sellerID string) error {
+	if status == "" || status == "active" || c.GetBool(middleware.AdminContextKey) {
+		return nil
+	}
+
+	caller := c.GetString(middleware.SellerContextKey)
+	if caller == "" || caller != sellerID {
+		return errors.NewForbiddenError("Only administrators, or sellers listing their own products, can list products that are not active")
+	}
+
+	return nil
+}
+
+// productFiltersParams reads the product list filters from the query string.
+// Only the syntax is checked here; the use case validates the values.
+func productFiltersParams(c *gin.Context) (dto.ProductFiltersDTO, error) {
+	filters := dto.ProductFiltersDTO{
+		Status:    c.Query("status"),
+		Category:  c.Query("category"),
+		Condition: c.Query("condition"),
+		SellerID:  c.Query("seller_id"),
+	}
+
+	var err error
+	if filters.CategoryID, err = int64QueryParam(c, "category_id"); err != nil {
+		return dto.ProductFiltersDTO{}, err
+	}
+	if filters.MinPrice, err = floatQueryParam(c, "min_price"); err != nil {
+		return dto.ProductFiltersDTO{}, err
+	}
+	if filters.MaxPrice, err = floatQueryParam(c, "max_price"); err != nil {
+		return dto.ProductFiltersDTO{}, err
+	}
+	if filters.InStock, err = boolQueryParam(c, "in_stock"); err != nil {
+		return dto.ProductFiltersDTO{}, err
+	}
+	filters.Attributes = attributeQueryParams(c)
+
+	return filters, nil
+}
+
+// attributeQueryParamPrefix starts the query parameters that filter products
+// by attribute, as in ?attr.brand=Apple.
+const attributeQueryParamPrefix = "attr."
+
+// attributeQueryParams reads the attr.<name> query parameters into a map of
+// attribute names to values, nil when there are none. Like c.Query, only the
+// first value of a repeated parameter is used.
+func attributeQueryParams(c *gin.Context) map[string]string {
+	var attributes map[string]string
+	for key, values := range c.Request.URL.Query() {
+		name, ok := strings.CutPrefix(key, attributeQueryParamPrefix)
+		if !ok {
+			continue
+		}
+		if attributes == nil {
+			attributes = map[string]string{}
+		}
+		attributes[name] = values[0]
+	}
+
+	return attributes
+}
+
+// boolQueryParam reads an optional boolean query parameter, nil when absent.
+func boolQueryParam(c *gin.Context, name string) (*bool, error) {
+	value := c.Query(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, errors.NewInvalidInputError(name + " must be a boolean")
+	}
+
+	return &parsed, nil
+}
+
+// floatQueryParam reads an optional decimal query parameter, nil when absent.
+func floatQueryParam(c *gin.Context, name string) (*float64, error) {
+	value := c.Query(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(parsed) || math.IsInf(parsed, 0) {
+		return nil, errors.NewInvalidInputError(name + " must be a number")
+	}
+
+	return &parsed, nil
+}
+
+// int64QueryParam reads an optional integer query parameter, nil when absent.
+func int64QueryParam(c *gin.Context, name string) (*int64, error) {
+	value := c.Query(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, errors.NewInvalidInputError(name + " must be an integer")
+	}
+
+	return &parsed, nil
+}
+
+// intQueryParam reads an optional integer query parameter, zero when absent.
+func intQueryParam(c *gin.Context, name string) (int, error) {
+	value := c.Query(name)
+	if value == "" {
+		return 0, nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, errors.NewInvalidInputError(name + " must be an integer")
+	}
+
+	return number, nil
+}
+
+// etag renders a product version, its UpdatedAt timestamp, as a strong entity tag.
+func etag(updatedAt time.Time) string {
+	return `"` + updatedAt.UTC().Format(time.RFC3339Nano) + `"`
+}
+
+// ifMatchVersion reads the product version sent by the client in If-Match.
+// A tag that cannot be parsed can never match the stored version.
+func ifMatchVersion(c *gin.Context) (time.Time, error) {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	if header == "" {
+		return time.Time{}, errors.ErrPreconditionRequired
+	}
+
+	tag := strings.Trim(strings.TrimPrefix(header, "W/"), `"`)
+	version, err := time.Parse(time.RFC3339Nano, tag)
+	if err != nil {
+		return time.Time{}, errors.ErrVersionConflict
+	}
+
+	return version, nil
+}
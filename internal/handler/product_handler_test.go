@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"project/internal/dto"
 	"project/internal/errors"
+	"project/internal/infra/http/middleware"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -21,12 +23,24 @@ type MockListProductUseCase struct {
 	mock.Mock
 }
 
-func (m *MockListProductUseCase) Execute(ctx context.Context) ([]dto.ProductDTO, error) {
-	args := m.Called(ctx)
+func (m *MockListProductUseCase) Execute(ctx context.Context, input dto.ListProductInputDTO) (*dto.ProductListDTO, error) {
+	args := m.Called(ctx, input)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ProductListDTO), nil
+}
+
+type MockBatchGetProductsUseCase struct {
+	mock.Mock
+}
+
+func (m *MockBatchGetProductsUseCase) Execute(ctx context.Context, input dto.BatchGetProductsInputDTO) (*dto.ProductBatchDTO, error) {
+	args := m.Called(ctx, input)
 	if args.Error(1) != nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).([]dto.ProductDTO), nil
+	return args.Get(0).(*dto.ProductBatchDTO), nil
 }
 
 type MockGetProductUseCase struct {
@@ -41,50 +55,136 @@ func (m *MockGetProductUseCase) Execute(ctx context.Context, input dto.ProductIn
 	return args.Get(0).(*dto.ProductDTO), nil
 }
 
+type MockCreateProductUseCase struct {
+	mock.Mock
+}
+
+func (m *MockCreateProductUseCase) Execute(ctx context.Context, input dto.CreateProductInputDTO) (*dto.ProductDTO, error) {
+	args := m.Called(ctx, input)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ProductDTO), nil
+}
+
+type MockUpdateProductUseCase struct {
+	mock.Mock
+}
+
+func (m *MockUpdateProductUseCase) Execute(ctx context.Context, input dto.UpdateProductInputDTO) (*dto.ProductDTO, error) {
+	args := m.Called(ctx, input)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ProductDTO), nil
+}
+
+type MockPatchProductUseCase struct {
+	mock.Mock
+}
+
+func (m *MockPatchProductUseCase) Execute(ctx context.Context, input dto.PatchProductInputDTO) (*dto.ProductDTO, error) {
+	args := m.Called(ctx, input)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ProductDTO), nil
+}
+
+type MockDeleteProductUseCase struct {
+	mock.Mock
+}
+
+func (m *MockDeleteProductUseCase) Execute(ctx context.Context, input dto.ProductInputDTO) error {
+	args := m.Called(ctx, input)
+	return args.Error(0)
+}
+
+type MockRestoreProductUseCase struct {
+	mock.Mock
+}
+
+func (m *MockRestoreProductUseCase) Execute(ctx context.Context, input dto.ProductInputDTO) (*dto.ProductDTO, error) {
+	args := m.Called(ctx, input)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ProductDTO), nil
+}
+
+type MockSearchProductsUseCase struct {
+	mock.Mock
+}
+
+func (m *MockSearchProductsUseCase) Execute(ctx context.Context, input dto.ProductSearchInputDTO) (*dto.ProductListDTO, error) {
+	args := m.Called(ctx, input)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ProductListDTO), nil
+}
+
+const testAdminToken = "test-admin-token"
+
+// testErrorHandler renders the errors added by the handlers like the error
+// middleware does, but with the raw error message.
+func testErrorHandler(c *gin.Context) {
+	c.Next()
+	if len(c.Errors) > 0 {
+		err := c.Errors.Last().Err
+		statusCode := errors.GetStatusCode(err)
+		errorCode := errors.GetErrorCode(err)
+		c.JSON(statusCode, errors.ErrorResponse{
+			Error:     err.Error(),
+			Code:      errorCode,
+			Timestamp: time.Now(),
+		})
+	}
+}
+
 func setupTestRouter(handler *ProductHandler) *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
 
-	r.Use(func(c *gin.Context) {
-		c.Next()
-		if len(c.Errors) > 0 {
-			err := c.Errors.Last().Err
-			statusCode := errors.GetStatusCode(err)
-			errorCode := errors.GetErrorCode(err)
-			c.JSON(statusCode, errors.ErrorResponse{
-				Error:     err.Error(),
-				Code:      errorCode,
-				Timestamp: time.Now(),
-			})
-		}
-	})
+	r.Use(testErrorHandler)
+	r.Use(middleware.AdminMiddleware(testAdminToken))
+	r.Use(middleware.SellerMiddleware())
 
 	r.GET("/products", handler.ListProducts)
+	r.GET("/products/search", handler.SearchProducts)
 	r.GET("/products/:id", handler.GetProduct)
+	r.POST("/products", handler.CreateProduct)
+	r.PUT("/products/:id", handler.UpdateProduct)
+	r.PATCH("/products/:id", handler.PatchProduct)
+	r.DELETE("/products/:id", handler.DeleteProduct)
+	r.POST("/products/:id/restore", handler.RestoreProduct)
 
 	return r
 }
 
 func TestProductHandler_ListProducts_Success(t *testing.T) {
-	result := []dto.ProductDTO{
-		{
-			ID:       "PROD-1",
-			Title:    "Product 1",
-			Price:    100.0,
-			Currency: "USD",
-		},
-		{
-			ID:       "PROD-2",
-			Title:    "Product 2",
-			Price:    200.0,
-			Currency: "USD",
+	result := &dto.ProductListDTO{
+		Products: []dto.ProductDTO{
+			{
+				ID:       "PROD-1",
+				Title:    "Product 1",
+				Price:    100.0,
+				Currency: "USD",
+			},
+			{
+				ID:       "PROD-2",
+				Title:    "Product 2",
+				Price:    200.0,
+				Currency: "USD",
+			},
 		},
+		Pagination: dto.PaginationDTO{Limit: 20},
 	}
 
 	mockListUseCase := new(MockListProductUseCase)
-	mockListUseCase.On("Execute", mock.Anything).Return(result, nil)
+	mockListUseCase.On("Execute", mock.Anything, dto.ListProductInputDTO{}).Return(result, nil)
 
-	handler := NewProductHandler(mockListUseCase, nil)
+	handler := NewProductHandler(mockListUseCase, nil, nil, nil, nil, nil, nil, nil, nil)
 	router := setupTestRouter(handler)
 
 	w := httptest.NewRecorder()
@@ -100,13 +200,17 @@ func TestProductHandler_ListProducts_Success(t *testing.T) {
 
 	data := response["data"].([]interface{})
 	assert.Len(t, data, 2)
+
+	pagination := response["pagination"].(map[string]interface{})
+	assert.Equal(t, false, pagination["has_more"])
+	assert.NotContains(t, pagination, "next_cursor")
 }
 
 func TestProductHandler_ListProducts_EmptyList(t *testing.T) {
 	mockListUseCase := new(MockListProductUseCase)
-	mockListUseCase.On("Execute", mock.Anything).Return([]dto.ProductDTO{}, nil)
+	mockListUseCase.On("Execute", mock.Anything, dto.ListProductInputDTO{}).Return(&dto.ProductListDTO{Products: []dto.ProductDTO{}}, nil)
 
-	handler := NewProductHandler(mockListUseCase, nil)
+	handler := NewProductHandler(mockListUseCase, nil, nil, nil, nil, nil, nil, nil, nil)
 	router := setupTestRouter(handler)
 
 	w := httptest.NewRecorder()
@@ -125,9 +229,9 @@ func TestProductHandler_ListProducts_EmptyList(t *testing.T) {
 
 func TestProductHandler_ListProducts_DatabaseError(t *testing.T) {
 	mockListUseCase := new(MockListProductUseCase)
-	mockListUseCase.On("Execute", mock.Anything).Return(nil, fmt.Errorf("failed to list products: %w", errors.ErrDatabaseError))
+	mockListUseCase.On("Execute", mock.Anything, dto.ListProductInputDTO{}).Return(nil, fmt.Errorf("failed to list products: %w", errors.ErrDatabaseError))
 
-	handler := NewProductHandler(mockListUseCase, nil)
+	handler := NewProductHandler(mockListUseCase, nil, nil, nil, nil, nil, nil, nil, nil)
 	router := setupTestRouter(handler)
 
 	w := httptest.NewRecorder()
@@ -156,7 +260,7 @@ func TestProductHandler_GetProduct_Success(t *testing.T) {
 	mockGetUseCase := new(MockGetProductUseCase)
 	mockGetUseCase.On("Execute", mock.Anything, mock.Anything).Return(result, nil)
 
-	handler := NewProductHandler(nil, mockGetUseCase)
+	handler := NewProductHandler(nil, mockGetUseCase, nil, nil, nil, nil, nil, nil, nil)
 	router := setupTestRouter(handler)
 
 	w := httptest.NewRecorder()
@@ -180,7 +284,7 @@ func TestProductHandler_GetProduct_InvalidID(t *testing.T) {
 	mockGetUseCase := new(MockGetProductUseCase)
 	mockGetUseCase.On("Execute", mock.Anything, mock.Anything).Return(nil, errors.ErrInvalidProductID)
 
-	handler := NewProductHandler(nil, mockGetUseCase)
+	handler := NewProductHandler(nil, mockGetUseCase, nil, nil, nil, nil, nil, nil, nil)
 	router := setupTestRouter(handler)
 
 	w := httptest.NewRecorder()
@@ -199,7 +303,7 @@ func TestProductHandler_GetProduct_NotFound(t *testing.T) {
 	mockGetUseCase := new(MockGetProductUseCase)
 	mockGetUseCase.On("Execute", mock.Anything, mock.Anything).Return(nil, errors.ErrProductNotFound)
 
-	handler := NewProductHandler(nil, mockGetUseCase)
+	handler := NewProductHandler(nil, mockGetUseCase, nil, nil, nil, nil, nil, nil, nil)
 	router := setupTestRouter(handler)
 
 	w := httptest.NewRecorder()
@@ -219,7 +323,7 @@ func TestProductHandler_GetProduct_DatabaseError(t *testing.T) {
 	mockGetUseCase := new(MockGetProductUseCase)
 	mockGetUseCase.On("Execute", mock.Anything, mock.Anything).Return(nil, errors.ErrDatabaseError)
 
-	handler := NewProductHandler(nil, mockGetUseCase)
+	handler := NewProductHandler(nil, mockGetUseCase, nil, nil, nil, nil, nil, nil, nil)
 	router := setupTestRouter(handler)
 
 	w := httptest.NewRecorder()
@@ -233,3 +337,705 @@ func TestProductHandler_GetProduct_DatabaseError(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "DATABASE_ERROR", response.Code)
 }
+
+func TestProductHandler_CreateProduct_Success(t *testing.T) {
+	result := &dto.ProductDTO{
+		ID:       "MLB100",
+		Title:    "iPhone 15",
+		Price:    999.99,
+		Currency: "USD",
+	}
+	mockCreateUseCase := new(MockCreateProductUseCase)
+	mockCreateUseCase.On("Execute", mock.Anything, mock.MatchedBy(func(input dto.CreateProductInputDTO) bool {
+		return input.Title == "iPhone 15" && input.Price == 999.99 && len(input.Images) == 1
+	})).Return(result, nil)
+
+	handler := NewProductHandler(nil, nil, mockCreateUseCase, nil, nil, nil, nil, nil, nil)
+	router := setupTestRouter(handler)
+
+	body := `{"title":"iPhone 15","price":999.99,"currency":"USD","condition":"new","stock":1,"seller_id":"SELLER001","images":["http://example.com/img.jpg"]}`
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/products", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, "/products/MLB100", w.Header().Get("Location"))
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	data := response["data"].(map[string]interface{})
+	assert.Equal(t, "MLB100", data["id"])
+	mockCreateUseCase.AssertExpectations(t)
+}
+
+func TestProductHandler_CreateProduct_MalformedBody(t *testing.T) {
+	mockCreateUseCase := new(MockCreateProductUseCase)
+
+	handler := NewProductHandler(nil, nil, mockCreateUseCase, nil, nil, nil, nil, nil, nil)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/products", strings.NewReader(`{"title": "iPhone", "price": "free"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response errors.ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "INVALID_INPUT", response.Code)
+	mockCreateUseCase.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
+
+func TestProductHandler_CreateProduct_AlreadyExists(t *testing.T) {
+	mockCreateUseCase := new(MockCreateProductUseCase)
+	mockCreateUseCase.On("Execute", mock.Anything, mock.Anything).Return(nil, errors.ErrProductAlreadyExists)
+
+	handler := NewProductHandler(nil, nil, mockCreateUseCase, nil, nil, nil, nil, nil, nil)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/products", strings.NewReader(`{"id":"MLB001","title":"iPhone"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Empty(t, w.Header().Get("Location"))
+
+	var response errors.ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "PRODUCT_ALREADY_EXISTS", response.Code)
+}
+
+func TestProductHandler_GetProduct_SetsETag(t *testing.T) {
+	updatedAt := time.Date(2024, 1, 1, 12, 0, 0, 500, time.UTC)
+	mockGetUseCase := new(MockGetProductUseCase)
+	mockGetUseCase.On("Execute", mock.Anything, mock.Anything).Return(&dto.ProductDTO{ID: "PROD-123", UpdatedAt: updatedAt}, nil)
+
+	handler := NewProductHandler(nil, mockGetUseCase, nil, nil, nil, nil, nil, nil, nil)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products/PROD-123", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"2024-01-01T12:00:00.0000005Z"`, w.Header().Get("ETag"))
+}
+
+func TestProductHandler_UpdateProduct_Success(t *testing.T) {
+	version := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	updatedAt := version.Add(time.Minute)
+
+	mockUpdateUseCase := new(MockUpdateProductUseCase)
+	mockUpdateUseCase.On("Execute", mock.Anything, mock.MatchedBy(func(input dto.UpdateProductInputDTO) bool {
+		return input.ID == "PROD-123" && input.Version.Equal(version) && input.Title == "Renamed"
+	})).Return(&dto.ProductDTO{ID: "PROD-123", Title: "Renamed", UpdatedAt: updatedAt}, nil)
+
+	handler := NewProductHandler(nil, nil, nil, mockUpdateUseCase, nil, nil, nil, nil, nil)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("PUT", "/products/PROD-123", strings.NewReader(`{"title":"Renamed","price":10,"currency":"USD","condition":"new","seller_id":"S1"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", `"2024-01-01T12:00:00Z"`)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"2024-01-01T12:01:00Z"`, w.Header().Get("ETag"))
+	mockUpdateUseCase.AssertExpectations(t)
+}
+
+func TestProductHandler_UpdateProduct_MissingIfMatch(t *testing.T) {
+	mockUpdateUseCase := new(MockUpdateProductUseCase)
+
+	handler := NewProductHandler(nil, nil, nil, mockUpdateUseCase, nil, nil, nil, nil, nil)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("PUT", "/products/PROD-123", strings.NewReader(`{"title":"Renamed"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusPreconditionRequired, w.Code)
+
+	var response errors.ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "PRECONDITION_REQUIRED", response.Code)
+	mockUpdateUseCase.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
+
+func TestProductHandler_UpdateProduct_UnparseableIfMatch(t *testing.T) {
+	mockUpdateUseCase := new(MockUpdateProductUseCase)
+
+	handler := NewProductHandler(nil, nil, nil, mockUpdateUseCase, nil, nil, nil, nil, nil)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("PUT", "/products/PROD-123", strings.NewReader(`{"title":"Renamed"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", `"abc123"`)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+	mockUpdateUseCase.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
+
+func TestProductHandler_PatchProduct_Success(t *testing.T) {
+	version := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	patch := `{"price": 899.9}`
+
+	mockPatchUseCase := new(MockPatchProductUseCase)
+	mockPatchUseCase.On("Execute", mock.Anything, mock.MatchedBy(func(input dto.PatchProductInputDTO) bool {
+		return input.ID == "PROD-123" && input.Version.Equal(version) && string(input.Patch) == patch
+	})).Return(&dto.ProductDTO{ID: "PROD-123", Price: 899.9, UpdatedAt: version.Add(time.Second)}, nil)
+
+	handler := NewProductHandler(nil, nil, nil, nil, mockPatchUseCase, nil, nil, nil, nil)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("PATCH", "/products/PROD-123", strings.NewReader(patch))
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	req.Header.Set("If-Match", `W/"2024-01-01T12:00:00Z"`)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"2024-01-01T12:00:01Z"`, w.Header().Get("ETag"))
+	mockPatchUseCase.AssertExpectations(t)
+}
+
+func TestProductHandler_PatchProduct_StaleVersion(t *testing.T) {
+	mockPatchUseCase := new(MockPatchProductUseCase)
+	mockPatchUseCase.On("Execute", mock.Anything, mock.Anything).Return(nil, errors.ErrVersionConflict)
+
+	handler := NewProductHandler(nil, nil, nil, nil, mockPatchUseCase, nil, nil, nil, nil)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("PATCH", "/products/PROD-123", strings.NewReader(`{"stock": 1}`))
+	req.Header.Set("If-Match", `"2024-01-01T12:00:00Z"`)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+
+	var response errors.ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "PRECONDITION_FAILED", response.Code)
+}
+
+func TestProductHandler_ListProducts_IncludeDeletedAsAdmin(t *testing.T) {
+	mockListUseCase := new(MockListProductUseCase)
+	mockListUseCase.On("Execute", mock.Anything, dto.ListProductInputDTO{IncludeDeleted: true}).Return(&dto.ProductListDTO{Products: []dto.ProductDTO{}}, nil)
+
+	handler := NewProductHandler(mockListUseCase, nil, nil, nil, nil, nil, nil, nil, nil)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products?include_deleted=true", nil)
+	req.Header.Set(middleware.AdminTokenHeader, testAdminToken)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockListUseCase.AssertExpectations(t)
+}
+
+func TestProductHandler_ListProducts_IncludeDeletedRequiresAdmin(t *testing.T) {
+	mockListUseCase := new(MockListProductUseCase)
+
+	handler := NewProductHandler(mockListUseCase, nil, nil, nil, nil, nil, nil, nil, nil)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products?include_deleted=true", nil)
+	req.Header.Set(middleware.AdminTokenHeader, "wrong-token")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	mockListUseCase.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
+
+func TestProductHandler_GetProduct_InvalidIncludeDeleted(t *testing.T) {
+	mockGetUseCase := new(MockGetProductUseCase)
+
+	handler := NewProductHandler(nil, mockGetUseCase, nil, nil, nil, nil, nil, nil, nil)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products/PROD-123?include_deleted=maybe", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockGetUseCase.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
+
+func TestProductHandler_DeleteProduct_Success(t *testing.T) {
+	mockDeleteUseCase := new(MockDeleteProductUseCase)
+	mockDeleteUseCase.On("Execute", mock.Anything, dto.ProductInputDTO{ID: "PROD-123"}).Return(nil)
+
+	handler := NewProductHandler(nil, nil, nil, nil, nil, mockDeleteUseCase, nil, nil, nil)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("DELETE", "/products/PROD-123", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Empty(t, w.Body.String())
+}
+
+func TestProductHandler_DeleteProduct_NotFound(t *testing.T) {
+	mockDeleteUseCase := new(MockDeleteProductUseCase)
+	mockDeleteUseCase.On("Execute", mock.Anything, mock.Anything).Return(errors.ErrProductNotFound)
+
+	handler := NewProductHandler(nil, nil, nil, nil, nil, mockDeleteUseCase, nil, nil, nil)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("DELETE", "/products/PROD-999", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestProductHandler_RestoreProduct_Success(t *testing.T) {
+	updatedAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	mockRestoreUseCase := new(MockRestoreProductUseCase)
+	mockRestoreUseCase.On("Execute", mock.Anything, dto.ProductInputDTO{ID: "PROD-123"}).
+		Return(&dto.ProductDTO{ID: "PROD-123", UpdatedAt: updatedAt}, nil)
+
+	handler := NewProductHandler(nil, nil, nil, nil, nil, nil, mockRestoreUseCase, nil, nil)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/products/PROD-123/restore", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"2024-01-01T12:00:00Z"`, w.Header().Get("ETag"))
+}
+
+func TestProductHandler_ListProducts_PassesPaginationParams(t *testing.T) {
+	result := &dto.ProductListDTO{
+		Products:   []dto.ProductDTO{{ID: "PROD-3"}},
+		Pagination: dto.PaginationDTO{Limit: 1, NextCursor: "next", HasMore: true},
+	}
+
+	mockListUseCase := new(MockListProductUseCase)
+	mockListUseCase.On("Execute", mock.Anything, dto.ListProductInputDTO{Limit: 1, Cursor: "abc"}).Return(result, nil)
+
+	handler := NewProductHandler(mockListUseCase, nil, nil, nil, nil, nil, nil, nil, nil)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products?limit=1&cursor=abc", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"pagination":{"limit":1,"next_cursor":"next","has_more":true}`)
+}
+
+func TestProductHandler_ListProducts_ReturnsFacets(t *testing.T) {
+	result := &dto.ProductListDTO{
+		Products: []dto.ProductDTO{{ID: "PROD-1"}},
+		Facets: dto.ProductFacetsDTO{
+			Condition:  []dto.FacetBucketDTO{{Value: "new", Count: 1}},
+			Category:   dto.CategoryFacetDTO{Level: 1, Buckets: []dto.FacetBucketDTO{{Value: "Electronics", Label: "Electronics", Count: 1}}},
+			Seller:     []dto.FacetBucketDTO{},
+			PriceRange: []dto.PriceRangeBucketDTO{},
+		},
+	}
+
+	mockListUseCase := new(MockListProductUseCase)
+	mockListUseCase.On("Execute", mock.Anything, dto.ListProductInputDTO{}).Return(result, nil)
+
+	handler := NewProductHandler(mockListUseCase, nil, nil, nil, nil, nil, nil, nil, nil)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"facets":{"condition":[{"value":"new","count":1}],"category":{"level":1,"buckets":[{"value":"Electronics","label":"Electronics","count":1}]},"seller":[],"price_range":[]}`)
+}
+
+func TestProductHandler_ListProducts_SparseFieldsAndExpand(t *testing.T) {
+	result := &dto.ProductListDTO{
+		Products: []dto.ProductDTO{{
+			ID:     "PROD-1",
+			Title:  "Product 1",
+			Price:  100.0,
+			Seller: &dto.SellerDTO{ID: "SELLER001", Name: "Store"},
+		}},
+	}
+
+	mockListUseCase := new(MockListProductUseCase)
+	mockListUseCase.On("Execute", mock.Anything, dto.ListProductInputDTO{
+		Expand: dto.ProductExpandDTO{Images: true, Seller: true},
+	}).Return(result, nil)
+
+	handler := NewProductHandler(mockListUseCase, nil, nil, nil, nil, nil, nil, nil, nil)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products?fields=id,+seller,price&expand=images,seller", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"data":[{"id":"PROD-1","price":100,"seller":{"id":"SELLER001","name":"Store"}}]`)
+}
+
+func TestProductHandler_ListProducts_InvalidFieldsOrExpand(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{name: "unknown field", query: "fields=id,password"},
+		{name: "unknown expansion", query: "expand=reviews"},
+		{name: "questions of a listing", query: "expand=questions"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockListUseCase := new(MockListProductUseCase)
+
+			handler := NewProductHandler(mockListUseCase, nil, nil, nil, nil, nil, nil, nil, nil)
+			router := setupTestRouter(handler)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/products?"+tt.query, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			mockListUseCase.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestProductHandler_GetProduct_ExpandQuestions(t *testing.T) {
+	mockGetUseCase := new(MockGetProductUseCase)
+	mockGetUseCase.On("Execute", mock.Anything, dto.ProductInputDTO{
+		ID:     "PROD-1",
+		Expand: dto.ProductExpandDTO{Seller: true, Questions: true},
+	}).Return(&dto.ProductDTO{
+		ID:        "PROD-1",
+		Questions: []dto.QuestionDTO{{ID: 2, ProductID: "PROD-1", Status: "answered", Answer: "Yes."}},
+	}, nil)
+
+	handler := NewProductHandler(nil, mockGetUseCase, nil, nil, nil, nil, nil, nil, nil)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products/PROD-1?expand=seller,questions", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"questions":[{"id":2`)
+	mockGetUseCase.AssertExpectations(t)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/products/PROD-1?expand=reviews", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "INVALID_INPUT")
+}
+
+func TestProductHandler_GetProduct_Currency(t *testing.T) {
+	originalPrice := 999.99
+	mockGetUseCase := new(MockGetProductUseCase)
+	mockGetUseCase.On("Execute", mock.Anything, dto.ProductInputDTO{ID: "PROD-1", Currency: "BRL"}).Return(&dto.ProductDTO{
+		ID:               "PROD-1",
+		Price:            4919.95,
+		Currency:         "BRL",
+		Converted:        true,
+		OriginalPrice:    &originalPrice,
+		OriginalCurrency: "USD",
+	}, nil)
+	mockGetUseCase.On("Execute", mock.Anything, dto.ProductInputDTO{ID: "PROD-1", Currency: "XYZ"}).Return(nil, errors.ErrUnknownCurrency)
+
+	handler := NewProductHandler(nil, mockGetUseCase, nil, nil, nil, nil, nil, nil, nil)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products/PROD-1?currency=BRL", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"price":4919.95,"currency":"BRL","converted":true,"original_price":999.99,"original_currency":"USD"`)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/products/PROD-1?currency=XYZ", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "UNKNOWN_CURRENCY")
+}
+
+func TestProductHandler_GetProduct_PriceFormat(t *testing.T) {
+	mockGetUseCase := new(MockGetProductUseCase)
+	mockGetUseCase.On("Execute", mock.Anything, dto.ProductInputDTO{ID: "PROD-1", PriceFormat: dto.PriceFormatMoney}).Return(&dto.ProductDTO{
+		ID:         "PROD-1",
+		Price:      999.99,
+		Currency:   "USD",
+		PriceMoney: &dto.MoneyDTO{Amount: 99999, Currency: "USD", Decimals: 2},
+	}, nil)
+
+	handler := NewProductHandler(nil, mockGetUseCase, nil, nil, nil, nil, nil, nil, nil)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products/PROD-1?price_format=money", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"price":999.99,"currency":"USD"`)
+	assert.Contains(t, w.Body.String(), `"price_money":{"amount":99999,"currency":"USD","decimals":2}`)
+}
+
+func TestProductHandler_GetProduct_SparseFields(t *testing.T) {
+	updatedAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	mockGetUseCase := new(MockGetProductUseCase)
+	mockGetUseCase.On("Execute", mock.Anything, dto.ProductInputDTO{ID: "PROD-1"}).Return(&dto.ProductDTO{
+		ID:          "PROD-1",
+		Title:       "Product 1",
+		Description: "Long description",
+		Price:       100.0,
+		UpdatedAt:   updatedAt,
+	}, nil)
+
+	handler := NewProductHandler(nil, mockGetUseCase, nil, nil, nil, nil, nil, nil, nil)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products/PROD-1?fields=id,title", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"data":{"id":"PROD-1","title":"Product 1"}}`, w.Body.String())
+	assert.Equal(t, `"2024-01-01T12:00:00Z"`, w.Header().Get("ETag"))
+}
+
+func TestProductHandler_ListProducts_BatchGetByIDs(t *testing.T) {
+	result := &dto.ProductBatchDTO{
+		Products: []dto.ProductDTO{{ID: "MLB001", Title: "iPhone"}},
+		Errors:   []dto.ProductBatchErrorDTO{{ID: "MLB999", Code: "PRODUCT_NOT_FOUND", Error: "The requested product was not found"}},
+	}
+
+	mockListUseCase := new(MockListProductUseCase)
+	mockBatchGetUseCase := new(MockBatchGetProductsUseCase)
+	mockBatchGetUseCase.On("Execute", mock.Anything, dto.BatchGetProductsInputDTO{IDs: []string{"MLB001", "MLB999"}}).Return(result, nil)
+
+	handler := NewProductHandler(mockListUseCase, nil, nil, nil, nil, nil, nil, nil, mockBatchGetUseCase)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products?ids=MLB001,MLB999&fields=id", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"data":[{"id":"MLB001"}],"errors":[{"id":"MLB999","code":"PRODUCT_NOT_FOUND","error":"The requested product was not found"}]}`, w.Body.String())
+	mockListUseCase.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
+
+func TestProductHandler_ListProducts_BatchGetRejectsListParams(t *testing.T) {
+	mockBatchGetUseCase := new(MockBatchGetProductsUseCase)
+
+	handler := NewProductHandler(nil, nil, nil, nil, nil, nil, nil, nil, mockBatchGetUseCase)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products?ids=MLB001&cursor=abc", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/products?ids=MLB001&attr.brand=Apple", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockBatchGetUseCase.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
+
+func TestProductHandler_ListProducts_InvalidLimit(t *testing.T) {
+	mockListUseCase := new(MockListProductUseCase)
+
+	handler := NewProductHandler(mockListUseCase, nil, nil, nil, nil, nil, nil, nil, nil)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products?limit=ten", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockListUseCase.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
+
+func TestProductHandler_ListProducts_NonActiveStatusRequiresOwnerOrAdmin(t *testing.T) {
+	mockListUseCase := new(MockListProductUseCase)
+	mockListUseCase.On("Execute", mock.Anything, mock.Anything).Return(&dto.ProductListDTO{Products: []dto.ProductDTO{}}, nil)
+
+	handler := NewProductHandler(mockListUseCase, nil, nil, nil, nil, nil, nil, nil, nil)
+	router := setupTestRouter(handler)
+
+	for _, tt := range []struct {
+		name   string
+		query  string
+		header string
+		value  string
+	}{
+		{name: "anonymous", query: "status=paused"},
+		{name: "seller without seller_id", query: "status=paused", header: middleware.SellerIDHeader, value: "SELLER001"},
+		{name: "other seller", query: "status=paused&seller_id=SELLER002", header: middleware.SellerIDHeader, value: "SELLER001"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/products?"+tt.query, nil)
+			if tt.header != "" {
+				req.Header.Set(tt.header, tt.value)
+			}
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusForbidden, w.Code)
+		})
+	}
+	mockListUseCase.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+
+	for _, tt := range []struct {
+		name   string
+		query  string
+		header string
+		value  string
+	}{
+		{name: "active", query: "status=active"},
+		{name: "own products", query: "status=paused&seller_id=SELLER001", header: middleware.SellerIDHeader, value: "SELLER001"},
+		{name: "admin", query: "status=closed", header: middleware.AdminTokenHeader, value: testAdminToken},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/products?"+tt.query, nil)
+			if tt.header != "" {
+				req.Header.Set(tt.header, tt.value)
+			}
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+		})
+	}
+}
+
+func TestProductHandler_GetProduct_PassesCaller(t *testing.T) {
+	mockGetUseCase := new(MockGetProductUseCase)
+	mockGetUseCase.On("Execute", mock.Anything, dto.ProductInputDTO{ID: "MLB001", SellerID: "SELLER001"}).
+		Return(&dto.ProductDTO{ID: "MLB001", Status: "paused"}, nil)
+
+	handler := NewProductHandler(nil, mockGetUseCase, nil, nil, nil, nil, nil, nil, nil)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products/MLB001", nil)
+	req.Header.Set(middleware.SellerIDHeader, "SELLER001")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"status":"paused"`)
+	mockGetUseCase.AssertExpectations(t)
+}
+
+func TestProductHandler_ListProducts_PassesFilters(t *testing.T) {
+	minPrice, maxPrice, inStock := 10.5, 99.0, true
+	expected := dto.ListProductInputDTO{
+		ProductFiltersDTO: dto.ProductFiltersDTO{
+			Category:   "Electronics > Audio",
+			Condition:  "new",
+			SellerID:   "SELLER001",
+			MinPrice:   &minPrice,
+			MaxPrice:   &maxPrice,
+			InStock:    &inStock,
+			Attributes: map[string]string{"brand": "Apple", "storage": "256GB"},
+		},
+	}
+
+	mockListUseCase := new(MockListProductUseCase)
+	mockListUseCase.On("Execute", mock.Anything, expected).Return(&dto.ProductListDTO{Products: []dto.ProductDTO{}}, nil)
+
+	handler := NewProductHandler(mockListUseCase, nil, nil, nil, nil, nil, nil, nil, nil)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products?category=Electronics+%3E+Audio&condition=new&seller_id=SELLER001&min_price=10.5&max_price=99&in_stock=true&attr.brand=Apple&attr.storage=256GB", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockListUseCase.AssertExpectations(t)
+}
+
+func TestProductHandler_ListProducts_InvalidFilterSyntax(t *testing.T) {
+	for _, query := range []string{"min_price=abc", "max_price=NaN", "in_stock=maybe"} {
+		mockListUseCase := new(MockListProductUseCase)
+
+		handler := NewProductHandler(mockListUseCase, nil, nil, nil, nil, nil, nil, nil, nil)
+		router := setupTestRouter(handler)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/products?"+query, nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+		mockListUseCase.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+	}
+}
+
+func TestProductHandler_SearchProducts_Success(t *testing.T) {
+	result := &dto.ProductListDTO{
+		Products: []dto.ProductDTO{{
+			ID:        "MLB001",
+			Highlight: &dto.ProductHighlightDTO{Title: "<em>iPhone</em> 15", Snippet: "Latest <em>iPhone</em>"},
+		}},
+		Pagination: dto.PaginationDTO{Limit: 5},
+	}
+
+	mockSearchUseCase := new(MockSearchProductsUseCase)
+	mockSearchUseCase.On("Execute", mock.Anything, dto.ProductSearchInputDTO{
+		Query:             "iphone pro",
+		Limit:             5,
+		ProductFiltersDTO: dto.ProductFiltersDTO{Condition: "new"},
+	}).Return(result, nil)
+
+	handler := NewProductHandler(nil, nil, nil, nil, nil, nil, nil, mockSearchUseCase, nil)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products/search?q=iphone+pro&limit=5&condition=new", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response dto.ProductListResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "<em>iPhone</em> 15", response.Data[0].Highlight.Title)
+	assert.Equal(t, 5, response.Pagination.Limit)
+}
+
+func TestProductHandler_SearchProducts_Unavailable(t *testing.T) {
+	mockSearchUseCase := new(MockSearchProductsUseCase)
+	mockSearchUseCase.On("Execute", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("failed to search products: %w", errors.ErrSearchUnavailable))
+
+	handler := NewProductHandler(nil, nil, nil, nil, nil, nil, nil, mockSearchUseCase, nil)
+	router := setupTestRouter(handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products/search?q=iphone", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotImplemented, w.Code)
+	assert.Contains(t, w.Body.String(), "SEARCH_UNAVAILABLE")
+}
@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"project/internal/dto"
+	"project/internal/errors"
+	"project/internal/infra/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ChangeProductStatusUseCase interface {
+	Execute(ctx context.Context, input dto.ChangeProductStatusInputDTO) (*dto.ProductDTO, error)
+}
+
+type ListStatusChangesUseCase interface {
+	Execute(ctx context.Context, input dto.ListStatusChangesInputDTO) ([]dto.StatusChangeDTO, error)
+}
+
+type ProductStatusHandler struct {
+	changeProductStatusUseCase ChangeProductStatusUseCase
+	listStatusChangesUseCase   ListStatusChangesUseCase
+}
+
+func NewProductStatusHandler(
+	changeProductStatusUseCase ChangeProductStatusUseCase,
+	listStatusChangesUseCase ListStatusChangesUseCase,
+) *ProductStatusHandler {
+	return &ProductStatusHandler{
+		changeProductStatusUseCase: changeProductStatusUseCase,
+		listStatusChangesUseCase:   listStatusChangesUseCase,
+	}
+}
+
+// ChangeProductStatus godoc
+// @Summary Change the status of a listing
+// @Description Move a listing to active, paused, closed, under_review or scheduled, as the seller of the product identified by X-Seller-ID or as an administrator. Only administrators put a listing under review or take it out of review, a closed listing cannot change status, a listing without stock cannot be activated and only a listing with a future publish_at can be scheduled. A listing past its expires_at is closed. Changing to the current status does nothing. Every change is recorded in the status history.
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID" example(MLB001)
+// @Param X-Seller-ID header string false "ID of the seller of the product" example(SELLER001)
+// @Param X-Admin-Token header string false "Administrator token"
+// @Param status body dto.ChangeProductStatusInputDTO true "New status"
+// @Success 200 {object} dto.ProductResponse
+// @Header 200 {string} ETag "Product version"
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
+// @Failure 422 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Router /api/v1/products/{id}/status [post]
+func (h *ProductStatusHandler) ChangeProductStatus(c *gin.Context) {
+	input := dto.ChangeProductStatusInputDTO{
+		ProductID: c.Param("id"),
+		SellerID:  c.GetString(middleware.SellerContextKey),
+		IsAdmin:   c.GetBool(middleware.AdminContextKey),
+	}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		_ = c.Error(errors.NewInvalidInputError("The request body is not a valid status change"))
+		return
+	}
+
+	result, err := h.changeProductStatusUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Header("ETag", etag(result.UpdatedAt))
+	c.JSON(http.StatusOK, gin.H{
+		"data": result,
+	})
+}
+
+// ListStatusChanges godoc
+// @Summary List the status history of a listing
+// @Description Get every status change of a listing, oldest first, with who made it and when: an administrator, the seller or the system, which pauses listings that run out of stock. Only the seller of the product identified by X-Seller-ID and administrators may read it.
+// @Tags products
+// @Produce json
+// @Param id path string true "Product ID" example(MLB001)
+// @Param X-Seller-ID header string false "ID of the seller of the product" example(SELLER001)
+// @Param X-Admin-Token header string false "Administrator token"
+// @Success 200 {object} dto.StatusChangeListResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Router /api/v1/products/{id}/status-changes [get]
+func (h *ProductStatusHandler) ListStatusChanges(c *gin.Context) {
+	result, err := h.listStatusChangesUseCase.Execute(c.Request.Context(), dto.ListStatusChangesInputDTO{
+		ProductID: c.Param("id"),
+		SellerID:  c.GetString(middleware.SellerContextKey),
+		IsAdmin:   c.GetBool(middleware.AdminContextKey),
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": result,
+	})
+}
@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"project/internal/dto"
+	"project/internal/errors"
+	"project/internal/infra/http/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockChangeProductStatusUseCase struct {
+	mock.Mock
+}
+
+func (m *MockChangeProductStatusUseCase) Execute(ctx context.Context, input dto.ChangeProductStatusInputDTO) (*dto.ProductDTO, error) {
+	args := m.Called(ctx, input)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ProductDTO), nil
+}
+
+type MockListStatusChangesUseCase struct {
+	mock.Mock
+}
+
+func (m *MockListStatusChangesUseCase) Execute(ctx context.Context, input dto.ListStatusChangesInputDTO) ([]dto.StatusChangeDTO, error) {
+	args := m.Called(ctx, input)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dto.StatusChangeDTO), nil
+}
+
+func setupProductStatusTestRouter(handler *ProductStatusHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	r.Use(testErrorHandler)
+	r.Use(middleware.AdminMiddleware(testAdminToken))
+	r.Use(middleware.SellerMiddleware())
+
+	r.POST("/products/:id/status", handler.ChangeProductStatus)
+	r.GET("/products/:id/status-changes", handler.ListStatusChanges)
+
+	return r
+}
+
+func TestProductStatusHandler_ChangeProductStatus_PassesSeller(t *testing.T) {
+	mockChangeUseCase := new(MockChangeProductStatusUseCase)
+	mockChangeUseCase.On("Execute", mock.Anything, dto.ChangeProductStatusInputDTO{
+		ProductID: "MLB001",
+		SellerID:  "SELLER001",
+		Status:    "paused",
+		Reason:    "On vacation",
+	}).Return(&dto.ProductDTO{ID: "MLB001", Status: "paused", UpdatedAt: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)}, nil)
+
+	router := setupProductStatusTestRouter(NewProductStatusHandler(mockChangeUseCase, nil))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/products/MLB001/status", strings.NewReader(`{"status": "paused", "reason": "On vacation"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(middleware.SellerIDHeader, "SELLER001")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"status":"paused"`)
+	assert.NotEmpty(t, w.Header().Get("ETag"))
+	mockChangeUseCase.AssertExpectations(t)
+}
+
+func TestProductStatusHandler_ChangeProductStatus_PassesAdmin(t *testing.T) {
+	mockChangeUseCase := new(MockChangeProductStatusUseCase)
+	mockChangeUseCase.On("Execute", mock.Anything, dto.ChangeProductStatusInputDTO{
+		ProductID: "MLB001",
+		IsAdmin:   true,
+		Status:    "under_review",
+	}).Return(&dto.ProductDTO{ID: "MLB001", Status: "under_review"}, nil)
+
+	router := setupProductStatusTestRouter(NewProductStatusHandler(mockChangeUseCase, nil))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/products/MLB001/status", strings.NewReader(`{"status": "under_review"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(middleware.AdminTokenHeader, testAdminToken)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockChangeUseCase.AssertExpectations(t)
+}
+
+func TestProductStatusHandler_ChangeProductStatus_InvalidBody(t *testing.T) {
+	mockChangeUseCase := new(MockChangeProductStatusUseCase)
+
+	router := setupProductStatusTestRouter(NewProductStatusHandler(mockChangeUseCase, nil))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/products/MLB001/status", strings.NewReader(`{"status": 1}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockChangeUseCase.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
+
+func TestProductStatusHandler_ChangeProductStatus_InvalidTransition(t *testing.T) {
+	mockChangeUseCase := new(MockChangeProductStatusUseCase)
+	mockChangeUseCase.On("Execute", mock.Anything, mock.Anything).
+		Return(nil, errors.NewStatusTransitionError("A closed listing cannot change status"))
+
+	router := setupProductStatusTestRouter(NewProductStatusHandler(mockChangeUseCase, nil))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/products/MLB001/status", strings.NewReader(`{"status": "active"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(middleware.SellerIDHeader, "SELLER001")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), "INVALID_STATUS_TRANSITION")
+}
+
+func TestProductStatusHandler_ListStatusChanges_PassesSeller(t *testing.T) {
+	mockListUseCase := new(MockListStatusChangesUseCase)
+	mockListUseCase.On("Execute", mock.Anything, dto.ListStatusChangesInputDTO{
+		ProductID: "MLB001",
+		SellerID:  "SELLER001",
+	}).Return([]dto.StatusChangeDTO{{ID: 1, From: "active", To: "paused", Actor: "system", Reason: "out of stock"}}, nil)
+
+	router := setupProductStatusTestRouter(NewProductStatusHandler(nil, mockListUseCase))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products/MLB001/status-changes", nil)
+	req.Header.Set(middleware.SellerIDHeader, "SELLER001")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"actor":"system"`)
+	mockListUseCase.AssertExpectations(t)
+}
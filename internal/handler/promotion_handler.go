@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"project/internal/dto"
+	"project/internal/errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CreatePromotionUseCase interface {
+	Execute(ctx context.Context, input dto.CreatePromotionInputDTO) (*dto.PromotionDTO, error)
+}
+
+type ListPromotionsUseCase interface {
+	Execute(ctx context.Context, input dto.ListPromotionsInputDTO) ([]dto.PromotionDTO, error)
+}
+
+type DeletePromotionUseCase interface {
+	Execute(ctx context.Context, input dto.DeletePromotionInputDTO) error
+}
+
+type PromotionHandler struct {
+	createPromotionUseCase CreatePromotionUseCase
+	listPromotionsUseCase  ListPromotionsUseCase
+	deletePromotionUseCase DeletePromotionUseCase
+}
+
+func NewPromotionHandler(
+	createPromotionUseCase CreatePromotionUseCase,
+	listPromotionsUseCase ListPromotionsUseCase,
+	deletePromotionUseCase DeletePromotionUseCase,
+) *PromotionHandler {
+	return &PromotionHandler{
+		createPromotionUseCase: createPromotionUseCase,
+		listPromotionsUseCase:  listPromotionsUseCase,
+		deletePromotionUseCase: deletePromotionUseCase,
+	}
+}
+
+// ListPromotions godoc
+// @Summary List promotions
+// @Description Get every promotion, past and scheduled ones included, ordered by ID. active=true keeps the ones active now.
+// @Tags promotions
+// @Produce json
+// @Param active query bool false "Only the promotions active now" example(true)
+// @Success 200 {object} dto.PromotionListResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Router /api/v1/promotions [get]
+func (h *PromotionHandler) ListPromotions(c *gin.Context) {
+	active, err := boolQueryParam(c, "active")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	input := dto.ListPromotionsInputDTO{}
+	if active != nil {
+		input.Active = *active
+	}
+
+	result, err := h.listPromotionsUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": result,
+	})
+}
+
+// CreatePromotion godoc
+// @Summary Create a promotion
+// @Description Discount a product, or every product of a category and its subcategories, by a percentage or a fixed amount from starts_at until just before ends_at. A fixed amount only discounts prices in its currency. When several promotions are active for a product the highest priority wins, then a product promotion over a category one and a subcategory over its parent, then the oldest one. Promotions for the same product or category with the same priority cannot overlap. Administrators only.
+// @Tags promotions
+// @Accept json
+// @Produce json
+// @Param X-Admin-Token header string true "Administrator token"
+// @Param promotion body dto.CreatePromotionInputDTO true "Promotion"
+// @Success 201 {object} dto.PromotionResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
+// @Failure 422 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Router /api/v1/promotions [post]
+func (h *PromotionHandler) CreatePromotion(c *gin.Context) {
+	var input dto.CreatePromotionInputDTO
+	if err := c.ShouldBindJSON(&input); err != nil {
+		_ = c.Error(errors.NewInvalidInputError("The request body is not a valid promotion"))
+		return
+	}
+
+	result, err := h.createPromotionUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"data": result,
+	})
+}
+
+// DeletePromotion godoc
+// @Summary Delete a promotion
+// @Description Delete a promotion for good, past, active or scheduled. The prices it discounted go back to their list price. Administrators only.
+// @Tags promotions
+// @Param id path int true "Promotion ID" example(1)
+// @Param X-Admin-Token header string true "Administrator token"
+// @Success 204
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Router /api/v1/promotions/{id} [delete]
+func (h *PromotionHandler) DeletePromotion(c *gin.Context) {
+	promotionID, err := promotionIDParam(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	if err := h.deletePromotionUseCase.Execute(c.Request.Context(), dto.DeletePromotionInputDTO{ID: promotionID}); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func promotionIDParam(c *gin.Context) (int64, error) {
+	promotionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return 0, errors.NewInvalidInputError("promotion id must be an integer")
+	}
+
+	if promotionID <= 0 {
+		return 0, errors.ErrPromotionNotFound
+	}
+
+	return promotionID, nil
+}
@@ -0,0 +1,205 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"project/internal/dto"
+	"project/internal/errors"
+	"project/internal/infra/http/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockCreatePromotionUseCase struct {
+	mock.Mock
+}
+
+func (m *MockCreatePromotionUseCase) Execute(ctx context.Context, input dto.CreatePromotionInputDTO) (*dto.PromotionDTO, error) {
+	args := m.Called(ctx, input)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.PromotionDTO), nil
+}
+
+type MockListPromotionsUseCase struct {
+	mock.Mock
+}
+
+func (m *MockListPromotionsUseCase) Execute(ctx context.Context, input dto.ListPromotionsInputDTO) ([]dto.PromotionDTO, error) {
+	args := m.Called(ctx, input)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dto.PromotionDTO), nil
+}
+
+type MockDeletePromotionUseCase struct {
+	mock.Mock
+}
+
+func (m *MockDeletePromotionUseCase) Execute(ctx context.Context, input dto.DeletePromotionInputDTO) error {
+	args := m.Called(ctx, input)
+	return args.Error(0)
+}
+
+func setupPromotionTestRouter(handler *PromotionHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	r.Use(testErrorHandler)
+	r.Use(middleware.AdminMiddleware(testAdminToken))
+
+	r.GET("/promotions", handler.ListPromotions)
+	r.POST("/promotions", middleware.RequireAdmin(), handler.CreatePromotion)
+	r.DELETE("/promotions/:id", middleware.RequireAdmin(), handler.DeletePromotion)
+
+	return r
+}
+
+func TestPromotionHandler_ListPromotions_Active(t *testing.T) {
+	mockListUseCase := new(MockListPromotionsUseCase)
+	mockListUseCase.On("Execute", mock.Anything, dto.ListPromotionsInputDTO{Active: true}).
+		Return([]dto.PromotionDTO{{ID: 1, Type: "percentage", Value: 15, Active: true}}, nil)
+
+	router := setupPromotionTestRouter(NewPromotionHandler(nil, mockListUseCase, nil))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/promotions?active=true", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"value":15`)
+	mockListUseCase.AssertExpectations(t)
+}
+
+func TestPromotionHandler_ListPromotions_InvalidActive(t *testing.T) {
+	mockListUseCase := new(MockListPromotionsUseCase)
+
+	router := setupPromotionTestRouter(NewPromotionHandler(nil, mockListUseCase, nil))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/promotions?active=soon", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockListUseCase.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
+
+func TestPromotionHandler_CreatePromotion_Success(t *testing.T) {
+	startsAt := time.Date(2024, 11, 29, 0, 0, 0, 0, time.UTC)
+	endsAt := time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC)
+	productID := "MLB001"
+	mockCreateUseCase := new(MockCreatePromotionUseCase)
+	mockCreateUseCase.On("Execute", mock.Anything, dto.CreatePromotionInputDTO{
+		ProductID: &productID,
+		Type:      "percentage",
+		Value:     15,
+		StartsAt:  startsAt,
+		EndsAt:    endsAt,
+	}).Return(&dto.PromotionDTO{ID: 1, ProductID: &productID, Type: "percentage", Value: 15, StartsAt: startsAt, EndsAt: endsAt}, nil)
+
+	router := setupPromotionTestRouter(NewPromotionHandler(mockCreateUseCase, nil, nil))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/promotions", strings.NewReader(`{"product_id": "MLB001", "type": "percentage", "value": 15, "starts_at": "2024-11-29T00:00:00Z", "ends_at": "2024-12-02T00:00:00Z"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(middleware.AdminTokenHeader, testAdminToken)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"product_id":"MLB001"`)
+	mockCreateUseCase.AssertExpectations(t)
+}
+
+func TestPromotionHandler_CreatePromotion_RequiresAdmin(t *testing.T) {
+	mockCreateUseCase := new(MockCreatePromotionUseCase)
+
+	router := setupPromotionTestRouter(NewPromotionHandler(mockCreateUseCase, nil, nil))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/promotions", strings.NewReader(`{"product_id": "MLB001"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	mockCreateUseCase.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
+
+func TestPromotionHandler_CreatePromotion_InvalidBody(t *testing.T) {
+	for _, body := range []string{`{"starts_at": "tomorrow"}`, `{"value": "15"}`, `not json`} {
+		t.Run(body, func(t *testing.T) {
+			mockCreateUseCase := new(MockCreatePromotionUseCase)
+
+			router := setupPromotionTestRouter(NewPromotionHandler(mockCreateUseCase, nil, nil))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/promotions", strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set(middleware.AdminTokenHeader, testAdminToken)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			mockCreateUseCase.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestPromotionHandler_CreatePromotion_Conflict(t *testing.T) {
+	mockCreateUseCase := new(MockCreatePromotionUseCase)
+	mockCreateUseCase.On("Execute", mock.Anything, mock.Anything).Return(nil, errors.ErrPromotionConflict)
+
+	router := setupPromotionTestRouter(NewPromotionHandler(mockCreateUseCase, nil, nil))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/promotions", strings.NewReader(`{"category_id": 6, "type": "percentage", "value": 10}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(middleware.AdminTokenHeader, testAdminToken)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestPromotionHandler_DeletePromotion_Success(t *testing.T) {
+	mockDeleteUseCase := new(MockDeletePromotionUseCase)
+	mockDeleteUseCase.On("Execute", mock.Anything, dto.DeletePromotionInputDTO{ID: 7}).Return(nil)
+
+	router := setupPromotionTestRouter(NewPromotionHandler(nil, nil, mockDeleteUseCase))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("DELETE", "/promotions/7", nil)
+	req.Header.Set(middleware.AdminTokenHeader, testAdminToken)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	mockDeleteUseCase.AssertExpectations(t)
+}
+
+func TestPromotionHandler_DeletePromotion_InvalidID(t *testing.T) {
+	tests := map[string]int{
+		"abc": http.StatusBadRequest,
+		"0":   http.StatusNotFound,
+	}
+	for id, status := range tests {
+		t.Run(id, func(t *testing.T) {
+			mockDeleteUseCase := new(MockDeletePromotionUseCase)
+
+			router := setupPromotionTestRouter(NewPromotionHandler(nil, nil, mockDeleteUseCase))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("DELETE", "/promotions/"+id, nil)
+			req.Header.Set(middleware.AdminTokenHeader, testAdminToken)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, status, w.Code)
+			mockDeleteUseCase.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"project/internal/dto"
+	"project/internal/errors"
+	"project/internal/infra/http/middleware"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CreateQuestionUseCase interface {
+	Execute(ctx context.Context, input dto.CreateQuestionInputDTO) (*dto.QuestionDTO, error)
+}
+
+type ListQuestionsUseCase interface {
+	Execute(ctx context.Context, input dto.ListQuestionsInputDTO) (*dto.QuestionListDTO, error)
+}
+
+type AnswerQuestionUseCase interface {
+	Execute(ctx context.Context, input dto.AnswerQuestionInputDTO) (*dto.QuestionDTO, error)
+}
+
+type HideQuestionUseCase interface {
+	Execute(ctx context.Context, input dto.HideQuestionInputDTO) (*dto.QuestionDTO, error)
+}
+
+type QuestionHandler struct {
+	createQuestionUseCase CreateQuestionUseCase
+	listQuestionsUseCase  ListQuestionsUseCase
+	answerQuestionUseCase AnswerQuestionUseCase
+	hideQuestionUseCase   HideQuestionUseCase
+}
+
+func NewQuestionHandler(
+	createQuestionUseCase CreateQuestionUseCase,
+	listQuestionsUseCase ListQuestionsUseCase,
+	answerQuestionUseCase AnswerQuestionUseCase,
+	hideQuestionUseCase HideQuestionUseCase,
+) *QuestionHandler {
+	return &QuestionHandler{
+		createQuestionUseCase: createQuestionUseCase,
+		listQuestionsUseCase:  listQuestionsUseCase,
+		answerQuestionUseCase: answerQuestionUseCase,
+		hideQuestionUseCase:   hideQuestionUseCase,
+	}
+}
+
+// CreateQuestion godoc
+// @Summary Ask a question about a product
+// @Description Post a question on a product page. It stays unanswered until the seller of the product answers it. A listing that is not active only takes questions from administrators and from its seller, identified by X-Seller-ID.
+// @Tags questions
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID" example(MLB001)
+// @Param question body dto.CreateQuestionInputDTO true "Question to ask"
+// @Param X-Admin-Token header string false "Administrator token"
+// @Param X-Seller-ID header string false "ID of the calling seller, who may get its own listings in any status" example(SELLER001)
+// @Success 201 {object} dto.QuestionResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Router /api/v1/products/{id}/questions [post]
+func (h *QuestionHandler) CreateQuestion(c *gin.Context) {
+	input := dto.CreateQuestionInputDTO{ProductID: c.Param("id")}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		_ = c.Error(errors.NewInvalidInputError("The request body is not a valid question"))
+		return
+	}
+	input.SellerID = c.GetString(middleware.SellerContextKey)
+	input.IsAdmin = c.GetBool(middleware.AdminContextKey)
+
+	result, err := h.createQuestionUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"data": result,
+	})
+}
+
+// ListQuestions godoc
+// @Summary List the questions of a product
+// @Description Get a page of the questions of a product, newest first. Without status, unanswered and answered questions are listed; hidden questions are only listed for administrators. Follow pagination.next_cursor to read the next page. The questions of a listing that is not active are only returned to administrators and to its seller, identified by X-Seller-ID.
+// @Tags questions
+// @Produce json
+// @Param id path string true "Product ID" example(MLB001)
+// @Param status query string false "Only questions in this state" Enums(unanswered, answered, hidden)
+// @Param limit query int false "Page size (1-100)" default(20)
+// @Param cursor query string false "Opaque cursor from pagination.next_cursor"
+// @Param X-Admin-Token header string false "Administrator token, required for status=hidden"
+// @Param X-Seller-ID header string false "ID of the calling seller, who may get its own listings in any status" example(SELLER001)
+// @Success 200 {object} dto.QuestionListResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Router /api/v1/products/{id}/questions [get]
+func (h *QuestionHandler) ListQuestions(c *gin.Context) {
+	limit, err := intQueryParam(c, "limit")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	status := c.Query("status")
+	if status == "hidden" && !c.GetBool(middleware.AdminContextKey) {
+		_ = c.Error(errors.ErrForbidden)
+		return
+	}
+
+	result, err := h.listQuestionsUseCase.Execute(c.Request.Context(), dto.ListQuestionsInputDTO{
+		ProductID: c.Param("id"),
+		Status:    status,
+		Limit:     limit,
+		Cursor:    c.Query("cursor"),
+		SellerID:  c.GetString(middleware.SellerContextKey),
+		IsAdmin:   c.GetBool(middleware.AdminContextKey),
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":       result.Questions,
+		"pagination": result.Pagination,
+	})
+}
+
+// AnswerQuestion godoc
+// @Summary Answer a question
+// @Description Answer an unanswered question as the seller of the product, identified by X-Seller-ID. A question is answered only once.
+// @Tags questions
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID" example(MLB001)
+// @Param question_id path int true "Question ID" example(3)
+// @Param X-Seller-ID header string true "ID of the seller of the product" example(SELLER001)
+// @Param answer body dto.AnswerQuestionInputDTO true "Answer"
+// @Success 200 {object} dto.QuestionResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Router /api/v1/products/{id}/questions/{question_id}/answer [post]
+func (h *QuestionHandler) AnswerQuestion(c *gin.Context) {
+	questionID, err := questionIDParam(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	input := dto.AnswerQuestionInputDTO{
+		ProductID:  c.Param("id"),
+		QuestionID: questionID,
+		SellerID:   c.GetString(middleware.SellerContextKey),
+	}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		_ = c.Error(errors.NewInvalidInputError("The request body is not a valid answer"))
+		return
+	}
+
+	result, err := h.answerQuestionUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": result,
+	})
+}
+
+// HideQuestion godoc
+// @Summary Hide a question
+// @Description Hide a question from the public listings, as the seller of the product identified by X-Seller-ID or as an administrator.
+// @Tags questions
+// @Produce json
+// @Param id path string true "Product ID" example(MLB001)
+// @Param question_id path int true "Question ID" example(3)
+// @Param X-Seller-ID header string false "ID of the seller of the product" example(SELLER001)
+// @Param X-Admin-Token header string false "Administrator token"
+// @Success 200 {object} dto.QuestionResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Router /api/v1/products/{id}/questions/{question_id}/hide [post]
+func (h *QuestionHandler) HideQuestion(c *gin.Context) {
+	questionID, err := questionIDParam(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	result, err := h.hideQuestionUseCase.Execute(c.Request.Context(), dto.HideQuestionInputDTO{
+		ProductID:  c.Param("id"),
+		QuestionID: questionID,
+		SellerID:   c.GetString(middleware.SellerContextKey),
+		IsAdmin:    c.GetBool(middleware.AdminContextKey),
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": result,
+	})
+}
+
+// questionIDParam reads the :question_id path parameter. An ID that cannot
+// exist is reported as a missing question.
+func questionIDParam(c *gin.Context) (int64, error) {
+	questionID, err := strconv.ParseInt(c.Param("question_id"), 10, 64)
+	if err != nil {
+		return 0, errors.NewInvalidInputError("question id must be an integer")
+	}
+
+	if questionID <= 0 {
+		return 0, errors.ErrQuestionNotFound
+	}
+
+	return questionID, nil
+}
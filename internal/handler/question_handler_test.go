@@ -0,0 +1,273 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"project/internal/dto"
+	"project/internal/errors"
+	"project/internal/infra/http/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockCreateQuestionUseCase struct {
+	mock.Mock
+}
+
+func (m *MockCreateQuestionUseCase) Execute(ctx context.Context, input dto.CreateQuestionInputDTO) (*dto.QuestionDTO, error) {
+	args := m.Called(ctx, input)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.QuestionDTO), nil
+}
+
+type MockListQuestionsUseCase struct {
+	mock.Mock
+}
+
+func (m *MockListQuestionsUseCase) Execute(ctx context.Context, input dto.ListQuestionsInputDTO) (*dto.QuestionListDTO, error) {
+	args := m.Called(ctx, input)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.QuestionListDTO), nil
+}
+
+type MockAnswerQuestionUseCase struct {
+	mock.Mock
+}
+
+func (m *MockAnswerQuestionUseCase) Execute(ctx context.Context, input dto.AnswerQuestionInputDTO) (*dto.QuestionDTO, error) {
+	args := m.Called(ctx, input)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.QuestionDTO), nil
+}
+
+type MockHideQuestionUseCase struct {
+	mock.Mock
+}
+
+func (m *MockHideQuestionUseCase) Execute(ctx context.Context, input dto.HideQuestionInputDTO) (*dto.QuestionDTO, error) {
+	args := m.Called(ctx, input)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.QuestionDTO), nil
+}
+
+func setupQuestionTestRouter(handler *QuestionHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	r.Use(testErrorHandler)
+	r.Use(middleware.AdminMiddleware(testAdminToken))
+	r.Use(middleware.SellerMiddleware())
+
+	r.GET("/products/:id/questions", handler.ListQuestions)
+	r.POST("/products/:id/questions", handler.CreateQuestion)
+	r.POST("/products/:id/questions/:question_id/answer", handler.AnswerQuestion)
+	r.POST("/products/:id/questions/:question_id/hide", handler.HideQuestion)
+
+	return r
+}
+
+func TestQuestionHandler_CreateQuestion_Success(t *testing.T) {
+	mockCreateUseCase := new(MockCreateQuestionUseCase)
+	mockCreateUseCase.On("Execute", mock.Anything, dto.CreateQuestionInputDTO{
+		ProductID: "MLB001",
+		Text:      "Is it unlocked?",
+	}).Return(&dto.QuestionDTO{ID: 5, ProductID: "MLB001", Text: "Is it unlocked?", Status: "unanswered"}, nil)
+
+	router := setupQuestionTestRouter(NewQuestionHandler(mockCreateUseCase, nil, nil, nil))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/products/MLB001/questions", strings.NewReader(`{"text": "Is it unlocked?"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"status":"unanswered"`)
+	mockCreateUseCase.AssertExpectations(t)
+}
+
+func TestQuestionHandler_ListQuestions_PassesParams(t *testing.T) {
+	mockListUseCase := new(MockListQuestionsUseCase)
+	mockListUseCase.On("Execute", mock.Anything, dto.ListQuestionsInputDTO{
+		ProductID: "MLB001",
+		Status:    "answered",
+		Limit:     1,
+		Cursor:    "abc",
+	}).Return(&dto.QuestionListDTO{
+		Questions:  []dto.QuestionDTO{{ID: 2, ProductID: "MLB001", Status: "answered", Answer: "Yes."}},
+		Pagination: dto.PaginationDTO{Limit: 1},
+	}, nil)
+
+	router := setupQuestionTestRouter(NewQuestionHandler(nil, mockListUseCase, nil, nil))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products/MLB001/questions?status=answered&limit=1&cursor=abc", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"answer":"Yes."`)
+	mockListUseCase.AssertExpectations(t)
+}
+
+func TestQuestionHandler_ListQuestions_HiddenRequiresAdmin(t *testing.T) {
+	mockListUseCase := new(MockListQuestionsUseCase)
+	mockListUseCase.On("Execute", mock.Anything, mock.Anything).Return(&dto.QuestionListDTO{Questions: []dto.QuestionDTO{}}, nil)
+
+	router := setupQuestionTestRouter(NewQuestionHandler(nil, mockListUseCase, nil, nil))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products/MLB001/questions?status=hidden", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	mockListUseCase.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/products/MLB001/questions?status=hidden", nil)
+	req.Header.Set(middleware.AdminTokenHeader, testAdminToken)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestQuestionHandler_ListingNotActive(t *testing.T) {
+	// MLB002 is paused: the use cases only find it for its seller, SELLER001,
+	// and for administrators.
+	mockCreateUseCase := new(MockCreateQuestionUseCase)
+	mockCreateUseCase.On("Execute", mock.Anything, dto.CreateQuestionInputDTO{ProductID: "MLB002", Text: "Is it unlocked?"}).Return(nil, errors.ErrProductNotFound)
+	mockCreateUseCase.On("Execute", mock.Anything, dto.CreateQuestionInputDTO{ProductID: "MLB002", Text: "Is it unlocked?", IsAdmin: true}).Return(&dto.QuestionDTO{ID: 5, ProductID: "MLB002", Status: "unanswered"}, nil)
+	mockListUseCase := new(MockListQuestionsUseCase)
+	mockListUseCase.On("Execute", mock.Anything, dto.ListQuestionsInputDTO{ProductID: "MLB002"}).Return(nil, errors.ErrProductNotFound)
+	mockListUseCase.On("Execute", mock.Anything, dto.ListQuestionsInputDTO{ProductID: "MLB002", SellerID: "SELLER001"}).Return(&dto.QuestionListDTO{Questions: []dto.QuestionDTO{}}, nil)
+
+	router := setupQuestionTestRouter(NewQuestionHandler(mockCreateUseCase, mockListUseCase, nil, nil))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products/MLB002/questions", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "PRODUCT_NOT_FOUND")
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/products/MLB002/questions", nil)
+	req.Header.Set(middleware.SellerIDHeader, "SELLER001")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/products/MLB002/questions", strings.NewReader(`{"text": "Is it unlocked?"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "PRODUCT_NOT_FOUND")
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/products/MLB002/questions", strings.NewReader(`{"text": "Is it unlocked?"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(middleware.AdminTokenHeader, testAdminToken)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	mockCreateUseCase.AssertExpectations(t)
+	mockListUseCase.AssertExpectations(t)
+}
+
+func TestQuestionHandler_AnswerQuestion_PassesSeller(t *testing.T) {
+	mockAnswerUseCase := new(MockAnswerQuestionUseCase)
+	mockAnswerUseCase.On("Execute", mock.Anything, dto.AnswerQuestionInputDTO{
+		ProductID:  "MLB001",
+		QuestionID: 3,
+		SellerID:   "SELLER001",
+		Answer:     "Yes.",
+	}).Return(&dto.QuestionDTO{ID: 3, ProductID: "MLB001", Status: "answered", Answer: "Yes."}, nil)
+
+	router := setupQuestionTestRouter(NewQuestionHandler(nil, nil, mockAnswerUseCase, nil))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/products/MLB001/questions/3/answer", strings.NewReader(`{"answer": "Yes."}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(middleware.SellerIDHeader, "SELLER001")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockAnswerUseCase.AssertExpectations(t)
+}
+
+func TestQuestionHandler_AnswerQuestion_AlreadyAnswered(t *testing.T) {
+	mockAnswerUseCase := new(MockAnswerQuestionUseCase)
+	mockAnswerUseCase.On("Execute", mock.Anything, mock.Anything).Return(nil, errors.ErrQuestionAnswered)
+
+	router := setupQuestionTestRouter(NewQuestionHandler(nil, nil, mockAnswerUseCase, nil))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/products/MLB001/questions/3/answer", strings.NewReader(`{"answer": "Yes."}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), "QUESTION_ALREADY_ANSWERED")
+}
+
+func TestQuestionHandler_InvalidQuestionID(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected int
+	}{
+		{"/products/MLB001/questions/abc/answer", http.StatusBadRequest},
+		{"/products/MLB001/questions/0/hide", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			mockAnswerUseCase := new(MockAnswerQuestionUseCase)
+			mockHideUseCase := new(MockHideQuestionUseCase)
+
+			router := setupQuestionTestRouter(NewQuestionHandler(nil, nil, mockAnswerUseCase, mockHideUseCase))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", tt.path, strings.NewReader(`{"answer": "Yes."}`))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expected, w.Code)
+			mockAnswerUseCase.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+			mockHideUseCase.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestQuestionHandler_HideQuestion_PassesAdmin(t *testing.T) {
+	mockHideUseCase := new(MockHideQuestionUseCase)
+	mockHideUseCase.On("Execute", mock.Anything, dto.HideQuestionInputDTO{
+		ProductID:  "MLB001",
+		QuestionID: 3,
+		IsAdmin:    true,
+	}).Return(&dto.QuestionDTO{ID: 3, ProductID: "MLB001", Status: "hidden"}, nil)
+
+	router := setupQuestionTestRouter(NewQuestionHandler(nil, nil, nil, mockHideUseCase))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/products/MLB001/questions/3/hide", nil)
+	req.Header.Set(middleware.AdminTokenHeader, testAdminToken)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"status":"hidden"`)
+	mockHideUseCase.AssertExpectations(t)
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"project/internal/dto"
+	"project/internal/errors"
+	"project/internal/infra/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CreateReviewUseCase interface {
+	Execute(ctx context.Context, input dto.CreateReviewInputDTO) (*dto.ReviewDTO, error)
+}
+
+type ListReviewsUseCase interface {
+	Execute(ctx context.Context, input dto.ListReviewsInputDTO) (*dto.ReviewListDTO, error)
+}
+
+type ReviewHandler struct {
+	createReviewUseCase CreateReviewUseCase
+	listReviewsUseCase  ListReviewsUseCase
+}
+
+func NewReviewHandler(
+	createReviewUseCase CreateReviewUseCase,
+	listReviewsUseCase ListReviewsUseCase,
+) *ReviewHandler {
+	return &ReviewHandler{
+		createReviewUseCase: createReviewUseCase,
+		listReviewsUseCase:  listReviewsUseCase,
+	}
+}
+
+// CreateReview godoc
+// @Summary Review a product
+// @Description Rate a product from 1 to 5 stars, with an optional title and comment. The rating is added to the rating of the product at once. A listing that is not active is only reviewed by administrators and by its seller, identified by X-Seller-ID.
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID" example(MLB001)
+// @Param review body dto.CreateReviewInputDTO true "Review to create"
+// @Param X-Admin-Token header string false "Administrator token"
+// @Param X-Seller-ID header string false "ID of the calling seller, who may get its own listings in any status" example(SELLER001)
+// @Success 201 {object} dto.ReviewResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Router /api/v1/products/{id}/reviews [post]
+func (h *ReviewHandler) CreateReview(c *gin.Context) {
+	input := dto.CreateReviewInputDTO{ProductID: c.Param("id")}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		_ = c.Error(errors.NewInvalidInputError("The request body is not a valid review"))
+		return
+	}
+	input.SellerID = c.GetString(middleware.SellerContextKey)
+	input.IsAdmin = c.GetBool(middleware.AdminContextKey)
+
+	result, err := h.createReviewUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"data": result,
+	})
+}
+
+// ListReviews godoc
+// @Summary List the reviews of a product
+// @Description Get a page of the reviews of a product, newest first. Follow pagination.next_cursor to read the next page. The reviews of a listing that is not active are only returned to administrators and to its seller, identified by X-Seller-ID.
+// @Tags reviews
+// @Produce json
+// @Param id path string true "Product ID" example(MLB001)
+// @Param limit query int false "Page size (1-100)" default(20)
+// @Param cursor query string false "Opaque cursor from pagination.next_cursor"
+// @Param X-Admin-Token header string false "Administrator token"
+// @Param X-Seller-ID header string false "ID of the calling seller, who may get its own listings in any status" example(SELLER001)
+// @Success 200 {object} dto.ReviewListResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Router /api/v1/products/{id}/reviews [get]
+func (h *ReviewHandler) ListReviews(c *gin.Context) {
+	limit, err := intQueryParam(c, "limit")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	result, err := h.listReviewsUseCase.Execute(c.Request.Context(), dto.ListReviewsInputDTO{
+		ProductID: c.Param("id"),
+		Limit:     limit,
+		Cursor:    c.Query("cursor"),
+		SellerID:  c.GetString(middleware.SellerContextKey),
+		IsAdmin:   c.GetBool(middleware.AdminContextKey),
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":       result.Reviews,
+		"pagination": result.Pagination,
+	})
+}
@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"project/internal/dto"
+	"project/internal/errors"
+	"project/internal/infra/http/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockCreateReviewUseCase struct {
+	mock.Mock
+}
+
+func (m *MockCreateReviewUseCase) Execute(ctx context.Context, input dto.CreateReviewInputDTO) (*dto.ReviewDTO, error) {
+	args := m.Called(ctx, input)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ReviewDTO), nil
+}
+
+type MockListReviewsUseCase struct {
+	mock.Mock
+}
+
+func (m *MockListReviewsUseCase) Execute(ctx context.Context, input dto.ListReviewsInputDTO) (*dto.ReviewListDTO, error) {
+	args := m.Called(ctx, input)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ReviewListDTO), nil
+}
+
+func setupReviewTestRouter(handler *ReviewHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	r.Use(testErrorHandler)
+	r.Use(middleware.AdminMiddleware(testAdminToken))
+	r.Use(middleware.SellerMiddleware())
+
+	r.GET("/products/:id/reviews", handler.ListReviews)
+	r.POST("/products/:id/reviews", handler.CreateReview)
+
+	return r
+}
+
+func TestReviewHandler_CreateReview_Success(t *testing.T) {
+	mockCreateUseCase := new(MockCreateReviewUseCase)
+	mockCreateUseCase.On("Execute", mock.Anything, dto.CreateReviewInputDTO{
+		ProductID: "MLB001",
+		Rating:    5,
+		Comment:   "Great",
+	}).Return(&dto.ReviewDTO{ID: 6, ProductID: "MLB001", Rating: 5, Comment: "Great"}, nil)
+
+	router := setupReviewTestRouter(NewReviewHandler(mockCreateUseCase, nil))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/products/MLB001/reviews", strings.NewReader(`{"rating": 5, "comment": "Great"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"id":6`)
+	mockCreateUseCase.AssertExpectations(t)
+}
+
+func TestReviewHandler_CreateReview_InvalidBody(t *testing.T) {
+	for _, body := range []string{`{"rating": 4.5}`, `{"rating": "five"}`, `not json`} {
+		t.Run(body, func(t *testing.T) {
+			mockCreateUseCase := new(MockCreateReviewUseCase)
+
+			router := setupReviewTestRouter(NewReviewHandler(mockCreateUseCase, nil))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/products/MLB001/reviews", strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			mockCreateUseCase.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestReviewHandler_CreateReview_ProductNotFound(t *testing.T) {
+	mockCreateUseCase := new(MockCreateReviewUseCase)
+	mockCreateUseCase.On("Execute", mock.Anything, mock.Anything).Return(nil, errors.ErrProductNotFound)
+
+	router := setupReviewTestRouter(NewReviewHandler(mockCreateUseCase, nil))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/products/MLB999/reviews", strings.NewReader(`{"rating": 5}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "PRODUCT_NOT_FOUND")
+}
+
+func TestReviewHandler_ListReviews_PassesParams(t *testing.T) {
+	mockListUseCase := new(MockListReviewsUseCase)
+	mockListUseCase.On("Execute", mock.Anything, dto.ListReviewsInputDTO{
+		ProductID: "MLB001",
+		Limit:     1,
+		Cursor:    "abc",
+	}).Return(&dto.ReviewListDTO{
+		Reviews:    []dto.ReviewDTO{{ID: 3, ProductID: "MLB001", Rating: 5}},
+		Pagination: dto.PaginationDTO{Limit: 1},
+	}, nil)
+
+	router := setupReviewTestRouter(NewReviewHandler(nil, mockListUseCase))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products/MLB001/reviews?limit=1&cursor=abc", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"rating":5`)
+	assert.Contains(t, w.Body.String(), `"pagination":{"limit":1,"has_more":false}`)
+	mockListUseCase.AssertExpectations(t)
+}
+
+func TestReviewHandler_ListReviews_InvalidLimit(t *testing.T) {
+	mockListUseCase := new(MockListReviewsUseCase)
+
+	router := setupReviewTestRouter(NewReviewHandler(nil, mockListUseCase))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products/MLB001/reviews?limit=abc", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockListUseCase.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
+
+func TestReviewHandler_ListingNotActive(t *testing.T) {
+	// MLB002 is paused: the use cases only find it for its seller, SELLER001,
+	// and for administrators.
+	mockCreateUseCase := new(MockCreateReviewUseCase)
+	mockCreateUseCase.On("Execute", mock.Anything, dto.CreateReviewInputDTO{ProductID: "MLB002", Rating: 5}).Return(nil, errors.ErrProductNotFound)
+	mockCreateUseCase.On("Execute", mock.Anything, dto.CreateReviewInputDTO{ProductID: "MLB002", Rating: 5, SellerID: "SELLER001"}).Return(&dto.ReviewDTO{ID: 6, ProductID: "MLB002", Rating: 5}, nil)
+	mockListUseCase := new(MockListReviewsUseCase)
+	mockListUseCase.On("Execute", mock.Anything, dto.ListReviewsInputDTO{ProductID: "MLB002"}).Return(nil, errors.ErrProductNotFound)
+	mockListUseCase.On("Execute", mock.Anything, dto.ListReviewsInputDTO{ProductID: "MLB002", IsAdmin: true}).Return(&dto.ReviewListDTO{Reviews: []dto.ReviewDTO{}}, nil)
+
+	router := setupReviewTestRouter(NewReviewHandler(mockCreateUseCase, mockListUseCase))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products/MLB002/reviews", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "PRODUCT_NOT_FOUND")
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/products/MLB002/reviews", nil)
+	req.Header.Set(middleware.AdminTokenHeader, testAdminToken)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/products/MLB002/reviews", strings.NewReader(`{"rating": 5}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "PRODUCT_NOT_FOUND")
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/products/MLB002/reviews", strings.NewReader(`{"rating": 5}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(middleware.SellerIDHeader, "SELLER001")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	mockCreateUseCase.AssertExpectations(t)
+	mockListUseCase.AssertExpectations(t)
+}
@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"project/internal/dto"
+	"project/internal/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GetSellerUseCase interface {
+	Execute(ctx context.Context, input dto.SellerInputDTO) (*dto.SellerDetailDTO, error)
+}
+
+type ListSellerProductsUseCase interface {
+	Execute(ctx context.Context, input dto.ListSellerProductsInputDTO) (*dto.ProductListDTO, error)
+}
+
+type SellerHandler struct {
+	getSellerUseCase          GetSellerUseCase
+	listSellerProductsUseCase ListSellerProductsUseCase
+}
+
+func NewSellerHandler(
+	getSellerUseCase GetSellerUseCase,
+	listSellerProductsUseCase ListSellerProductsUseCase,
+) *SellerHandler {
+	return &SellerHandler{
+		getSellerUseCase:          getSellerUseCase,
+		listSellerProductsUseCase: listSellerProductsUseCase,
+	}
+}
+
+// GetSeller godoc
+// @Summary Get a seller by ID
+// @Description Get a seller with the number of products it has listed
+// @Tags sellers
+// @Produce json
+// @Param id path string true "Seller ID" example(SELLER001)
+// @Success 200 {object} dto.SellerResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Router /api/v1/sellers/{id} [get]
+func (h *SellerHandler) GetSeller(c *gin.Context) {
+	result, err := h.getSellerUseCase.Execute(c.Request.Context(), dto.SellerInputDTO{ID: c.Param("id")})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": result,
+	})
+}
+
+// ListSellerProducts godoc
+// @Summary List the products of a seller
+// @Description Get a paginated list of the products of one seller, ordered by ID. Accepts the same parameters as the product list except seller_id and ids. The seller, identified by X-Seller-ID, and administrators may list its listings in any status.
+// @Tags sellers
+// @Produce json
+// @Param id path string true "Seller ID" example(SELLER001)
+// @Param limit query int false "Page size (1-100)" default(20)
+// @Param cursor query string false "Opaque cursor from pagination.next_cursor"
+// @Param status query string false "Listing status" Enums(active, paused, closed, under_review, scheduled) default(active)
+// @Param category query string false "Category path; also matches its subcategories" example(Electronics > Smartphones)
+// @Param category_id query int false "Category ID; also matches its subcategories" example(6)
+// @Param condition query string false "Product condition" Enums(new, used, refurbished)
+// @Param min_price query number false "Minimum price, inclusive, in currency or in USD without it"
+// @Param max_price query number false "Maximum price, inclusive, in currency or in USD without it"
+// @Param in_stock query bool false "Only products with (true) or without (false) stock"
+// @Param fields query string false "Comma-separated product fields to return" example(id,title,price)
+// @Param expand query string false "Comma-separated related data to embed in each product: images, seller" example(images,seller)
+// @Param currency query string false "Convert prices to this currency, which must have an exchange rate" example(BRL)
+// @Param price_format query string false "Also render prices exactly, in minor units, as price_money" Enums(number, money) default(number)
+// @Param include_deleted query bool false "Include soft deleted products (administrators only)"
+// @Param X-Admin-Token header string false "Administrator token"
+// @Param X-Seller-ID header string false "ID of the seller, who may list its own listings in any status" example(SELLER001)
+// @Success 200 {object} dto.ProductListResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Router /api/v1/sellers/{id}/products [get]
+func (h *SellerHandler) ListSellerProducts(c *gin.Context) {
+	for _, name := range []string{"seller_id", "ids"} {
+		if _, ok := c.GetQuery(name); ok {
+			_ = c.Error(errors.NewInvalidInputError(name + " cannot be used when listing the products of a seller"))
+			return
+		}
+	}
+
+	input, fields, err := listProductsParams(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	if err := listingStatusAllowed(c, input.Status, c.Param("id")); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	result, err := h.listSellerProductsUseCase.Execute(c.Request.Context(), dto.ListSellerProductsInputDTO{
+		SellerID:            c.Param("id"),
+		ListProductInputDTO: input,
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	writeProductList(c, result, fields)
+}
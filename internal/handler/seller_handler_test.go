@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"project/internal/dto"
+	"project/internal/errors"
+	"project/internal/infra/http/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockGetSellerUseCase struct {
+	mock.Mock
+}
+
+func (m *MockGetSellerUseCase) Execute(ctx context.Context, input dto.SellerInputDTO) (*dto.SellerDetailDTO, error) {
+	args := m.Called(ctx, input)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.SellerDetailDTO), nil
+}
+
+type MockListSellerProductsUseCase struct {
+	mock.Mock
+}
+
+func (m *MockListSellerProductsUseCase) Execute(ctx context.Context, input dto.ListSellerProductsInputDTO) (*dto.ProductListDTO, error) {
+	args := m.Called(ctx, input)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ProductListDTO), nil
+}
+
+func setupSellerTestRouter(handler *SellerHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	r.Use(testErrorHandler)
+	r.Use(middleware.AdminMiddleware(testAdminToken))
+	r.Use(middleware.SellerMiddleware())
+
+	r.GET("/sellers/:id", handler.GetSeller)
+	r.GET("/sellers/:id/products", handler.ListSellerProducts)
+
+	return r
+}
+
+func TestSellerHandler_GetSeller_Success(t *testing.T) {
+	mockGetUseCase := new(MockGetSellerUseCase)
+	mockGetUseCase.On("Execute", mock.Anything, dto.SellerInputDTO{ID: "SELLER001"}).Return(&dto.SellerDetailDTO{
+		ID:           "SELLER001",
+		Name:         "TechWorld Store",
+		ProductCount: 2,
+	}, nil)
+
+	router := setupSellerTestRouter(NewSellerHandler(mockGetUseCase, nil))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/sellers/SELLER001", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"name":"TechWorld Store"`)
+	assert.Contains(t, w.Body.String(), `"product_count":2`)
+}
+
+func TestSellerHandler_GetSeller_NotFound(t *testing.T) {
+	mockGetUseCase := new(MockGetSellerUseCase)
+	mockGetUseCase.On("Execute", mock.Anything, mock.Anything).Return(nil, errors.ErrSellerNotFound)
+
+	router := setupSellerTestRouter(NewSellerHandler(mockGetUseCase, nil))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/sellers/SELLER999", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestSellerHandler_ListSellerProducts_PassesSellerAndParams(t *testing.T) {
+	mockListUseCase := new(MockListSellerProductsUseCase)
+	mockListUseCase.On("Execute", mock.Anything, dto.ListSellerProductsInputDTO{
+		SellerID: "SELLER001",
+		ListProductInputDTO: dto.ListProductInputDTO{
+			Limit:             1,
+			ProductFiltersDTO: dto.ProductFiltersDTO{Condition: "used"},
+		},
+	}).Return(&dto.ProductListDTO{
+		Products:   []dto.ProductDTO{{ID: "MLB005", Title: "Sony WH-1000XM5"}},
+		Pagination: dto.PaginationDTO{Limit: 1},
+	}, nil)
+
+	router := setupSellerTestRouter(NewSellerHandler(nil, mockListUseCase))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/sellers/SELLER001/products?limit=1&condition=used&fields=id", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"data":[{"id":"MLB005"}]`)
+	mockListUseCase.AssertExpectations(t)
+}
+
+func TestSellerHandler_ListSellerProducts_NonActiveStatusRequiresSeller(t *testing.T) {
+	mockListUseCase := new(MockListSellerProductsUseCase)
+	mockListUseCase.On("Execute", mock.Anything, dto.ListSellerProductsInputDTO{
+		SellerID: "SELLER001",
+		ListProductInputDTO: dto.ListProductInputDTO{
+			ProductFiltersDTO: dto.ProductFiltersDTO{Status: "paused"},
+		},
+	}).Return(&dto.ProductListDTO{Products: []dto.ProductDTO{}}, nil)
+
+	router := setupSellerTestRouter(NewSellerHandler(nil, mockListUseCase))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/sellers/SELLER001/products?status=paused", nil)
+	req.Header.Set(middleware.SellerIDHeader, "SELLER002")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	mockListUseCase.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/sellers/SELLER001/products?status=paused", nil)
+	req.Header.Set(middleware.SellerIDHeader, "SELLER001")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockListUseCase.AssertExpectations(t)
+}
+
+func TestSellerHandler_ListSellerProducts_RejectsSellerIDAndIDs(t *testing.T) {
+	for _, query := range []string{"seller_id=SELLER002", "ids=MLB001"} {
+		t.Run(query, func(t *testing.T) {
+			mockListUseCase := new(MockListSellerProductsUseCase)
+
+			router := setupSellerTestRouter(NewSellerHandler(nil, mockListUseCase))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/sellers/SELLER001/products?"+query, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			mockListUseCase.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestSellerHandler_ListSellerProducts_NotFound(t *testing.T) {
+	mockListUseCase := new(MockListSellerProductsUseCase)
+	mockListUseCase.On("Execute", mock.Anything, mock.Anything).Return(nil, errors.ErrSellerNotFound)
+
+	router := setupSellerTestRouter(NewSellerHandler(nil, mockListUseCase))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/sellers/SELLER999/products", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"project/internal/dto"
+	"project/internal/infra/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GetShippingQuoteUseCase interface {
+	Execute(ctx context.Context, input dto.ShippingQuoteInputDTO) (*dto.ShippingQuoteDTO, error)
+}
+
+type ShippingHandler struct {
+	getShippingQuoteUseCase GetShippingQuoteUseCase
+}
+
+func NewShippingHandler(getShippingQuoteUseCase GetShippingQuoteUseCase) *ShippingHandler {
+	return &ShippingHandler{
+		getShippingQuoteUseCase: getShippingQuoteUseCase,
+	}
+}
+
+// GetShippingQuote godoc
+// @Summary Quote the shipping of a product
+// @Description Calculate the cost of shipping a product to a zip code from the rate of its route, by the weight of its package, and estimate the delivery in business days from today, skipping weekends and holidays. A product with free shipping costs nothing to ship. A listing that is not active is only quoted to administrators and to its seller, identified by X-Seller-ID.
+// @Tags shipping
+// @Produce json
+// @Param id path string true "Product ID" example(MLB001)
+// @Param zip query string true "Destination zip code, as 8 digits or as 01310-100" example(20040-002)
+// @Param X-Admin-Token header string false "Administrator token"
+// @Param X-Seller-ID header string false "ID of the calling seller, who may get its own listings in any status" example(SELLER001)
+// @Success 200 {object} dto.ShippingQuoteResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 422 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Router /api/v1/products/{id}/shipping [get]
+func (h *ShippingHandler) GetShippingQuote(c *gin.Context) {
+	result, err := h.getShippingQuoteUseCase.Execute(c.Request.Context(), dto.ShippingQuoteInputDTO{
+		ProductID: c.Param("id"),
+		Zip:       c.Query("zip"),
+		SellerID:  c.GetString(middleware.SellerContextKey),
+		IsAdmin:   c.GetBool(middleware.AdminContextKey),
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": result,
+	})
+}
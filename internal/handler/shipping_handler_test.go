@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"project/internal/dto"
+	"project/internal/errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockGetShippingQuoteUseCase struct {
+	mock.Mock
+}
+
+func (m *MockGetShippingQuoteUseCase) Execute(ctx context.Context, input dto.ShippingQuoteInputDTO) (*dto.ShippingQuoteDTO, error) {
+	args := m.Called(ctx, input)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ShippingQuoteDTO), nil
+}
+
+func setupShippingTestRouter(handler *ShippingHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	r.Use(testErrorHandler)
+
+	r.GET("/products/:id/shipping", handler.GetShippingQuote)
+
+	return r
+}
+
+func TestShippingHandler_GetShippingQuote_Success(t *testing.T) {
+	mockUseCase := new(MockGetShippingQuoteUseCase)
+	mockUseCase.On("Execute", mock.Anything, dto.ShippingQuoteInputDTO{ProductID: "MLB001", Zip: "20040-002"}).Return(&dto.ShippingQuoteDTO{
+		ProductID:             "MLB001",
+		DestinationZip:        "20040002",
+		Cost:                  12.9,
+		Currency:              "USD",
+		EstimatedDeliveryFrom: "2024-01-04",
+	}, nil)
+
+	router := setupShippingTestRouter(NewShippingHandler(mockUseCase))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products/MLB001/shipping?zip=20040-002", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"cost":12.9,"currency":"USD"`)
+	assert.Contains(t, w.Body.String(), `"estimated_delivery_from":"2024-01-04"`)
+}
+
+func TestShippingHandler_GetShippingQuote_Unavailable(t *testing.T) {
+	mockUseCase := new(MockGetShippingQuoteUseCase)
+	mockUseCase.On("Execute", mock.Anything, mock.Anything).Return(nil, errors.ErrShippingUnavailable)
+
+	router := setupShippingTestRouter(NewShippingHandler(mockUseCase))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/products/MLB001/shipping?zip=20040002", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(t, w.Body.String(), "SHIPPING_UNAVAILABLE")
+}
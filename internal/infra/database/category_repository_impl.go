@@ -0,0 +1,168 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"project/internal/entity"
+	"project/internal/errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type CategoryRepository struct {
+	DB *sqlx.DB
+}
+
+func NewCategoryRepository(db *sqlx.DB) *CategoryRepository {
+	return &CategoryRepository{
+		DB: db,
+	}
+}
+
+func (r *CategoryRepository) ListCategories(ctx context.Context) ([]entity.Category, error) {
+	categories := []entity.Category{}
+
+	if err := r.DB.SelectContext(ctx, &categories, "SELECT * FROM categories ORDER BY path ASC"); err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return categories, nil
+}
+
+func (r *CategoryRepository) GetCategory(ctx context.Context, id int64) (*entity.Category, error) {
+	var category entity.Category
+
+	err := r.DB.GetContext(ctx, &category, "SELECT * FROM categories WHERE id = ?", id)
+	if err == sql.ErrNoRows {
+		return nil, errors.ErrCategoryNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return &category, nil
+}
+
+// categoryPathsQuery walks from each requested category up to its root,
+// numbering the steps so that every path can be read back root first.
+const categoryPathsQuery = `
+        WITH RECURSIVE ancestors(leaf_id, id, depth) AS (
+            SELECT id, id, 0 FROM categories WHERE id IN (?)
+            UNION ALL
+            SELECT a.leaf_id, c.parent_id, a.depth + 1
+            FROM ancestors a
+            JOIN categories c ON c.id = a.id
+            WHERE c.parent_id IS NOT NULL
+        )
+        SELECT a.leaf_id, c.*
+        FROM ancestors a
+        JOIN categories c ON c.id = a.id
+        ORDER BY a.leaf_id ASC, a.depth DESC
+    `
+
+func (r *CategoryRepository) FindCategoryPaths(ctx context.Context, ids []int64) (map[int64][]entity.Category, error) {
+	paths := map[int64][]entity.Category{}
+	if len(ids) == 0 {
+		return paths, nil
+	}
+
+	query, args, err := sqlx.In(categoryPathsQuery, ids)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	var rows []struct {
+		LeafID int64 `db:"leaf_id"`
+		entity.Category
+	}
+	if err := r.DB.SelectContext(ctx, &rows, r.DB.Rebind(query), args...); err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	for _, row := range rows {
+		paths[row.LeafID] = append(paths[row.LeafID], row.Category)
+	}
+
+	return paths, nil
+}
+
+func (r *CategoryRepository) CountProductsByCategory(ctx context.Context, at time.Time) (map[int64]int, error) {
+	active, args := listingStatusCondition(entity.ProductActive, at)
+	query := `
+        SELECT p.category_id, count(*) AS count
+        FROM products p
+        WHERE p.category_id IS NOT NULL AND p.deleted_at IS NULL AND ` + active + `
+        GROUP BY p.category_id
+    `
+
+	var rows []struct {
+		CategoryID int64 `db:"category_id"`
+		Count      int   `db:"count"`
+	}
+	if err := r.DB.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	counts := make(map[int64]int, len(rows))
+	for _, row := range rows {
+		counts[row.CategoryID] = row.Count
+	}
+
+	return counts, nil
+}
+
+func (r *CategoryRepository) FindCategoryAttributes(ctx context.Context, path string) ([]entity.CategoryAttribute, error) {
+	attributes := []entity.CategoryAttribute{}
+
+	prefixes := entity.CategoryPathPrefixes(path)
+	if len(prefixes) == 0 {
+		return attributes, nil
+	}
+
+	// A path sorts after the paths of its ancestors, which are its prefixes.
+	query, args, err := sqlx.In(`
+        SELECT ca.category_id, ca.name, ca.type, ca.required
+        FROM category_attributes ca
+        JOIN categories c ON c.id = ca.category_id
+        WHERE c.path IN (?)
+        ORDER BY c.path ASC, ca.name ASC
+    `, prefixes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	if err := r.DB.SelectContext(ctx, &attributes, r.DB.Rebind(query), args...); err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return attributes, nil
+}
+
+// ensureCategory creates the category at path and any missing ancestor, and
+// returns its ID, or nil for an empty path. It runs inside the transaction
+// that writes the product so a product never points to a missing category.
+func ensureCategory(ctx context.Context, tx *sqlx.Tx, path string) (*int64, error) {
+	var parentID *int64
+
+	for _, prefix := range entity.CategoryPathPrefixes(path) {
+		names := entity.SplitCategoryPath(prefix)
+
+		_, err := tx.ExecContext(ctx,
+			"INSERT INTO categories (parent_id, name, path) VALUES (?, ?, ?) ON CONFLICT(path) DO NOTHING",
+			parentID, names[len(names)-1], prefix,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+		}
+
+		var id int64
+		if err := tx.GetContext(ctx, &id, "SELECT id FROM categories WHERE path = ?", prefix); err != nil {
+			return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+		}
+		parentID = &id
+	}
+
+	return parentID, nil
+}
@@ -1,40 +1,229 @@
 package database
 
 import (
+	"context"
 	"fmt"
+	"io/fs"
+	"net/url"
+	"path"
 	"project/internal/infra/database/migrations"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	_ "github.com/mattn/go-sqlite3"
 )
 
-func InitDB() (*sqlx.DB, error) {
-	db, err := sqlx.Open("sqlite3", ":memory:")
+// memoryPath keeps the database in memory, where it lives as long as the
+// process.
+const memoryPath = ":memory:"
+
+// seedSuffix names the migrations that load the demo catalog rather than
+// change the schema.
+const seedSuffix = "_seed.sql"
+
+// Options configures the SQLite database. An empty Path keeps it in memory.
+// WAL applies to a database file only. Seed loads the demo catalog into a new
+// database; a database that already ran its migrations is never seeded.
+type Options struct {
+	Path        string
+	WAL         bool
+	BusyTimeout time.Duration
+	ForeignKeys bool
+	Seed        bool
+}
+
+func InitDB(options Options) (*sqlx.DB, error) {
+	path := options.Path
+	if path == "" {
+		path = memoryPath
+	}
+
+	db, err := sqlx.Open("sqlite3", dataSourceName(path, options))
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
 
+	// Every connection to ":memory:" opens a separate, empty database, so the
+	// pool is pinned to a single connection that holds the schema and data.
+	if path == memoryPath {
+		db.SetMaxOpenConns(1)
+	}
+
 	if err := db.Ping(); err != nil {
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
-	schema, err := migrations.FS.ReadFile("001_schema.sql")
+	if err := runMigrations(db, options); err != nil {
+		return nil, err
+	}
+
+	return db, nil
+}
+
+// dataSourceName is the go-sqlite3 DSN of the database at path. On a file,
+// transactions take the write lock when they begin, so that concurrent
+// writers wait for the busy timeout instead of failing when one of them
+// upgrades its read lock.
+func dataSourceName(path string, options Options) string {
+	params := url.Values{}
+	params.Set("_busy_timeout", strconv.FormatInt(options.BusyTimeout.Milliseconds(), 10))
+	params.Set("_foreign_keys", strconv.FormatBool(options.ForeignKeys))
+
+	if path != memoryPath {
+		params.Set("_txlock", "immediate")
+		if options.WAL {
+			params.Set("_journal_mode", "WAL")
+		}
+	}
+
+	return "file:" + path + "?" + params.Encode()
+}
+
+// runMigrations executes the embedded SQL files the database has not run yet
+// in the order given by their numeric prefix. Each file runs in its own
+// transaction and is recorded in schema_migrations by name, so a database
+// file is only migrated once. The search index migrations join the sequence
+// in builds that embed them; a database migrated without them runs them when
+// first opened by such a build. The seed files run only when seeding a new
+// database; otherwise they are recorded without running, as they expect the
+// schema of their place in the sequence.
+func runMigrations(db *sqlx.DB, options Options) error {
+	ctx := context.Background()
+
+	// Rebuilding a table drops the one its children point to, so foreign keys
+	// are off while migrating. The pragma has no effect inside a transaction,
+	// so every migration runs on this connection.
+	conn, err := db.Connx(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read schema: %w", err)
+		return fmt.Errorf("failed to get a database connection: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "PRAGMA foreign_keys = OFF"); err != nil {
+		return fmt.Errorf("failed to disable foreign keys: %w", err)
+	}
+
+	if _, err := conn.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+        version TEXT PRIMARY KEY,
+        applied_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+    )`); err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
 	}
 
-	if _, err := db.Exec(string(schema)); err != nil {
-		return nil, fmt.Errorf("failed to execute schema: %w", err)
+	var versions []string
+	if err := conn.SelectContext(ctx, &versions, "SELECT version FROM schema_migrations"); err != nil {
+		return fmt.Errorf("failed to list applied migrations: %w", err)
+	}
+	applied := make(map[string]bool, len(versions))
+	for _, version := range versions {
+		applied[version] = true
 	}
+	seed := options.Seed && len(versions) == 0
 
-	seed, err := migrations.FS.ReadFile("002_seed.sql")
+	files, err := migrationFiles()
 	if err != nil {
-		return nil, fmt.Errorf("failed to read seed data: %w", err)
+		return err
 	}
 
-	if _, err := db.Exec(string(seed)); err != nil {
-		return nil, fmt.Errorf("failed to execute seed data: %w", err)
+	for _, file := range files {
+		version := path.Base(file)
+		if applied[version] {
+			continue
+		}
+
+		script, err := migrations.FS.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read migration %s: %w", file, err)
+		}
+		if strings.HasSuffix(version, seedSuffix) && !seed {
+			script = nil
+		}
+
+		if err := runMigration(ctx, conn, version, string(script)); err != nil {
+			return err
+		}
 	}
 
-	return db, nil
+	if err := checkForeignKeys(ctx, conn); err != nil {
+		return err
+	}
+
+	if options.ForeignKeys {
+		if _, err := conn.ExecContext(ctx, "PRAGMA foreign_keys = ON"); err != nil {
+			return fmt.Errorf("failed to enable foreign keys: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// migrationFiles lists the embedded migrations, those of the search index
+// included, sorted by name.
+func migrationFiles() ([]string, error) {
+	files, err := fs.Glob(migrations.FS, "*.sql")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list migrations: %w", err)
+	}
+
+	searchFiles, err := fs.Glob(migrations.FS, migrations.Search+"/*.sql")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list migrations: %w", err)
+	}
+	files = append(files, searchFiles...)
+
+	sort.Slice(files, func(i, j int) bool {
+		return path.Base(files[i]) < path.Base(files[j])
+	})
+
+	return files, nil
+}
+
+// runMigration executes script and records file as applied in one
+// transaction. An empty script only records it.
+func runMigration(ctx context.Context, conn *sqlx.Conn, file, script string) error {
+	tx, err := conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin migration %s: %w", file, err)
+	}
+	defer tx.Rollback()
+
+	if script != "" {
+		if _, err := tx.ExecContext(ctx, script); err != nil {
+			return fmt.Errorf("failed to execute migration %s: %w", file, err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, "INSERT INTO schema_migrations (version) VALUES (?)", file); err != nil {
+		return fmt.Errorf("failed to record migration %s: %w", file, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit migration %s: %w", file, err)
+	}
+
+	return nil
+}
+
+// checkForeignKeys reports the first row whose foreign key points to no row,
+// which the migrations run with foreign keys off may have left behind.
+func checkForeignKeys(ctx context.Context, conn *sqlx.Conn) error {
+	rows, err := conn.QueryContext(ctx, "PRAGMA foreign_key_check")
+	if err != nil {
+		return fmt.Errorf("failed to check foreign keys: %w", err)
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		var table, parent string
+		var rowID, foreignKeyID any
+		if err := rows.Scan(&table, &rowID, &parent, &foreignKeyID); err != nil {
+			return fmt.Errorf("failed to check foreign keys: %w", err)
+		}
+		return fmt.Errorf("failed to check foreign keys: row %v of %s points to a missing row of %s", rowID, table, parent)
+	}
+
+	return rows.Err()
 }
@@ -0,0 +1,57 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"project/internal/entity"
+	"project/internal/errors"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type ExchangeRateRepository struct {
+	DB *sqlx.DB
+}
+
+func NewExchangeRateRepository(db *sqlx.DB) *ExchangeRateRepository {
+	return &ExchangeRateRepository{
+		DB: db,
+	}
+}
+
+func (r *ExchangeRateRepository) ListExchangeRates(ctx context.Context) ([]entity.ExchangeRate, error) {
+	rates := []entity.ExchangeRate{}
+
+	if err := r.DB.SelectContext(ctx, &rates, "SELECT * FROM exchange_rates ORDER BY currency ASC"); err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return rates, nil
+}
+
+func (r *ExchangeRateRepository) SaveExchangeRates(ctx context.Context, rates []entity.ExchangeRate) error {
+	tx, err := r.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+	defer tx.Rollback()
+
+	for _, rate := range rates {
+		_, err := tx.NamedExecContext(ctx, `
+            INSERT INTO exchange_rates (currency, rate, updated_at)
+            VALUES (:currency, :rate, :updated_at)
+            ON CONFLICT(currency) DO UPDATE SET
+                rate = excluded.rate,
+                updated_at = excluded.updated_at
+        `, rate)
+		if err != nil {
+			return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return nil
+}
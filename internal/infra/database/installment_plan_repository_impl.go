@@ -0,0 +1,37 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"project/internal/entity"
+	"project/internal/errors"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type InstallmentPlanRepository struct {
+	DB *sqlx.DB
+}
+
+func NewInstallmentPlanRepository(db *sqlx.DB) *InstallmentPlanRepository {
+	return &InstallmentPlanRepository{
+		DB: db,
+	}
+}
+
+func (r *InstallmentPlanRepository) ListInstallmentPlans(ctx context.Context) ([]entity.InstallmentPlan, error) {
+	plans := []entity.InstallmentPlan{}
+
+	query := `
+        SELECT id, installments, interest_rate_bp,
+            min_installment_minor AS "min_installment.amount", currency AS "min_installment.currency"
+        FROM installment_plans
+        ORDER BY currency ASC, installments ASC
+    `
+
+	if err := r.DB.SelectContext(ctx, &plans, query); err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return plans, nil
+}
@@ -0,0 +1,3 @@
+CREATE INDEX idx_products_category ON products(category);
+CREATE INDEX idx_products_seller_id ON products(seller_id);
+CREATE INDEX idx_products_price ON products(price);
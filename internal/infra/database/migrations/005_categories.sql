@@ -0,0 +1,39 @@
+CREATE TABLE categories (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    parent_id INTEGER REFERENCES categories(id),
+    name TEXT NOT NULL,
+    path TEXT NOT NULL UNIQUE
+);
+
+CREATE INDEX idx_categories_parent_id ON categories(parent_id);
+
+-- Every product category path and each of its ancestors becomes a category,
+-- so "Electronics > Audio > Headphones" also creates "Electronics" and
+-- "Electronics > Audio". Sorting by path inserts parents before children.
+WITH RECURSIVE levels(path, name, rest) AS (
+    SELECT '', '', category || ' > '
+    FROM products
+    WHERE category IS NOT NULL AND category <> ''
+    UNION
+    SELECT
+        CASE WHEN path = '' THEN '' ELSE path || ' > ' END || substr(rest, 1, instr(rest, ' > ') - 1),
+        substr(rest, 1, instr(rest, ' > ') - 1),
+        substr(rest, instr(rest, ' > ') + 3)
+    FROM levels
+    WHERE rest <> ''
+)
+INSERT INTO categories (name, path)
+SELECT name, path FROM levels WHERE path <> '' GROUP BY path ORDER BY path;
+
+UPDATE categories SET parent_id = (
+    SELECT parent.id FROM categories parent
+    WHERE categories.path = parent.path || ' > ' || categories.name
+);
+
+ALTER TABLE products ADD COLUMN category_id INTEGER REFERENCES categories(id);
+
+UPDATE products SET category_id = (
+    SELECT id FROM categories WHERE categories.path = products.category
+);
+
+CREATE INDEX idx_products_category_id ON products(category_id);
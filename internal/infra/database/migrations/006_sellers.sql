@@ -0,0 +1,47 @@
+CREATE TABLE sellers (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+-- A seller may have been copied onto its products under different names;
+-- the bare name column of an aggregate query with max() comes from the most
+-- recently updated product.
+INSERT INTO sellers (id, name, updated_at)
+SELECT seller_id, seller_name, max(updated_at)
+FROM products
+GROUP BY seller_id;
+
+-- SQLite cannot drop a column that is part of the table definition nor add
+-- a foreign key to an existing column, so products is rebuilt without the
+-- copied seller_name.
+CREATE TABLE products_new (
+    id TEXT PRIMARY KEY,
+    title TEXT NOT NULL,
+    description TEXT,
+    price REAL NOT NULL,
+    currency TEXT NOT NULL,
+    condition TEXT CHECK(condition IN ('new', 'used', 'refurbished')),
+    stock INTEGER DEFAULT 0,
+    seller_id TEXT NOT NULL REFERENCES sellers(id),
+    category TEXT,
+    category_id INTEGER REFERENCES categories(id),
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    deleted_at DATETIME
+);
+
+INSERT INTO products_new (id, title, description, price, currency, condition, stock, seller_id, category, category_id, created_at, updated_at, deleted_at)
+SELECT id, title, description, price, currency, condition, stock, seller_id, category, category_id, created_at, updated_at, deleted_at
+FROM products;
+
+DROP TABLE products;
+
+ALTER TABLE products_new RENAME TO products;
+
+CREATE INDEX idx_products_deleted_at ON products(deleted_at);
+CREATE INDEX idx_products_category ON products(category);
+CREATE INDEX idx_products_seller_id ON products(seller_id);
+CREATE INDEX idx_products_price ON products(price);
+CREATE INDEX idx_products_category_id ON products(category_id);
@@ -0,0 +1,38 @@
+ALTER TABLE sellers ADD COLUMN completed_sales INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE sellers ADD COLUMN cancelled_sales INTEGER NOT NULL DEFAULT 0;
+
+-- Orders keep the seller the product had when it was sold. product_id is not
+-- a foreign key so that purging a product does not erase its sales history.
+CREATE TABLE orders (
+    id TEXT PRIMARY KEY,
+    product_id TEXT NOT NULL,
+    seller_id TEXT NOT NULL REFERENCES sellers(id),
+    status TEXT NOT NULL DEFAULT 'pending' CHECK(status IN ('pending', 'completed', 'cancelled')),
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_orders_seller_id_status ON orders(seller_id, status);
+
+-- The sales counters of a seller are recounted by the database whenever one
+-- of its orders changes, whoever writes the order.
+CREATE TRIGGER orders_after_insert AFTER INSERT ON orders BEGIN
+    UPDATE sellers SET
+        completed_sales = (SELECT count(*) FROM orders WHERE seller_id = sellers.id AND status = 'completed'),
+        cancelled_sales = (SELECT count(*) FROM orders WHERE seller_id = sellers.id AND status = 'cancelled')
+    WHERE id = new.seller_id;
+END;
+
+CREATE TRIGGER orders_after_update AFTER UPDATE OF status, seller_id ON orders BEGIN
+    UPDATE sellers SET
+        completed_sales = (SELECT count(*) FROM orders WHERE seller_id = sellers.id AND status = 'completed'),
+        cancelled_sales = (SELECT count(*) FROM orders WHERE seller_id = sellers.id AND status = 'cancelled')
+    WHERE id IN (old.seller_id, new.seller_id);
+END;
+
+CREATE TRIGGER orders_after_delete AFTER DELETE ON orders BEGIN
+    UPDATE sellers SET
+        completed_sales = (SELECT count(*) FROM orders WHERE seller_id = sellers.id AND status = 'completed'),
+        cancelled_sales = (SELECT count(*) FROM orders WHERE seller_id = sellers.id AND status = 'cancelled')
+    WHERE id = old.seller_id;
+END;
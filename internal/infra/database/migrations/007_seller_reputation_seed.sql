@@ -0,0 +1,23 @@
+-- Sales history of the seed sellers, numbered ORD-<product>-<n>.
+WITH RECURSIVE sequence(n) AS (
+    SELECT 1
+    UNION ALL
+    SELECT n + 1 FROM sequence WHERE n < 60
+),
+history(product_id, seller_id, completed, cancelled) AS (
+    VALUES
+        ('MLB001', 'SELLER001', 45, 1),
+        ('MLB005', 'SELLER001', 15, 0),
+        ('MLB002', 'SELLER002', 12, 2),
+        ('MLB003', 'SELLER003', 20, 1),
+        ('MLB004', 'SELLER004', 3, 0)
+)
+INSERT INTO orders (id, product_id, seller_id, status)
+SELECT
+    printf('ORD-%s-%03d', history.product_id, sequence.n),
+    history.product_id,
+    history.seller_id,
+    CASE WHEN sequence.n <= history.completed THEN 'completed' ELSE 'cancelled' END
+FROM history
+JOIN sequence ON sequence.n <= history.completed + history.cancelled
+ORDER BY history.product_id, sequence.n;
@@ -0,0 +1,18 @@
+CREATE TABLE reviews (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    product_id TEXT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    rating INTEGER NOT NULL CHECK(rating BETWEEN 1 AND 5),
+    title TEXT NOT NULL DEFAULT '',
+    comment TEXT NOT NULL DEFAULT '',
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_reviews_product_id ON reviews(product_id, id);
+
+-- Reviews per number of stars. Writes of a review increment the matching
+-- counter in the same transaction.
+ALTER TABLE products ADD COLUMN rating_1 INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE products ADD COLUMN rating_2 INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE products ADD COLUMN rating_3 INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE products ADD COLUMN rating_4 INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE products ADD COLUMN rating_5 INTEGER NOT NULL DEFAULT 0;
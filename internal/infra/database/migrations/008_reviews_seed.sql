@@ -0,0 +1,14 @@
+INSERT INTO reviews (product_id, rating, title, comment, created_at)
+VALUES
+    ('MLB001', 5, 'Best iPhone so far', 'Battery lasts the whole day and the camera is outstanding.', '2024-01-10 12:00:00'),
+    ('MLB001', 4, 'Great, but pricey', 'Excellent phone, although the power adapter is not included.', '2024-01-12 09:30:00'),
+    ('MLB001', 5, '', 'Arrived in two days, sealed box.', '2024-01-15 18:45:00'),
+    ('MLB002', 4, 'Runs everything', 'Fans get loud under load, otherwise perfect.', '2024-01-11 20:10:00'),
+    ('MLB003', 3, 'Solid keyboard', 'Typing feels great, but the Bluetooth connection drops now and then.', '2024-01-13 14:00:00');
+
+UPDATE products SET
+    rating_1 = (SELECT count(*) FROM reviews WHERE product_id = products.id AND rating = 1),
+    rating_2 = (SELECT count(*) FROM reviews WHERE product_id = products.id AND rating = 2),
+    rating_3 = (SELECT count(*) FROM reviews WHERE product_id = products.id AND rating = 3),
+    rating_4 = (SELECT count(*) FROM reviews WHERE product_id = products.id AND rating = 4),
+    rating_5 = (SELECT count(*) FROM reviews WHERE product_id = products.id AND rating = 5);
@@ -0,0 +1,11 @@
+CREATE TABLE questions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    product_id TEXT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    text TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'unanswered' CHECK(status IN ('unanswered', 'answered', 'hidden')),
+    answer TEXT,
+    answered_at DATETIME,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_questions_product_id_status ON questions(product_id, status, id);
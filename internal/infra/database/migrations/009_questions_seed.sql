@@ -0,0 +1,6 @@
+INSERT INTO questions (product_id, text, status, answer, answered_at, created_at)
+VALUES
+    ('MLB001', 'Is it factory unlocked?', 'answered', 'Yes, it works with every carrier.', '2024-01-05 10:15:00', '2024-01-05 09:00:00'),
+    ('MLB001', 'Does it come with a power adapter?', 'answered', 'No, only the USB-C cable is included.', '2024-01-08 16:40:00', '2024-01-08 14:20:00'),
+    ('MLB001', 'Do you ship to Portugal?', 'unanswered', NULL, NULL, '2024-01-16 11:05:00'),
+    ('MLB002', 'How many hours of battery does it last?', 'answered', 'Around five hours of light use.', '2024-01-09 18:00:00', '2024-01-09 12:30:00');
@@ -0,0 +1,14 @@
+-- Rates are quoted against USD: how many units of currency one dollar buys.
+CREATE TABLE exchange_rates (
+    currency TEXT PRIMARY KEY CHECK(length(currency) = 3),
+    rate REAL NOT NULL CHECK(rate > 0),
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO exchange_rates (currency, rate, updated_at)
+VALUES
+    ('USD', 1, '2024-01-15 00:00:00'),
+    ('BRL', 4.92, '2024-01-15 00:00:00'),
+    ('EUR', 0.91, '2024-01-15 00:00:00'),
+    ('ARS', 818.5, '2024-01-15 00:00:00'),
+    ('MXN', 17.1, '2024-01-15 00:00:00');
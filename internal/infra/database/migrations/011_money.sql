@@ -0,0 +1,48 @@
+-- Prices move from a REAL in major units to an INTEGER in the minor unit of
+-- their currency, so 1299.99 USD is stored as 129999. The scale of each
+-- currency follows entity.CurrencyDecimals: most have cents, the ones below
+-- have 0, 3 or 4 decimal places. SQLite cannot change the type of a column,
+-- so products is rebuilt as in 006_sellers.sql.
+--
+-- Price filters compare the price in major units, which depends on the
+-- currency, so the former index on price has no replacement.
+CREATE TABLE products_new (
+    id TEXT PRIMARY KEY,
+    title TEXT NOT NULL,
+    description TEXT,
+    price_minor INTEGER NOT NULL CHECK(price_minor >= 0),
+    currency TEXT NOT NULL,
+    condition TEXT CHECK(condition IN ('new', 'used', 'refurbished')),
+    stock INTEGER DEFAULT 0,
+    seller_id TEXT NOT NULL REFERENCES sellers(id),
+    category TEXT,
+    category_id INTEGER REFERENCES categories(id),
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    deleted_at DATETIME,
+    rating_1 INTEGER NOT NULL DEFAULT 0,
+    rating_2 INTEGER NOT NULL DEFAULT 0,
+    rating_3 INTEGER NOT NULL DEFAULT 0,
+    rating_4 INTEGER NOT NULL DEFAULT 0,
+    rating_5 INTEGER NOT NULL DEFAULT 0
+);
+
+INSERT INTO products_new (id, title, description, price_minor, currency, condition, stock, seller_id, category, category_id, created_at, updated_at, deleted_at, rating_1, rating_2, rating_3, rating_4, rating_5)
+SELECT id, title, description,
+    CAST(round(price * CASE
+        WHEN currency IN ('BIF', 'CLP', 'DJF', 'GNF', 'ISK', 'JPY', 'KMF', 'KRW', 'PYG', 'RWF', 'UGX', 'UYI', 'VND', 'VUV', 'XAF', 'XOF', 'XPF') THEN 1
+        WHEN currency IN ('BHD', 'IQD', 'JOD', 'KWD', 'LYD', 'OMR', 'TND') THEN 1000
+        WHEN currency IN ('CLF', 'UYW') THEN 10000
+        ELSE 100
+    END) AS INTEGER),
+    currency, condition, stock, seller_id, category, category_id, created_at, updated_at, deleted_at, rating_1, rating_2, rating_3, rating_4, rating_5
+FROM products;
+
+DROP TABLE products;
+
+ALTER TABLE products_new RENAME TO products;
+
+CREATE INDEX idx_products_deleted_at ON products(deleted_at);
+CREATE INDEX idx_products_category ON products(category);
+CREATE INDEX idx_products_seller_id ON products(seller_id);
+CREATE INDEX idx_products_category_id ON products(category_id);
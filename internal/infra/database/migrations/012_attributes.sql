@@ -0,0 +1,43 @@
+-- The attributes a category allows. The products of a category may use the
+-- attributes of the category and of its ancestors; a subcategory overrides a
+-- definition of the same name.
+CREATE TABLE category_attributes (
+    category_id INTEGER NOT NULL REFERENCES categories(id),
+    name TEXT NOT NULL,
+    type TEXT NOT NULL DEFAULT 'string' CHECK(type IN ('string', 'number', 'boolean')),
+    required INTEGER NOT NULL DEFAULT 0 CHECK(required IN (0, 1)),
+    PRIMARY KEY (category_id, name)
+);
+
+-- Values are kept as text whatever the type of the attribute, so a filter
+-- compares them as text too.
+CREATE TABLE product_attributes (
+    product_id TEXT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    value TEXT NOT NULL,
+    PRIMARY KEY (product_id, name)
+);
+
+CREATE INDEX idx_product_attributes_name_value ON product_attributes(name, value COLLATE NOCASE);
+
+WITH definitions(path, name, type, required) AS (
+    VALUES
+        ('Electronics', 'brand', 'string', 0),
+        ('Electronics > Smartphones', 'storage', 'string', 1),
+        ('Electronics > Smartphones', 'color', 'string', 0),
+        ('Electronics > Smartphones', 'ram_gb', 'number', 0),
+        ('Electronics > Computers', 'ram_gb', 'number', 1),
+        ('Electronics > Computers', 'storage', 'string', 0),
+        ('Electronics > Computers', 'gpu', 'string', 0),
+        ('Electronics > Computer Accessories', 'wireless', 'boolean', 0),
+        ('Electronics > Computer Accessories', 'layout', 'string', 0),
+        ('Electronics > Audio', 'wireless', 'boolean', 0),
+        ('Electronics > Audio > Headphones', 'noise_cancelling', 'boolean', 0),
+        ('Fashion', 'brand', 'string', 0),
+        ('Fashion', 'color', 'string', 0),
+        ('Fashion > Shoes', 'size', 'number', 1)
+)
+INSERT INTO category_attributes (category_id, name, type, required)
+SELECT categories.id, definitions.name, definitions.type, definitions.required
+FROM definitions
+JOIN categories ON categories.path = definitions.path;
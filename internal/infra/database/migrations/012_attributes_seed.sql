@@ -0,0 +1,19 @@
+INSERT INTO product_attributes (product_id, name, value)
+VALUES
+    ('MLB001', 'brand', 'Apple'),
+    ('MLB001', 'storage', '256GB'),
+    ('MLB001', 'color', 'Titanium Blue'),
+    ('MLB001', 'ram_gb', '8'),
+    ('MLB002', 'brand', 'ASUS'),
+    ('MLB002', 'ram_gb', '16'),
+    ('MLB002', 'storage', '1TB'),
+    ('MLB002', 'gpu', 'NVIDIA RTX 4070'),
+    ('MLB003', 'brand', 'Keychron'),
+    ('MLB003', 'wireless', 'true'),
+    ('MLB003', 'layout', '75%'),
+    ('MLB004', 'brand', 'Nike'),
+    ('MLB004', 'color', 'White/Black/Red'),
+    ('MLB004', 'size', '10'),
+    ('MLB005', 'brand', 'Sony'),
+    ('MLB005', 'wireless', 'true'),
+    ('MLB005', 'noise_cancelling', 'true');
@@ -0,0 +1,29 @@
+-- Variations are the purchasable combinations of a product, each with its own
+-- price, in the currency of the product, stock and images. The price and
+-- stock of a product with variations are kept equal to its cheapest
+-- variation and to the stock of all of them.
+CREATE TABLE product_variations (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    product_id TEXT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    price_minor INTEGER NOT NULL CHECK(price_minor >= 0),
+    stock INTEGER NOT NULL DEFAULT 0 CHECK(stock >= 0),
+    display_order INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_product_variations_product_id ON product_variations(product_id, display_order);
+
+CREATE TABLE variation_attributes (
+    variation_id INTEGER NOT NULL REFERENCES product_variations(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    value TEXT NOT NULL,
+    PRIMARY KEY (variation_id, name)
+);
+
+CREATE INDEX idx_variation_attributes_name_value ON variation_attributes(name, value COLLATE NOCASE);
+
+CREATE TABLE variation_images (
+    variation_id INTEGER NOT NULL REFERENCES product_variations(id) ON DELETE CASCADE,
+    image_url TEXT NOT NULL,
+    display_order INTEGER NOT NULL,
+    PRIMARY KEY (variation_id, display_order)
+);
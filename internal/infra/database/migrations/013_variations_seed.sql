@@ -0,0 +1,28 @@
+-- The color and storage of MLB001 become the attributes of its variations.
+DELETE FROM product_attributes WHERE product_id = 'MLB001' AND name IN ('color', 'storage');
+
+INSERT INTO product_variations (id, product_id, price_minor, stock, display_order)
+VALUES
+    (1, 'MLB001', 129999, 20, 0),
+    (2, 'MLB001', 149999, 15, 1),
+    (3, 'MLB001', 129999, 10, 2);
+
+INSERT INTO variation_attributes (variation_id, name, value)
+VALUES
+    (1, 'color', 'Titanium Blue'),
+    (1, 'storage', '256GB'),
+    (2, 'color', 'Titanium Blue'),
+    (2, 'storage', '512GB'),
+    (3, 'color', 'Natural Titanium'),
+    (3, 'storage', '256GB');
+
+INSERT INTO variation_images (variation_id, image_url, display_order)
+VALUES
+    (1, 'https://images.unsplash.com/photo-1696446702230-a8ff49103cd1?w=800', 0),
+    (2, 'https://images.unsplash.com/photo-1696446702230-a8ff49103cd1?w=800', 0),
+    (3, 'https://images.unsplash.com/photo-1695048133142-1a20484d2569?w=800', 0);
+
+UPDATE products
+SET price_minor = (SELECT min(price_minor) FROM product_variations WHERE product_id = products.id),
+    stock = (SELECT sum(stock) FROM product_variations WHERE product_id = products.id)
+WHERE id IN (SELECT product_id FROM product_variations);
@@ -0,0 +1,41 @@
+-- The shipping profile of a product: the weight and dimensions of its
+-- package, whether the seller pays for the shipping and the zip code it
+-- ships from. Zip codes are kept as their 8 digits.
+ALTER TABLE products ADD COLUMN weight_grams INTEGER NOT NULL DEFAULT 0 CHECK(weight_grams >= 0);
+ALTER TABLE products ADD COLUMN length_cm INTEGER NOT NULL DEFAULT 0 CHECK(length_cm >= 0);
+ALTER TABLE products ADD COLUMN width_cm INTEGER NOT NULL DEFAULT 0 CHECK(width_cm >= 0);
+ALTER TABLE products ADD COLUMN height_cm INTEGER NOT NULL DEFAULT 0 CHECK(height_cm >= 0);
+ALTER TABLE products ADD COLUMN free_shipping INTEGER NOT NULL DEFAULT 0 CHECK(free_shipping IN (0, 1));
+ALTER TABLE products ADD COLUMN origin_zip TEXT NOT NULL DEFAULT '';
+
+-- A rate applies to the zip codes between the bounds of its origin and
+-- destination ranges, bounds included. When several rates apply, the one with
+-- the narrowest ranges wins, so the last rate is the fallback for any route.
+-- Costs are in minor units of currency.
+CREATE TABLE shipping_rates (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    origin_zip_from TEXT NOT NULL CHECK(length(origin_zip_from) = 8),
+    origin_zip_to TEXT NOT NULL CHECK(length(origin_zip_to) = 8),
+    destination_zip_from TEXT NOT NULL CHECK(length(destination_zip_from) = 8),
+    destination_zip_to TEXT NOT NULL CHECK(length(destination_zip_to) = 8),
+    base_cost_minor INTEGER NOT NULL CHECK(base_cost_minor >= 0),
+    cost_per_kg_minor INTEGER NOT NULL CHECK(cost_per_kg_minor >= 0),
+    currency TEXT NOT NULL CHECK(length(currency) = 3),
+    min_days INTEGER NOT NULL CHECK(min_days >= 0),
+    max_days INTEGER NOT NULL CHECK(max_days >= min_days)
+);
+
+INSERT INTO shipping_rates (origin_zip_from, origin_zip_to, destination_zip_from, destination_zip_to, base_cost_minor, cost_per_kg_minor, currency, min_days, max_days)
+VALUES
+    -- Within the city of São Paulo.
+    ('01000000', '05999999', '01000000', '05999999', 490, 100, 'USD', 1, 2),
+    -- Within the state of São Paulo.
+    ('01000000', '19999999', '01000000', '19999999', 790, 150, 'USD', 2, 3),
+    -- From São Paulo to Rio de Janeiro, Espírito Santo and Minas Gerais.
+    ('01000000', '19999999', '20000000', '39999999', 1290, 250, 'USD', 3, 5),
+    -- From São Paulo to the South.
+    ('01000000', '19999999', '80000000', '99999999', 1490, 290, 'USD', 3, 6),
+    -- Within Rio de Janeiro, Espírito Santo and Minas Gerais.
+    ('20000000', '39999999', '20000000', '39999999', 890, 180, 'USD', 2, 4),
+    -- Anywhere else.
+    ('00000000', '99999999', '00000000', '99999999', 2490, 490, 'USD', 6, 10);
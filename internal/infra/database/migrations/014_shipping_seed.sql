@@ -0,0 +1,5 @@
+UPDATE products SET weight_grams = 240, length_cm = 18, width_cm = 10, height_cm = 6, free_shipping = 1, origin_zip = '01310100' WHERE id = 'MLB001';
+UPDATE products SET weight_grams = 2600, length_cm = 45, width_cm = 32, height_cm = 8, free_shipping = 1, origin_zip = '04538132' WHERE id = 'MLB002';
+UPDATE products SET weight_grams = 900, length_cm = 38, width_cm = 16, height_cm = 6, free_shipping = 0, origin_zip = '22250040' WHERE id = 'MLB003';
+UPDATE products SET weight_grams = 1100, length_cm = 34, width_cm = 22, height_cm = 13, free_shipping = 0, origin_zip = '80010000' WHERE id = 'MLB004';
+UPDATE products SET weight_grams = 500, length_cm = 24, width_cm = 20, height_cm = 10, free_shipping = 0, origin_zip = '01310100' WHERE id = 'MLB005';
@@ -0,0 +1,27 @@
+-- The installment plans offered for prices in each currency. The interest is
+-- monthly, in basis points (199 is 1.99% a month), and 0 for a plan without
+-- interest. A plan is offered only when each installment is at least the
+-- minimum, in minor units of currency.
+CREATE TABLE installment_plans (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    currency TEXT NOT NULL CHECK(length(currency) = 3),
+    installments INTEGER NOT NULL CHECK(installments >= 1),
+    interest_rate_bp INTEGER NOT NULL DEFAULT 0 CHECK(interest_rate_bp >= 0),
+    min_installment_minor INTEGER NOT NULL DEFAULT 0 CHECK(min_installment_minor >= 0),
+    UNIQUE(currency, installments)
+);
+
+INSERT INTO installment_plans (currency, installments, interest_rate_bp, min_installment_minor)
+VALUES
+    ('USD', 1, 0, 0),
+    ('USD', 3, 0, 1000),
+    ('USD', 6, 0, 2000),
+    ('USD', 12, 199, 2000),
+    ('USD', 18, 249, 2000),
+    ('BRL', 1, 0, 0),
+    ('BRL', 3, 0, 500),
+    ('BRL', 6, 0, 500),
+    ('BRL', 10, 0, 500),
+    ('BRL', 12, 0, 500),
+    ('BRL', 18, 199, 500),
+    ('BRL', 24, 249, 500);
@@ -0,0 +1,22 @@
+-- A promotion discounts one product, or every product of a category and its
+-- subcategories, from starts_at until just before ends_at. A percentage
+-- promotion takes percent_off_bp basis points off (1500 is 15%); a fixed one
+-- takes amount_off_minor minor units of currency off. Among the active
+-- promotions of a product, the highest priority wins.
+CREATE TABLE promotions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    product_id TEXT REFERENCES products(id),
+    category_id INTEGER REFERENCES categories(id),
+    type TEXT NOT NULL CHECK(type IN ('percentage', 'fixed')),
+    percent_off_bp INTEGER NOT NULL DEFAULT 0 CHECK(percent_off_bp >= 0 AND percent_off_bp < 10000),
+    amount_off_minor INTEGER NOT NULL DEFAULT 0 CHECK(amount_off_minor >= 0),
+    currency TEXT NOT NULL DEFAULT '',
+    priority INTEGER NOT NULL DEFAULT 0 CHECK(priority >= 0),
+    starts_at DATETIME NOT NULL,
+    ends_at DATETIME NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    CHECK((product_id IS NULL) != (category_id IS NULL))
+);
+
+CREATE INDEX idx_promotions_product_id ON promotions(product_id);
+CREATE INDEX idx_promotions_category_id ON promotions(category_id);
@@ -0,0 +1,27 @@
+-- A listing is active, paused, closed or under_review, and only active
+-- listings are shown to buyers. Every status transition is recorded with who
+-- made it: an admin, the seller actor_id or the system, which pauses
+-- listings that run out of stock.
+ALTER TABLE products ADD COLUMN status TEXT NOT NULL DEFAULT 'active'
+    CHECK(status IN ('active', 'paused', 'closed', 'under_review'));
+
+CREATE INDEX idx_products_status ON products(status);
+
+CREATE TABLE product_status_changes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    product_id TEXT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    from_status TEXT NOT NULL,
+    to_status TEXT NOT NULL,
+    actor TEXT NOT NULL CHECK(actor IN ('admin', 'seller', 'system')),
+    actor_id TEXT NOT NULL DEFAULT '',
+    reason TEXT NOT NULL DEFAULT '',
+    created_at DATETIME NOT NULL
+);
+
+CREATE INDEX idx_product_status_changes_product_id ON product_status_changes(product_id, id);
+
+INSERT INTO product_status_changes (product_id, from_status, to_status, actor, reason, created_at)
+SELECT id, 'active', 'paused', 'system', 'out of stock', CURRENT_TIMESTAMP
+FROM products WHERE stock = 0 AND deleted_at IS NULL;
+
+UPDATE products SET status = 'paused' WHERE stock = 0 AND deleted_at IS NULL;
@@ -0,0 +1,52 @@
+-- A listing can be published at publish_at and closes at expires_at. Until
+-- publish_at it is scheduled, a status the CHECK of 017_product_status.sql
+-- does not allow; SQLite cannot change a constraint, so products is rebuilt
+-- as in 011_money.sql.
+CREATE TABLE products_new (
+    id TEXT PRIMARY KEY,
+    title TEXT NOT NULL,
+    description TEXT,
+    price_minor INTEGER NOT NULL CHECK(price_minor >= 0),
+    currency TEXT NOT NULL,
+    condition TEXT CHECK(condition IN ('new', 'used', 'refurbished')),
+    stock INTEGER DEFAULT 0,
+    seller_id TEXT NOT NULL REFERENCES sellers(id),
+    category TEXT,
+    category_id INTEGER REFERENCES categories(id),
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    deleted_at DATETIME,
+    rating_1 INTEGER NOT NULL DEFAULT 0,
+    rating_2 INTEGER NOT NULL DEFAULT 0,
+    rating_3 INTEGER NOT NULL DEFAULT 0,
+    rating_4 INTEGER NOT NULL DEFAULT 0,
+    rating_5 INTEGER NOT NULL DEFAULT 0,
+    weight_grams INTEGER NOT NULL DEFAULT 0 CHECK(weight_grams >= 0),
+    length_cm INTEGER NOT NULL DEFAULT 0 CHECK(length_cm >= 0),
+    width_cm INTEGER NOT NULL DEFAULT 0 CHECK(width_cm >= 0),
+    height_cm INTEGER NOT NULL DEFAULT 0 CHECK(height_cm >= 0),
+    free_shipping INTEGER NOT NULL DEFAULT 0 CHECK(free_shipping IN (0, 1)),
+    origin_zip TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'active'
+        CHECK(status IN ('active', 'paused', 'closed', 'under_review', 'scheduled')),
+    publish_at DATETIME,
+    expires_at DATETIME
+);
+
+INSERT INTO products_new (id, title, description, price_minor, currency, condition, stock, seller_id, category, category_id, created_at, updated_at, deleted_at, rating_1, rating_2, rating_3, rating_4, rating_5, weight_grams, length_cm, width_cm, height_cm, free_shipping, origin_zip, status)
+SELECT id, title, description, price_minor, currency, condition, stock, seller_id, category, category_id, created_at, updated_at, deleted_at, rating_1, rating_2, rating_3, rating_4, rating_5, weight_grams, length_cm, width_cm, height_cm, free_shipping, origin_zip, status
+FROM products;
+
+DROP TABLE products;
+
+ALTER TABLE products_new RENAME TO products;
+
+CREATE INDEX idx_products_deleted_at ON products(deleted_at);
+CREATE INDEX idx_products_category ON products(category);
+CREATE INDEX idx_products_seller_id ON products(seller_id);
+CREATE INDEX idx_products_category_id ON products(category_id);
+CREATE INDEX idx_products_status ON products(status);
+
+-- The scheduler looks up the listings whose window opened or closed.
+CREATE INDEX idx_products_publish_at ON products(publish_at) WHERE publish_at IS NOT NULL;
+CREATE INDEX idx_products_expires_at ON products(expires_at) WHERE expires_at IS NOT NULL;
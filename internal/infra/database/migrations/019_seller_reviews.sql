@@ -0,0 +1,11 @@
+-- Reviews of the products of a seller count towards its reputation. The
+-- writes of reviews, and of products that change seller or are purged,
+-- recount these columns from the per-star counters of the products.
+ALTER TABLE sellers ADD COLUMN review_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE sellers ADD COLUMN review_stars INTEGER NOT NULL DEFAULT 0;
+
+UPDATE sellers SET
+    review_count = (SELECT coalesce(sum(rating_1 + rating_2 + rating_3 + rating_4 + rating_5), 0)
+        FROM products WHERE seller_id = sellers.id),
+    review_stars = (SELECT coalesce(sum(rating_1 + 2 * rating_2 + 3 * rating_3 + 4 * rating_4 + 5 * rating_5), 0)
+        FROM products WHERE seller_id = sellers.id);
@@ -0,0 +1,32 @@
+-- products_fts is the full-text index of products, sharing their rowid, and
+-- the triggers keep it in sync with every write. The index is filled once
+-- here, from the products of the migrations before it; a database whose
+-- index was created before this migration is rebuilt too. A later migration
+-- that rebuilds products drops these triggers and renumbers the rowids, so
+-- it needs a migration here that recreates them and rebuilds the index.
+CREATE VIRTUAL TABLE IF NOT EXISTS products_fts USING fts5(
+    title,
+    description,
+    category,
+    tokenize = 'unicode61 remove_diacritics 2'
+);
+
+CREATE TRIGGER IF NOT EXISTS products_fts_after_insert AFTER INSERT ON products BEGIN
+    INSERT INTO products_fts(rowid, title, description, category)
+    VALUES (new.rowid, new.title, coalesce(new.description, ''), coalesce(new.category, ''));
+END;
+
+CREATE TRIGGER IF NOT EXISTS products_fts_after_update AFTER UPDATE OF title, description, category ON products BEGIN
+    DELETE FROM products_fts WHERE rowid = old.rowid;
+    INSERT INTO products_fts(rowid, title, description, category)
+    VALUES (new.rowid, new.title, coalesce(new.description, ''), coalesce(new.category, ''));
+END;
+
+CREATE TRIGGER IF NOT EXISTS products_fts_after_delete AFTER DELETE ON products BEGIN
+    DELETE FROM products_fts WHERE rowid = old.rowid;
+END;
+
+DELETE FROM products_fts;
+
+INSERT INTO products_fts(rowid, title, description, category)
+SELECT rowid, title, coalesce(description, ''), coalesce(category, '') FROM products;
@@ -1,6 +1,6 @@
 package migrations
 
-import "embed"
-
-//go:embed *.sql
-var FS embed.FS
+// Search is the directory of the migrations of the full-text search index,
+// which FS only holds in builds with the sqlite_fts5 tag. They are numbered
+// in sequence with the other migrations.
+const Search = "fts5"
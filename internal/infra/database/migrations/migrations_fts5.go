@@ -0,0 +1,10 @@
+//go:build sqlite_fts5
+
+package migrations
+
+import "embed"
+
+// FS holds the migrations, those of the search index included.
+//
+//go:embed *.sql fts5/*.sql
+var FS embed.FS
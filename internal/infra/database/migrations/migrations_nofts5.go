@@ -0,0 +1,11 @@
+//go:build !sqlite_fts5
+
+package migrations
+
+import "embed"
+
+// FS holds the migrations. Without the sqlite_fts5 build tag the SQLite
+// driver has no FTS5 module, so the migrations in Search are left out.
+//
+//go:embed *.sql
+var FS embed.FS
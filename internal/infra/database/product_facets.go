@@ -0,0 +1,100 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"project/internal/errors"
+	"project/internal/repository"
+	"strings"
+)
+
+func (p *ProductRepository) CountProductFacets(ctx context.Context, query repository.ProductFacetQuery) (*repository.ProductFacetCounts, error) {
+	source, args, err := facetSource(query)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := &repository.ProductFacetCounts{
+		Conditions:  []repository.FacetCount{},
+		Categories:  []repository.FacetCount{},
+		Sellers:     []repository.FacetCount{},
+		PriceRanges: make([]int, len(query.PriceBreaks)+1),
+	}
+
+	facets := []struct {
+		target  *[]repository.FacetCount
+		columns string
+	}{
+		{&counts.Conditions, "coalesce(p.condition, '') AS value, '' AS label"},
+		{&counts.Categories, "coalesce(p.category, '') AS value, '' AS label"},
+		{&counts.Sellers, "p.seller_id AS value, max(s.name) AS label"},
+	}
+
+	for _, facet := range facets {
+		statement := "SELECT " + facet.columns + ", count(*) AS count" + source + " GROUP BY value ORDER BY count DESC, value ASC"
+		if err := p.DB.SelectContext(ctx, facet.target, statement, args...); err != nil {
+			return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+		}
+	}
+
+	var priceRanges []struct {
+		Bucket int `db:"bucket"`
+		Count  int `db:"count"`
+	}
+	price, priceArgs := priceInColumn(query.PriceCurrency)
+	bucket, bucketArgs := priceBucketExpression(query.PriceBreaks)
+	statement := "SELECT " + bucket + " AS bucket, count(*) AS count FROM (SELECT " + price + " AS price" + source + ") GROUP BY bucket"
+	statementArgs := append(append(bucketArgs, priceArgs...), args...)
+	if err := p.DB.SelectContext(ctx, &priceRanges, statement, statementArgs...); err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+	for _, priceRange := range priceRanges {
+		// Prices without an exchange rate fall in no range.
+		if priceRange.Bucket < 0 {
+			continue
+		}
+		counts.PriceRanges[priceRange.Bucket] = priceRange.Count
+	}
+
+	return counts, nil
+}
+
+// facetSource renders the FROM and WHERE clauses selecting the products the
+// facets are counted over.
+func facetSource(query repository.ProductFacetQuery) (string, []any, error) {
+	conditions, args := filterConditions(query.ProductFilter)
+	source := " FROM products p" + sellerJoin
+
+	if query.Text != "" {
+		join, condition, searchArgs, err := searchCondition(query.Text)
+		if err != nil {
+			return "", nil, err
+		}
+		source += " " + join
+		conditions = append([]string{condition}, conditions...)
+		args = append(searchArgs, args...)
+	}
+
+	if len(conditions) > 0 {
+		source += " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	return source, args, nil
+}
+
+// priceBucketExpression numbers the price range of the price column: 0 below
+// the first break, len(breaks) at or above the last one, and -1 for a price
+// that could not be converted.
+func priceBucketExpression(breaks []float64) (string, []any) {
+	var expression strings.Builder
+	args := make([]any, 0, len(breaks))
+
+	expression.WriteString("CASE WHEN price IS NULL THEN -1")
+	for i, upper := range breaks {
+		fmt.Fprintf(&expression, " WHEN price < ? THEN %d", i)
+		args = append(args, upper)
+	}
+	fmt.Fprintf(&expression, " ELSE %d END", len(breaks))
+
+	return expression.String(), args
+}
@@ -0,0 +1,230 @@
+package database
+
+import (
+	"fmt"
+	"math"
+	"project/internal/entity"
+	"project/internal/repository"
+	"sort"
+	"strings"
+	"time"
+)
+
+// productColumns selects the columns of product p, reading the price and its
+// currency into entity.Product.Price, its shipping profile into
+// entity.Product.Shipping and its attributes, aggregated into a JSON object,
+// into entity.Product.Attributes.
+const productColumns = `p.id, p.title, p.description,
+            p.price_minor AS "price.amount", p.currency AS "price.currency",
+            p.condition, p.stock, p.status, p.publish_at, p.expires_at, p.seller_id, p.category, p.category_id,
+            p.weight_grams AS "shipping.weight_grams", p.length_cm AS "shipping.length_cm",
+            p.width_cm AS "shipping.width_cm", p.height_cm AS "shipping.height_cm",
+            p.free_shipping AS "shipping.free_shipping", p.origin_zip AS "shipping.origin_zip",
+            p.created_at, p.updated_at, p.deleted_at,
+            p.rating_1, p.rating_2, p.rating_3, p.rating_4, p.rating_5,
+            (SELECT json_group_object(name, value) FROM product_attributes
+             WHERE product_id = p.id) AS attributes`
+
+// priceColumn is the price of product p in major units of its own currency.
+// The exact minor units divided by the scale of the currency give the float64
+// nearest to the price, as entity.Money.Float64. Price filters and facets
+// compare it through priceInColumn.
+var priceColumn = "(p.price_minor * 1.0 / " + currencyScale("p.currency") + ")"
+
+// priceInColumn renders the price of product p in major units of currency,
+// empty being entity.BaseCurrency. A price in another currency is converted
+// by the exchange rates and rounded to the minor unit of currency, as
+// entity.ConvertPrice does, and is NULL when either currency has no rate.
+func priceInColumn(currency string) (string, []any) {
+	if currency == "" {
+		currency = entity.BaseCurrency
+	}
+	decimals, ok := entity.CurrencyDecimals(currency)
+	if !ok {
+		decimals = 2
+	}
+
+	expression := fmt.Sprintf("(CASE WHEN p.currency = ? THEN %s"+
+		" ELSE round(%s / (SELECT rate FROM exchange_rates WHERE currency = p.currency)"+
+		" * (SELECT rate FROM exchange_rates WHERE currency = ?), %d) END)", priceColumn, priceColumn, decimals)
+
+	return expression, []any{currency, currency}
+}
+
+// currencyScale renders the number of minor units in a major unit of the
+// currency in column, from entity.CurrencyDecimals. Currency codes are three
+// uppercase letters, so they are safe to inline.
+func currencyScale(column string) string {
+	codesByDecimals := map[int][]string{}
+	for _, code := range entity.Currencies() {
+		if decimals, _ := entity.CurrencyDecimals(code); decimals != 2 {
+			codesByDecimals[decimals] = append(codesByDecimals[decimals], "'"+code+"'")
+		}
+	}
+
+	decimals := make([]int, 0, len(codesByDecimals))
+	for d := range codesByDecimals {
+		decimals = append(decimals, d)
+	}
+	sort.Ints(decimals)
+
+	var expression strings.Builder
+	expression.WriteString("CASE")
+	for _, d := range decimals {
+		fmt.Fprintf(&expression, " WHEN %s IN (%s) THEN %d", column, strings.Join(codesByDecimals[d], ", "), int64(math.Pow10(d)))
+	}
+	expression.WriteString(" ELSE 100 END")
+
+	return expression.String()
+}
+
+// listingStatusColumn is the status the publishing window gives product p at
+// the time bound to both placeholders, as entity.Product.ListingStatus.
+const listingStatusColumn = `(CASE
+            WHEN p.status <> 'closed' AND p.expires_at IS NOT NULL
+                AND julianday(p.expires_at) <= julianday(?) THEN 'closed'
+            WHEN p.status = 'scheduled'
+                AND (p.publish_at IS NULL OR julianday(p.publish_at) <= julianday(?))
+                THEN CASE WHEN p.stock > 0 THEN 'active' ELSE 'paused' END
+            ELSE p.status
+        END)`
+
+// listingStatusCondition matches the products p in status at at.
+func listingStatusCondition(status string, at time.Time) (string, []any) {
+	return listingStatusColumn + " = ?", []any{at, at, status}
+}
+
+// thumbnailColumn selects the first image of product p, or an empty string
+// for products without images.
+const thumbnailColumn = `
+            coalesce((SELECT image_url FROM product_images
+             WHERE product_id = p.id
+             ORDER BY display_order ASC
+             LIMIT 1), '') as thumbnail`
+
+// sellerJoin attaches the seller of product p as s, whose name and sales
+// and review counters are read through sellerColumns.
+const (
+	sellerJoin    = " JOIN sellers s ON s.id = p.seller_id"
+	sellerColumns = `s.name AS seller_name,
+            s.completed_sales AS "seller.completed_sales",
+            s.cancelled_sales AS "seller.cancelled_sales",
+            s.review_count AS "seller.review_count",
+            s.review_stars AS "seller.review_stars"`
+)
+
+const listProductsQuery = `
+        SELECT
+            ` + productColumns + `, ` + sellerColumns + `,` + thumbnailColumn + `
+        FROM products p` + sellerJoin + `
+    `
+
+// buildListProductsQuery renders query as SQL, keeping every value in a
+// placeholder.
+func buildListProductsQuery(query repository.ProductQuery) (string, []any) {
+	conditions, args := filterConditions(query.ProductFilter)
+	conditions = append([]string{"p.id > ?"}, conditions...)
+	args = append([]any{query.AfterID}, args...)
+
+	statement := listProductsQuery + " WHERE " + strings.Join(conditions, " AND ") + " ORDER BY p.id ASC LIMIT ?"
+	args = append(args, query.Limit)
+
+	return statement, args
+}
+
+// filterConditions renders filter as SQL conditions on the products table
+// aliased as p, with their placeholder arguments.
+func filterConditions(filter repository.ProductFilter) ([]string, []any) {
+	var conditions []string
+	var args []any
+
+	if !filter.IncludeDeleted {
+		conditions = append(conditions, "p.deleted_at IS NULL")
+	}
+
+	if filter.Status != "" {
+		condition, statusArgs := listingStatusCondition(filter.Status, filter.At)
+		conditions = append(conditions, condition)
+		args = append(args, statusArgs...)
+	}
+
+	if filter.CategoryPrefix != "" {
+		conditions = append(conditions, `(p.category = ? OR p.category LIKE ? ESCAPE '\')`)
+		args = append(args, filter.CategoryPrefix, escapeLike(filter.CategoryPrefix)+" > %")
+	}
+
+	if filter.Condition != "" {
+		conditions = append(conditions, "p.condition = ?")
+		args = append(args, filter.Condition)
+	}
+
+	if filter.SellerID != "" {
+		conditions = append(conditions, "p.seller_id = ?")
+		args = append(args, filter.SellerID)
+	}
+
+	if filter.MinPrice != nil {
+		price, priceArgs := priceInColumn(filter.PriceCurrency)
+		conditions = append(conditions, price+" >= ?")
+		args = append(append(args, priceArgs...), *filter.MinPrice)
+	}
+
+	if filter.MaxPrice != nil {
+		price, priceArgs := priceInColumn(filter.PriceCurrency)
+		conditions = append(conditions, price+" <= ?")
+		args = append(append(args, priceArgs...), *filter.MaxPrice)
+	}
+
+	if len(filter.Attributes) > 0 {
+		condition, attributeArgs := attributeCondition(filter.Attributes)
+		conditions = append(conditions, condition)
+		args = append(args, attributeArgs...)
+	}
+
+	if filter.InStock != nil {
+		if *filter.InStock {
+			conditions = append(conditions, "p.stock > 0")
+		} else {
+			conditions = append(conditions, "p.stock = 0")
+		}
+	}
+
+	return conditions, args
+}
+
+// attributeCondition matches the products having every attribute of
+// attributes, either as an attribute of their own or of one of their
+// variations. A single variation must match all the attributes the product
+// lacks, so that color and storage select a combination that is sold.
+// Attributes are matched in name order so that the same filter always renders
+// the same statement.
+func attributeCondition(attributes map[string]string) (string, []any) {
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	const productAttribute = "EXISTS (SELECT 1 FROM product_attributes pa WHERE pa.product_id = p.id AND pa.name = ? AND pa.value = ? COLLATE NOCASE)"
+	const variationAttribute = "EXISTS (SELECT 1 FROM variation_attributes va WHERE va.variation_id = pv.id AND va.name = ? AND va.value = ? COLLATE NOCASE)"
+
+	var onProduct, onVariation []string
+	var productArgs, variationArgs []any
+	for _, name := range names {
+		onProduct = append(onProduct, productAttribute)
+		productArgs = append(productArgs, name, attributes[name])
+
+		onVariation = append(onVariation, "("+productAttribute+" OR "+variationAttribute+")")
+		variationArgs = append(variationArgs, name, attributes[name], name, attributes[name])
+	}
+
+	condition := "((" + strings.Join(onProduct, " AND ") + ")" +
+		" OR EXISTS (SELECT 1 FROM product_variations pv WHERE pv.product_id = p.id AND " + strings.Join(onVariation, " AND ") + "))"
+
+	return condition, append(productArgs, variationArgs...)
+}
+
+// escapeLike escapes the LIKE wildcards in value so it matches literally.
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
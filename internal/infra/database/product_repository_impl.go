@@ -3,11 +3,15 @@ package database
 import (
 	"context"
 	"database/sql"
+	stdErrors "errors"
 	"fmt"
 	"project/internal/entity"
 	"project/internal/errors"
+	"project/internal/repository"
+	"time"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/mattn/go-sqlite3"
 )
 
 type ProductRepository struct {
@@ -20,20 +24,12 @@ func NewProductRepository(db *sqlx.DB) *ProductRepository {
 	}
 }
 
-func (p *ProductRepository) ListProducts(ctx context.Context) ([]entity.Product, error) {
+func (p *ProductRepository) ListProducts(ctx context.Context, query repository.ProductQuery) ([]entity.Product, error) {
 	products := []entity.Product{}
 
-	query := `
-        SELECT
-            p.*,
-            (SELECT image_url FROM product_images
-             WHERE product_id = p.id
-             ORDER BY display_order ASC
-             LIMIT 1) as thumbnail
-        FROM products p
-    `
+	statement, args := buildListProductsQuery(query)
 
-	err := p.DB.SelectContext(ctx, &products, query)
+	err := p.DB.SelectContext(ctx, &products, statement, args...)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
 	}
@@ -41,10 +37,13 @@ func (p *ProductRepository) ListProducts(ctx context.Context) ([]entity.Product,
 	return products, nil
 }
 
-func (p *ProductRepository) GetProduct(ctx context.Context, id string) (*entity.Product, error) {
+func (p *ProductRepository) GetProduct(ctx context.Context, id string, includeDeleted bool) (*entity.Product, error) {
 	var product entity.Product
 
-	query := "SELECT * FROM products WHERE id = ?"
+	query := "SELECT " + productColumns + ", " + sellerColumns + " FROM products p" + sellerJoin + " WHERE p.id = ?"
+	if !includeDeleted {
+		query += " AND p.deleted_at IS NULL"
+	}
 
 	err := p.DB.GetContext(ctx, &product, query, id)
 	if err == sql.ErrNoRows {
@@ -57,6 +56,29 @@ func (p *ProductRepository) GetProduct(ctx context.Context, id string) (*entity.
 	return &product, nil
 }
 
+func (p *ProductRepository) GetProductsByIDs(ctx context.Context, ids []string, includeDeleted bool) ([]entity.Product, error) {
+	products := []entity.Product{}
+	if len(ids) == 0 {
+		return products, nil
+	}
+
+	query := "SELECT " + productColumns + ", " + sellerColumns + " FROM products p" + sellerJoin + " WHERE p.id IN (?)"
+	if !includeDeleted {
+		query += " AND p.deleted_at IS NULL"
+	}
+
+	query, args, err := sqlx.In(query, ids)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	if err := p.DB.SelectContext(ctx, &products, p.DB.Rebind(query), args...); err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return products, nil
+}
+
 func (p *ProductRepository) FindImagesByProductID(ctx context.Context, productID string) ([]entity.ProductImage, error) {
 	images := []entity.ProductImage{}
 
@@ -69,3 +91,493 @@ func (p *ProductRepository) FindImagesByProductID(ctx context.Context, productID
 
 	return images, nil
 }
+
+// variationsQuery selects variations with their attributes and images, to be
+// followed by the condition on v.product_id.
+const variationsQuery = `
+        SELECT v.id, v.product_id, v.price_minor AS "price.amount", p.currency AS "price.currency", v.stock,
+            (SELECT json_group_object(name, value) FROM variation_attributes
+             WHERE variation_id = v.id) AS attributes,
+            (SELECT json_group_array(image_url) FROM
+             (SELECT image_url FROM variation_images WHERE variation_id = v.id ORDER BY display_order ASC)) AS images
+        FROM product_variations v
+        JOIN products p ON p.id = v.product_id
+    `
+
+func (p *ProductRepository) FindVariationsByProductID(ctx context.Context, productID string) ([]entity.Variation, error) {
+	variations := []entity.Variation{}
+
+	query := variationsQuery + " WHERE v.product_id = ? ORDER BY v.display_order ASC, v.id ASC"
+
+	if err := p.DB.SelectContext(ctx, &variations, query, productID); err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return variations, nil
+}
+
+func (p *ProductRepository) FindVariationsByProductIDs(ctx context.Context, productIDs []string) (map[string][]entity.Variation, error) {
+	variationsByProduct := map[string][]entity.Variation{}
+	if len(productIDs) == 0 {
+		return variationsByProduct, nil
+	}
+
+	query, args, err := sqlx.In(variationsQuery+" WHERE v.product_id IN (?) ORDER BY v.product_id ASC, v.display_order ASC, v.id ASC", productIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	variations := []entity.Variation{}
+	if err := p.DB.SelectContext(ctx, &variations, p.DB.Rebind(query), args...); err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	for _, variation := range variations {
+		variationsByProduct[variation.ProductID] = append(variationsByProduct[variation.ProductID], variation)
+	}
+
+	return variationsByProduct, nil
+}
+
+func (p *ProductRepository) FindImagesByProductIDs(ctx context.Context, productIDs []string) (map[string][]entity.ProductImage, error) {
+	imagesByProduct := map[string][]entity.ProductImage{}
+	if len(productIDs) == 0 {
+		return imagesByProduct, nil
+	}
+
+	query, args, err := sqlx.In("SELECT * FROM product_images WHERE product_id IN (?) ORDER BY product_id ASC, display_order ASC", productIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	images := []entity.ProductImage{}
+	if err := p.DB.SelectContext(ctx, &images, p.DB.Rebind(query), args...); err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	for _, image := range images {
+		imagesByProduct[image.ProductID] = append(imagesByProduct[image.ProductID], image)
+	}
+
+	return imagesByProduct, nil
+}
+
+func (p *ProductRepository) CreateProduct(ctx context.Context, product *entity.Product, images []entity.ProductImage) error {
+	tx, err := p.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+	defer tx.Rollback()
+
+	if err := ensureSeller(ctx, tx, product); err != nil {
+		return err
+	}
+
+	if product.CategoryID, err = ensureCategory(ctx, tx, product.Category); err != nil {
+		return err
+	}
+
+	query := `
+        INSERT INTO products (id, title, description, price_minor, currency, condition, stock, status, publish_at, expires_at, seller_id, category, category_id,
+            weight_grams, length_cm, width_cm, height_cm, free_shipping, origin_zip, created_at, updated_at)
+        VALUES (:id, :title, :description, :price.amount, :price.currency, :condition, :stock, :status, :publish_at, :expires_at, :seller_id, :category, :category_id,
+            :shipping.weight_grams, :shipping.length_cm, :shipping.width_cm, :shipping.height_cm, :shipping.free_shipping, :shipping.origin_zip, :created_at, :updated_at)
+    `
+
+	if _, err := tx.NamedExecContext(ctx, query, product); err != nil {
+		if isUniqueViolation(err) {
+			return errors.ErrProductAlreadyExists
+		}
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	if err := insertImages(ctx, tx, images); err != nil {
+		return err
+	}
+
+	if err := replaceAttributes(ctx, tx, product); err != nil {
+		return err
+	}
+
+	if err := replaceVariations(ctx, tx, product); err != nil {
+		return err
+	}
+
+	if err := insertStatusChanges(ctx, tx, product); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return nil
+}
+
+func (p *ProductRepository) UpdateProduct(ctx context.Context, product *entity.Product, images []entity.ProductImage, expectedVersion time.Time) error {
+	tx, err := p.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+	defer tx.Rollback()
+
+	var current struct {
+		Version  time.Time `db:"updated_at"`
+		SellerID string    `db:"seller_id"`
+	}
+	err = tx.GetContext(ctx, &current, "SELECT updated_at, seller_id FROM products WHERE id = ? AND deleted_at IS NULL", product.ID)
+	if err == sql.ErrNoRows {
+		return errors.ErrProductNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	if !current.Version.Equal(expectedVersion) {
+		return errors.ErrVersionConflict
+	}
+
+	if err := ensureSeller(ctx, tx, product); err != nil {
+		return err
+	}
+
+	if product.CategoryID, err = ensureCategory(ctx, tx, product.Category); err != nil {
+		return err
+	}
+
+	query := `
+        UPDATE products SET
+            title = :title,
+            description = :description,
+            price_minor = :price.amount,
+            currency = :price.currency,
+            condition = :condition,
+            stock = :stock,
+            status = :status,
+            publish_at = :publish_at,
+            expires_at = :expires_at,
+            seller_id = :seller_id,
+            category = :category,
+            category_id = :category_id,
+            weight_grams = :shipping.weight_grams,
+            length_cm = :shipping.length_cm,
+            width_cm = :shipping.width_cm,
+            height_cm = :shipping.height_cm,
+            free_shipping = :shipping.free_shipping,
+            origin_zip = :shipping.origin_zip,
+            updated_at = :updated_at
+        WHERE id = :id
+    `
+
+	if _, err := tx.NamedExecContext(ctx, query, product); err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	if images != nil {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM product_images WHERE product_id = ?", product.ID); err != nil {
+			return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+		}
+
+		if err := insertImages(ctx, tx, images); err != nil {
+			return err
+		}
+	}
+
+	if err := replaceAttributes(ctx, tx, product); err != nil {
+		return err
+	}
+
+	if err := replaceVariations(ctx, tx, product); err != nil {
+		return err
+	}
+
+	if err := insertStatusChanges(ctx, tx, product); err != nil {
+		return err
+	}
+
+	// The reviews of the product move to its new seller.
+	if product.SellerID != current.SellerID {
+		if err := recountSellerReviews(ctx, tx, current.SellerID, product.SellerID); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return nil
+}
+
+func (p *ProductRepository) UpdateProductStatus(ctx context.Context, product *entity.Product) error {
+	if len(product.StatusChanges) == 0 {
+		return nil
+	}
+
+	tx, err := p.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+	defer tx.Rollback()
+
+	query := "UPDATE products SET status = ?, updated_at = ? WHERE id = ? AND status = ? AND deleted_at IS NULL"
+
+	result, err := tx.ExecContext(ctx, query, product.Status, product.UpdatedAt, product.ID, product.StatusChanges[0].From)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+	if affected == 0 {
+		return errors.ErrInvalidStatusTransition
+	}
+
+	if err := insertStatusChanges(ctx, tx, product); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return nil
+}
+
+func (p *ProductRepository) ListStatusChanges(ctx context.Context, productID string) ([]entity.StatusChange, error) {
+	changes := []entity.StatusChange{}
+
+	query := "SELECT * FROM product_status_changes WHERE product_id = ? ORDER BY id ASC"
+
+	if err := p.DB.SelectContext(ctx, &changes, query, productID); err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return changes, nil
+}
+
+func (p *ProductRepository) ListScheduleDue(ctx context.Context, at time.Time) ([]entity.Product, error) {
+	products := []entity.Product{}
+
+	query := "SELECT " + productColumns + ", " + sellerColumns + " FROM products p" + sellerJoin + `
+        WHERE p.deleted_at IS NULL AND (p.status = 'scheduled' OR p.expires_at IS NOT NULL)
+            AND ` + listingStatusColumn + ` <> p.status
+        ORDER BY p.id ASC`
+
+	if err := p.DB.SelectContext(ctx, &products, query, at, at); err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return products, nil
+}
+
+func (p *ProductRepository) SoftDeleteProduct(ctx context.Context, id string, deletedAt time.Time) error {
+	query := "UPDATE products SET deleted_at = ?, updated_at = ? WHERE id = ? AND deleted_at IS NULL"
+
+	result, err := p.DB.ExecContext(ctx, query, deletedAt, deletedAt, id)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return requireAffectedRow(result)
+}
+
+func (p *ProductRepository) RestoreProduct(ctx context.Context, id string, restoredAt time.Time) error {
+	query := "UPDATE products SET deleted_at = NULL, updated_at = ? WHERE id = ? AND deleted_at IS NOT NULL"
+
+	result, err := p.DB.ExecContext(ctx, query, restoredAt, id)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return requireAffectedRow(result)
+}
+
+func (p *ProductRepository) PurgeDeletedProducts(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	tx, err := p.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+	defer tx.Rollback()
+
+	// deleted_at is compared as a julian day number because the stored text
+	// mixes timestamp layouts that do not sort lexically.
+	purgeable := "SELECT id FROM products WHERE deleted_at IS NOT NULL AND julianday(deleted_at) < julianday(?)"
+
+	// Child rows are removed explicitly so the purge does not depend on the
+	// foreign_keys pragma being enabled for ON DELETE CASCADE.
+	purgeableVariations := "SELECT id FROM product_variations WHERE product_id IN (" + purgeable + ")"
+	for _, child := range []string{"variation_attributes", "variation_images"} {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM "+child+" WHERE variation_id IN ("+purgeableVariations+")", deletedBefore); err != nil {
+			return 0, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+		}
+	}
+
+	for _, child := range []string{"product_images", "product_attributes", "product_variations", "reviews", "questions", "promotions", "product_status_changes"} {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM "+child+" WHERE product_id IN ("+purgeable+")", deletedBefore); err != nil {
+			return 0, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+		}
+	}
+
+	var sellerIDs []string
+	if err := tx.SelectContext(ctx, &sellerIDs, "SELECT DISTINCT seller_id FROM products WHERE id IN ("+purgeable+")", deletedBefore); err != nil {
+		return 0, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	result, err := tx.ExecContext(ctx, "DELETE FROM products WHERE id IN ("+purgeable+")", deletedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	// The reviews of the purged products no longer count for their sellers.
+	if len(sellerIDs) > 0 {
+		if err := recountSellerReviews(ctx, tx, sellerIDs...); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return purged, nil
+}
+
+// requireAffectedRow maps an UPDATE that matched no row to ErrProductNotFound.
+func requireAffectedRow(result sql.Result) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+	if affected == 0 {
+		return errors.ErrProductNotFound
+	}
+	return nil
+}
+
+// insertImages stores the images inside tx and fills in the IDs assigned by the database.
+func insertImages(ctx context.Context, tx *sqlx.Tx, images []entity.ProductImage) error {
+	query := "INSERT INTO product_images (product_id, image_url, display_order) VALUES (:product_id, :image_url, :display_order)"
+
+	for i := range images {
+		result, err := tx.NamedExecContext(ctx, query, images[i])
+		if err != nil {
+			return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+		}
+
+		id, err := result.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+		}
+		images[i].ID = int(id)
+	}
+
+	return nil
+}
+
+// replaceAttributes stores the attributes of product inside tx in place of
+// the ones it had.
+func replaceAttributes(ctx context.Context, tx *sqlx.Tx, product *entity.Product) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM product_attributes WHERE product_id = ?", product.ID); err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	for name, value := range product.Attributes {
+		_, err := tx.ExecContext(ctx, "INSERT INTO product_attributes (product_id, name, value) VALUES (?, ?, ?)", product.ID, name, value)
+		if err != nil {
+			return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+		}
+	}
+
+	return nil
+}
+
+// replaceVariations stores the variations of product inside tx in place of
+// the ones it had. A variation with an ID keeps it; the others get the ID
+// assigned by the database.
+func replaceVariations(ctx context.Context, tx *sqlx.Tx, product *entity.Product) error {
+	stored := "SELECT id FROM product_variations WHERE product_id = ?"
+	for _, statement := range []string{
+		"DELETE FROM variation_attributes WHERE variation_id IN (" + stored + ")",
+		"DELETE FROM variation_images WHERE variation_id IN (" + stored + ")",
+		"DELETE FROM product_variations WHERE product_id = ?",
+	} {
+		if _, err := tx.ExecContext(ctx, statement, product.ID); err != nil {
+			return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+		}
+	}
+
+	for order := range product.Variations {
+		variation := &product.Variations[order]
+
+		var id any
+		if variation.ID != 0 {
+			id = variation.ID
+		}
+
+		result, err := tx.ExecContext(ctx,
+			"INSERT INTO product_variations (id, product_id, price_minor, stock, display_order) VALUES (?, ?, ?, ?, ?)",
+			id, product.ID, variation.Price.Amount, variation.Stock, order)
+		if err != nil {
+			return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+		}
+
+		if variation.ID, err = result.LastInsertId(); err != nil {
+			return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+		}
+
+		for name, value := range variation.Attributes {
+			_, err := tx.ExecContext(ctx, "INSERT INTO variation_attributes (variation_id, name, value) VALUES (?, ?, ?)", variation.ID, name, value)
+			if err != nil {
+				return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+			}
+		}
+
+		for imageOrder, url := range variation.Images {
+			_, err := tx.ExecContext(ctx, "INSERT INTO variation_images (variation_id, image_url, display_order) VALUES (?, ?, ?)", variation.ID, url, imageOrder)
+			if err != nil {
+				return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// insertStatusChanges records the pending status changes of product inside
+// tx, filling in their IDs.
+func insertStatusChanges(ctx context.Context, tx *sqlx.Tx, product *entity.Product) error {
+	query := `
+        INSERT INTO product_status_changes (product_id, from_status, to_status, actor, actor_id, reason, created_at)
+        VALUES (:product_id, :from_status, :to_status, :actor, :actor_id, :reason, :created_at)
+    `
+
+	for i := range product.StatusChanges {
+		result, err := tx.NamedExecContext(ctx, query, product.StatusChanges[i])
+		if err != nil {
+			return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+		}
+
+		if product.StatusChanges[i].ID, err = result.LastInsertId(); err != nil {
+			return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+		}
+	}
+
+	return nil
+}
+
+func isUniqueViolation(err error) bool {
+	var sqliteErr sqlite3.Error
+	if !stdErrors.As(err, &sqliteErr) {
+		return false
+	}
+	return sqliteErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey ||
+		sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique
+}
@@ -0,0 +1,125 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type PromotionRepository struct {
+	DB *sqlx.DB
+}
+
+func NewPromotionRepository(db *sqlx.DB) *PromotionRepository {
+	return &PromotionRepository{
+		DB: db,
+	}
+}
+
+// promotionColumns reads a promotion, with its fixed amount as an
+// entity.Money.
+const promotionColumns = `id, product_id, category_id, type, percent_off_bp,
+    amount_off_minor AS "amount_off.amount", currency AS "amount_off.currency",
+    priority, starts_at, ends_at, created_at`
+
+// CreatePromotion looks for an overlapping promotion and inserts promotion
+// in one transaction. A database file begins it immediately, taking the write
+// lock, and an in-memory one has a single connection, so two concurrent
+// creates cannot both pass the check.
+func (r *PromotionRepository) CreatePromotion(ctx context.Context, promotion *entity.Promotion) error {
+	tx, err := r.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+	defer tx.Rollback()
+
+	var overlappingID int64
+	query, args := overlappingPromotionQuery(*promotion)
+	err = tx.GetContext(ctx, &overlappingID, query, args...)
+	if err == nil {
+		return fmt.Errorf("%w: overlaps promotion %d", errors.ErrPromotionConflict, overlappingID)
+	}
+	if err != sql.ErrNoRows {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	result, err := tx.NamedExecContext(ctx, `
+        INSERT INTO promotions (product_id, category_id, type, percent_off_bp, amount_off_minor, currency, priority, starts_at, ends_at, created_at)
+        VALUES (:product_id, :category_id, :type, :percent_off_bp, :amount_off.amount, :amount_off.currency, :priority, :starts_at, :ends_at, :created_at)
+    `, promotion)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	if promotion.ID, err = result.LastInsertId(); err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return nil
+}
+
+func (r *PromotionRepository) ListPromotions(ctx context.Context, query repository.PromotionQuery) ([]entity.Promotion, error) {
+	promotions := []entity.Promotion{}
+
+	// Times are compared as julian day numbers because the stored text does
+	// not sort lexically across timestamp layouts.
+	sqlQuery := "SELECT " + promotionColumns + " FROM promotions"
+	var args []any
+	if query.ActiveAt != nil {
+		sqlQuery += " WHERE julianday(starts_at) <= julianday(?) AND julianday(?) < julianday(ends_at)"
+		args = append(args, *query.ActiveAt, *query.ActiveAt)
+	}
+	sqlQuery += " ORDER BY id ASC"
+
+	if err := r.DB.SelectContext(ctx, &promotions, sqlQuery, args...); err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return promotions, nil
+}
+
+// overlappingPromotionQuery selects the ID of the first promotion for the
+// same product or category as promotion, with the same priority, that is
+// active at some time promotion is.
+func overlappingPromotionQuery(promotion entity.Promotion) (string, []any) {
+	query := `SELECT id FROM promotions
+        WHERE priority = ?
+            AND julianday(starts_at) < julianday(?) AND julianday(?) < julianday(ends_at)`
+	args := []any{promotion.Priority, promotion.EndsAt, promotion.StartsAt}
+	if promotion.ProductID != nil {
+		query += " AND product_id = ?"
+		args = append(args, *promotion.ProductID)
+	} else {
+		query += " AND category_id = ?"
+		args = append(args, promotion.CategoryID)
+	}
+	query += " ORDER BY id ASC LIMIT 1"
+
+	return query, args
+}
+
+func (r *PromotionRepository) DeletePromotion(ctx context.Context, id int64) error {
+	result, err := r.DB.ExecContext(ctx, "DELETE FROM promotions WHERE id = ?", id)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+	if affected == 0 {
+		return errors.ErrPromotionNotFound
+	}
+
+	return nil
+}
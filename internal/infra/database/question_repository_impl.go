@@ -0,0 +1,133 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type QuestionRepository struct {
+	DB *sqlx.DB
+}
+
+func NewQuestionRepository(db *sqlx.DB) *QuestionRepository {
+	return &QuestionRepository{
+		DB: db,
+	}
+}
+
+// createQuestionQuery inserts nothing when the product does not exist or is
+// deleted, which CreateQuestion reports as ErrProductNotFound.
+const createQuestionQuery = `
+        INSERT INTO questions (product_id, text, status, created_at)
+        SELECT ?, ?, ?, ?
+        WHERE EXISTS (SELECT 1 FROM products WHERE id = ? AND deleted_at IS NULL)
+    `
+
+func (r *QuestionRepository) CreateQuestion(ctx context.Context, question *entity.Question) error {
+	result, err := r.DB.ExecContext(ctx, createQuestionQuery,
+		question.ProductID, question.Text, question.Status, question.CreatedAt, question.ProductID,
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	if err := requireAffectedRow(result); err != nil {
+		return err
+	}
+
+	if question.ID, err = result.LastInsertId(); err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return nil
+}
+
+func (r *QuestionRepository) GetQuestion(ctx context.Context, productID string, id int64) (*entity.Question, error) {
+	var question entity.Question
+
+	err := r.DB.GetContext(ctx, &question, "SELECT * FROM questions WHERE id = ? AND product_id = ?", id, productID)
+	if err == sql.ErrNoRows {
+		return nil, errors.ErrQuestionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return &question, nil
+}
+
+func (r *QuestionRepository) ListQuestions(ctx context.Context, query repository.QuestionQuery) ([]entity.Question, error) {
+	questions := []entity.Question{}
+
+	statement := "SELECT * FROM questions WHERE product_id = ?"
+	args := []any{query.ProductID}
+
+	if len(query.Statuses) > 0 {
+		inStatement, inArgs, err := sqlx.In(" AND status IN (?)", query.Statuses)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+		}
+		statement += inStatement
+		args = append(args, inArgs...)
+	}
+
+	if query.BeforeID > 0 {
+		statement += " AND id < ?"
+		args = append(args, query.BeforeID)
+	}
+
+	statement += " ORDER BY id DESC LIMIT ?"
+	args = append(args, query.Limit)
+
+	if err := r.DB.SelectContext(ctx, &questions, statement, args...); err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return questions, nil
+}
+
+func (r *QuestionRepository) AnswerQuestion(ctx context.Context, question *entity.Question) error {
+	result, err := r.DB.NamedExecContext(ctx, `
+        UPDATE questions SET
+            status = :status,
+            answer = :answer,
+            answered_at = :answered_at
+        WHERE id = :id AND product_id = :product_id AND status = 'unanswered'
+    `, question)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+	if affected == 0 {
+		return errors.ErrQuestionAnswered
+	}
+
+	return nil
+}
+
+func (r *QuestionRepository) HideQuestion(ctx context.Context, productID string, id int64) error {
+	result, err := r.DB.ExecContext(ctx, "UPDATE questions SET status = 'hidden' WHERE id = ? AND product_id = ?", id, productID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+	if affected == 0 {
+		return errors.ErrQuestionNotFound
+	}
+
+	return nil
+}
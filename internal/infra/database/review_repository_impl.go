@@ -0,0 +1,100 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type ReviewRepository struct {
+	DB *sqlx.DB
+}
+
+func NewReviewRepository(db *sqlx.DB) *ReviewRepository {
+	return &ReviewRepository{
+		DB: db,
+	}
+}
+
+// addRatingQuery increments the counter of the stars given by a review. A
+// comparison is 1 when true and 0 otherwise, so only the matching counter
+// changes. updated_at is left alone: a review does not change the version
+// clients send back in If-Match.
+const addRatingQuery = `
+        UPDATE products SET
+            rating_1 = rating_1 + (:rating = 1),
+            rating_2 = rating_2 + (:rating = 2),
+            rating_3 = rating_3 + (:rating = 3),
+            rating_4 = rating_4 + (:rating = 4),
+            rating_5 = rating_5 + (:rating = 5)
+        WHERE id = :product_id AND deleted_at IS NULL
+    `
+
+func (r *ReviewRepository) CreateReview(ctx context.Context, review *entity.Review) error {
+	tx, err := r.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.NamedExecContext(ctx, addRatingQuery, review)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	if err := requireAffectedRow(result); err != nil {
+		return err
+	}
+
+	var sellerID string
+	if err := tx.GetContext(ctx, &sellerID, "SELECT seller_id FROM products WHERE id = ?", review.ProductID); err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	if err := recountSellerReviews(ctx, tx, sellerID); err != nil {
+		return err
+	}
+
+	result, err = tx.NamedExecContext(ctx,
+		"INSERT INTO reviews (product_id, rating, title, comment, created_at) VALUES (:product_id, :rating, :title, :comment, :created_at)",
+		review,
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	if review.ID, err = result.LastInsertId(); err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return nil
+}
+
+func (r *ReviewRepository) ListReviews(ctx context.Context, query repository.ReviewQuery) ([]entity.Review, error) {
+	reviews := []entity.Review{}
+
+	statement := "SELECT * FROM reviews WHERE product_id = ?"
+	args := []any{query.ProductID}
+
+	if query.BeforeID > 0 {
+		statement += " AND id < ?"
+		args = append(args, query.BeforeID)
+	}
+
+	statement += " ORDER BY id DESC LIMIT ?"
+	args = append(args, query.Limit)
+
+	if err := r.DB.SelectContext(ctx, &reviews, statement, args...); err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return reviews, nil
+}
@@ -0,0 +1,79 @@
+//go:build sqlite_fts5
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+	"strings"
+	"unicode"
+)
+
+// searchProductsQuery ranks matches with bm25, weighting title over category
+// over description.
+const searchProductsQuery = `
+        SELECT
+            ` + productColumns + `, ` + sellerColumns + `,` + thumbnailColumn + `,
+            highlight(products_fts, 0, '<em>', '</em>') as highlighted_title,
+            snippet(products_fts, -1, '<em>', '</em>', '…', 16) as snippet
+        FROM products_fts
+        JOIN products p ON p.rowid = products_fts.rowid` + sellerJoin + `
+    `
+
+func (p *ProductRepository) SearchProducts(ctx context.Context, query repository.ProductSearchQuery) ([]entity.ProductSearchResult, error) {
+	results := []entity.ProductSearchResult{}
+
+	match := matchExpression(query.Text)
+	if match == "" {
+		return results, nil
+	}
+
+	conditions, args := filterConditions(query.ProductFilter)
+	conditions = append([]string{"products_fts MATCH ?"}, conditions...)
+	args = append([]any{match}, args...)
+
+	statement := searchProductsQuery +
+		" WHERE " + strings.Join(conditions, " AND ") +
+		" ORDER BY bm25(products_fts, 10.0, 1.0, 5.0), p.id ASC LIMIT ? OFFSET ?"
+	args = append(args, query.Limit, query.Offset)
+
+	if err := p.DB.SelectContext(ctx, &results, statement, args...); err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return results, nil
+}
+
+// searchCondition restricts a query over products p to the full-text matches
+// of text, returning the join and condition to add to it.
+func searchCondition(text string) (string, string, []any, error) {
+	match := matchExpression(text)
+	if match == "" {
+		return "", "0", nil, nil
+	}
+
+	return "JOIN products_fts ON products_fts.rowid = p.rowid", "products_fts MATCH ?", []any{match}, nil
+}
+
+// matchExpression turns free text into an FTS5 query that requires every
+// word, the last one as a prefix so partially typed words still match. Words
+// are quoted, so the FTS5 query syntax cannot be injected.
+func matchExpression(text string) string {
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	if len(words) == 0 {
+		return ""
+	}
+
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		terms = append(terms, `"`+word+`"`)
+	}
+	terms[len(terms)-1] += "*"
+
+	return strings.Join(terms, " ")
+}
@@ -0,0 +1,20 @@
+//go:build !sqlite_fts5
+
+package database
+
+import (
+	"context"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+)
+
+// SearchProducts fails without the sqlite_fts5 build tag: the SQLite driver
+// is compiled without the FTS5 module, so search is disabled.
+func (p *ProductRepository) SearchProducts(ctx context.Context, query repository.ProductSearchQuery) ([]entity.ProductSearchResult, error) {
+	return nil, errors.ErrSearchUnavailable
+}
+
+func searchCondition(text string) (string, string, []any, error) {
+	return "", "", nil, errors.ErrSearchUnavailable
+}
@@ -0,0 +1,105 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"project/internal/entity"
+	"project/internal/errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type SellerRepository struct {
+	DB *sqlx.DB
+}
+
+func NewSellerRepository(db *sqlx.DB) *SellerRepository {
+	return &SellerRepository{
+		DB: db,
+	}
+}
+
+func (r *SellerRepository) GetSeller(ctx context.Context, id string) (*entity.Seller, error) {
+	var seller entity.Seller
+
+	err := r.DB.GetContext(ctx, &seller, "SELECT * FROM sellers WHERE id = ?", id)
+	if err == sql.ErrNoRows {
+		return nil, errors.ErrSellerNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return &seller, nil
+}
+
+func (r *SellerRepository) CountSellerProducts(ctx context.Context, id string, at time.Time) (int, error) {
+	var count int
+
+	active, args := listingStatusCondition(entity.ProductActive, at)
+	query := "SELECT count(*) FROM products p WHERE p.seller_id = ? AND p.deleted_at IS NULL AND " + active
+
+	err := r.DB.GetContext(ctx, &count, query, append([]any{id}, args...)...)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return count, nil
+}
+
+// ensureSeller makes sure the seller of product exists inside the
+// transaction that writes the product. A seller ID that is not known yet is
+// registered under product.SellerName; without a name it is rejected with
+// ErrSellerNotFound. The stored name always wins over product.SellerName, so
+// product writes never rename a seller, and the seller reputation is loaded
+// along with it.
+func ensureSeller(ctx context.Context, tx *sqlx.Tx, product *entity.Product) error {
+	if product.SellerName != "" {
+		_, err := tx.ExecContext(ctx,
+			"INSERT INTO sellers (id, name, created_at, updated_at) VALUES (?, ?, ?, ?) ON CONFLICT(id) DO NOTHING",
+			product.SellerID, product.SellerName, product.UpdatedAt, product.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+		}
+	}
+
+	var seller entity.Seller
+	err := tx.GetContext(ctx, &seller, "SELECT * FROM sellers WHERE id = ?", product.SellerID)
+	if err == sql.ErrNoRows {
+		return errors.ErrSellerNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	product.SellerName = seller.Name
+	product.SellerReputation = seller.SellerReputation
+
+	return nil
+}
+
+// recountSellerReviews recounts inside tx the review counters of the sellers
+// with the given IDs from the per-star counters of their products. Products
+// that are soft deleted still count until they are purged.
+func recountSellerReviews(ctx context.Context, tx *sqlx.Tx, sellerIDs ...string) error {
+	query, args, err := sqlx.In(`
+        UPDATE sellers SET
+            review_count = (SELECT coalesce(sum(rating_1 + rating_2 + rating_3 + rating_4 + rating_5), 0)
+                FROM products WHERE seller_id = sellers.id),
+            review_stars = (SELECT coalesce(sum(rating_1 + 2 * rating_2 + 3 * rating_3 + 4 * rating_4 + 5 * rating_5), 0)
+                FROM products WHERE seller_id = sellers.id)
+        WHERE id IN (?)
+    `, sellerIDs)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, tx.Rebind(query), args...); err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"project/internal/entity"
+	"project/internal/errors"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type ShippingRateRepository struct {
+	DB *sqlx.DB
+}
+
+func NewShippingRateRepository(db *sqlx.DB) *ShippingRateRepository {
+	return &ShippingRateRepository{
+		DB: db,
+	}
+}
+
+func (r *ShippingRateRepository) FindShippingRate(ctx context.Context, originZip, destinationZip string) (*entity.ShippingRate, error) {
+	var rate entity.ShippingRate
+
+	// Zip codes have the same number of digits, so they compare as text.
+	query := `
+        SELECT id, origin_zip_from, origin_zip_to, destination_zip_from, destination_zip_to,
+            base_cost_minor AS "base_cost.amount", currency AS "base_cost.currency",
+            cost_per_kg_minor AS "cost_per_kg.amount", currency AS "cost_per_kg.currency",
+            min_days, max_days
+        FROM shipping_rates
+        WHERE ? BETWEEN origin_zip_from AND origin_zip_to
+            AND ? BETWEEN destination_zip_from AND destination_zip_to
+        ORDER BY (CAST(origin_zip_to AS INTEGER) - CAST(origin_zip_from AS INTEGER))
+            + (CAST(destination_zip_to AS INTEGER) - CAST(destination_zip_from AS INTEGER)) ASC, id ASC
+        LIMIT 1
+    `
+
+	err := r.DB.GetContext(ctx, &rate, query, originZip, destinationZip)
+	if err == sql.ErrNoRows {
+		return nil, errors.ErrShippingUnavailable
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseError, err)
+	}
+
+	return &rate, nil
+}
@@ -42,6 +42,7 @@ func ErrorHandlerMiddleware() gin.HandlerFunc {
 			errorResponse := errors.ErrorResponse{
 				Error:     userMessage,
 				Code:      errorCode,
+				Details:   errors.GetErrorDetails(err),
 				Timestamp: time.Now(),
 			}
 
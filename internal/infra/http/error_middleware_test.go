@@ -142,6 +142,32 @@ func TestErrorHandlerMiddleware_CustomAppError(t *testing.T) {
 	assert.Equal(t, "CUSTOM_CODE", response.Code)
 }
 
+func TestErrorHandlerMiddleware_ValidationError(t *testing.T) {
+	router := setupTestRouter()
+	router.POST("/test", func(c *gin.Context) {
+		validation := &errors.ValidationError{}
+		validation.Add("title", errors.RuleRequired, "title is required")
+		validation.Add("stock", errors.RuleMin, "stock must be greater than or equal to 0")
+		_ = c.Error(fmt.Errorf("failed to create product: %w", validation))
+	})
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/test", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+
+	var response errors.ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "VALIDATION_FAILED", response.Code)
+	assert.Equal(t, "title is required; stock must be greater than or equal to 0", response.Error)
+	assert.Equal(t, []errors.FieldError{
+		{Field: "title", Rule: "required", Message: "title is required"},
+		{Field: "stock", Rule: "min", Message: "stock must be greater than or equal to 0"},
+	}, response.Details)
+}
+
 func TestErrorHandlerMiddleware_MultipleErrors(t *testing.T) {
 	router := setupTestRouter()
 	router.GET("/test", func(c *gin.Context) {
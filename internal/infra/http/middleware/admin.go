@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"crypto/subtle"
+
+	"project/internal/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminTokenHeader é o header que identifica chamadas administrativas
+const AdminTokenHeader = "X-Admin-Token"
+
+// AdminContextKey é a chave do contexto que indica se a requisição é de um administrador
+const AdminContextKey = "is_admin"
+
+// AdminMiddleware marca a requisição como administrativa quando o header
+// X-Admin-Token confere com o token configurado. Com token vazio ninguém é admin.
+func AdminMiddleware(adminToken string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := c.GetHeader(AdminTokenHeader)
+
+		// Comparação em tempo constante para não vazar o token por timing
+		isAdmin := adminToken != "" &&
+			subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) == 1
+
+		c.Set(AdminContextKey, isAdmin)
+
+		c.Next()
+	}
+}
+
+// RequireAdmin interrompe a requisição com 403 quando ela não é administrativa.
+// Deve ser usado depois do AdminMiddleware
+func RequireAdmin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !c.GetBool(AdminContextKey) {
+			_ = c.Error(errors.ErrForbidden)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"project/internal/errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name            string
+		configuredToken string
+		headerToken     string
+		expectedAdmin   bool
+	}{
+		{name: "matching token", configuredToken: "secret", headerToken: "secret", expectedAdmin: true},
+		{name: "wrong token", configuredToken: "secret", headerToken: "other", expectedAdmin: false},
+		{name: "missing header", configuredToken: "secret", headerToken: "", expectedAdmin: false},
+		{name: "admin disabled", configuredToken: "", headerToken: "", expectedAdmin: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(AdminMiddleware(tt.configuredToken))
+
+			router.GET("/test", func(c *gin.Context) {
+				assert.Equal(t, tt.expectedAdmin, c.GetBool(AdminContextKey))
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/test", nil)
+			if tt.headerToken != "" {
+				req.Header.Set(AdminTokenHeader, tt.headerToken)
+			}
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+		})
+	}
+}
+
+func TestRequireAdmin_RejectsNonAdmin(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var handledErr error
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Next()
+		if len(c.Errors) > 0 {
+			handledErr = c.Errors.Last().Err
+		}
+	})
+	router.Use(AdminMiddleware("secret"))
+
+	called := false
+	router.POST("/test", RequireAdmin(), func(c *gin.Context) {
+		called = true
+		c.Status(http.StatusOK)
+	})
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/test", nil)
+	router.ServeHTTP(w, req)
+
+	assert.False(t, called)
+	assert.ErrorIs(t, handledErr, errors.ErrForbidden)
+}
+
+func TestRequireAdmin_AllowsAdmin(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(AdminMiddleware("secret"))
+
+	router.POST("/test", RequireAdmin(), func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/test", nil)
+	req.Header.Set(AdminTokenHeader, "secret")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SellerIDHeader é o header com o ID do vendedor que faz a chamada
+const SellerIDHeader = "X-Seller-ID"
+
+// SellerContextKey é a chave do contexto com o ID do vendedor da requisição
+const SellerContextKey = "seller_id"
+
+// SellerMiddleware guarda no contexto o vendedor informado no header
+// X-Seller-ID. Sem o header a requisição não é de nenhum vendedor.
+func SellerMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(SellerContextKey, strings.TrimSpace(c.GetHeader(SellerIDHeader)))
+
+		c.Next()
+	}
+}
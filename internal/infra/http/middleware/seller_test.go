@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSellerMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name             string
+		headerSellerID   string
+		expectedSellerID string
+	}{
+		{name: "seller header", headerSellerID: "SELLER001", expectedSellerID: "SELLER001"},
+		{name: "surrounding spaces", headerSellerID: " SELLER001 ", expectedSellerID: "SELLER001"},
+		{name: "missing header", headerSellerID: "", expectedSellerID: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(SellerMiddleware())
+
+			router.GET("/test", func(c *gin.Context) {
+				assert.Equal(t, tt.expectedSellerID, c.GetString(SellerContextKey))
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/test", nil)
+			if tt.headerSellerID != "" {
+				req.Header.Set(SellerIDHeader, tt.headerSellerID)
+			}
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+		})
+	}
+}
@@ -11,7 +11,17 @@ import (
 
 func SetupRouter(
 	productHandler *handler.ProductHandler,
+	categoryHandler *handler.CategoryHandler,
+	sellerHandler *handler.SellerHandler,
+	reviewHandler *handler.ReviewHandler,
+	questionHandler *handler.QuestionHandler,
+	exchangeRateHandler *handler.ExchangeRateHandler,
+	shippingHandler *handler.ShippingHandler,
+	installmentHandler *handler.InstallmentHandler,
+	promotionHandler *handler.PromotionHandler,
+	productStatusHandler *handler.ProductStatusHandler,
 	healthHandler *handler.HealthHandler,
+	adminToken string,
 ) *gin.Engine {
 	r := gin.New()
 
@@ -23,6 +33,10 @@ func SetupRouter(
 
 	r.Use(ErrorHandlerMiddleware())
 
+	r.Use(middleware.AdminMiddleware(adminToken))
+
+	r.Use(middleware.SellerMiddleware())
+
 	r.GET("/health", healthHandler.HealthCheck)
 
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
@@ -30,7 +44,36 @@ func SetupRouter(
 	api := r.Group("/api/v1")
 	{
 		api.GET("/products", productHandler.ListProducts)
+		api.GET("/products/search", productHandler.SearchProducts)
 		api.GET("/products/:id", productHandler.GetProduct)
+		api.POST("/products", productHandler.CreateProduct)
+		api.PUT("/products/:id", productHandler.UpdateProduct)
+		api.PATCH("/products/:id", productHandler.PatchProduct)
+		api.DELETE("/products/:id", productHandler.DeleteProduct)
+		api.POST("/products/:id/restore", middleware.RequireAdmin(), productHandler.RestoreProduct)
+		api.POST("/products/:id/status", productStatusHandler.ChangeProductStatus)
+		api.GET("/products/:id/status-changes", productStatusHandler.ListStatusChanges)
+		api.GET("/products/:id/reviews", reviewHandler.ListReviews)
+		api.POST("/products/:id/reviews", reviewHandler.CreateReview)
+		api.GET("/products/:id/questions", questionHandler.ListQuestions)
+		api.POST("/products/:id/questions", questionHandler.CreateQuestion)
+		api.POST("/products/:id/questions/:question_id/answer", questionHandler.AnswerQuestion)
+		api.POST("/products/:id/questions/:question_id/hide", questionHandler.HideQuestion)
+		api.GET("/products/:id/shipping", shippingHandler.GetShippingQuote)
+		api.GET("/products/:id/installments", installmentHandler.GetProductInstallments)
+
+		api.GET("/categories", categoryHandler.ListCategories)
+		api.GET("/categories/:id", categoryHandler.GetCategory)
+
+		api.GET("/sellers/:id", sellerHandler.GetSeller)
+		api.GET("/sellers/:id/products", sellerHandler.ListSellerProducts)
+
+		api.GET("/exchange-rates", exchangeRateHandler.ListExchangeRates)
+		api.PUT("/exchange-rates", middleware.RequireAdmin(), exchangeRateHandler.LoadExchangeRates)
+
+		api.GET("/promotions", promotionHandler.ListPromotions)
+		api.POST("/promotions", middleware.RequireAdmin(), promotionHandler.CreatePromotion)
+		api.DELETE("/promotions/:id", middleware.RequireAdmin(), promotionHandler.DeletePromotion)
 	}
 
 	return r
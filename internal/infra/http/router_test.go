@@ -14,8 +14,8 @@ import (
 
 type mockListProductUseCase struct{}
 
-func (m *mockListProductUseCase) Execute(ctx context.Context) ([]dto.ProductDTO, error) {
-	return []dto.ProductDTO{}, nil
+func (m *mockListProductUseCase) Execute(ctx context.Context, input dto.ListProductInputDTO) (*dto.ProductListDTO, error) {
+	return &dto.ProductListDTO{Products: []dto.ProductDTO{}}, nil
 }
 
 type mockGetProductUseCase struct{}
@@ -27,10 +27,10 @@ func (m *mockGetProductUseCase) Execute(ctx context.Context, input dto.ProductIn
 func TestSetupRouter(t *testing.T) {
 	listUseCase := &mockListProductUseCase{}
 	getUseCase := &mockGetProductUseCase{}
-	productHandler := handler.NewProductHandler(listUseCase, getUseCase)
+	productHandler := handler.NewProductHandler(listUseCase, getUseCase, nil, nil, nil, nil, nil, nil, nil)
 	healthHandler := handler.NewHealthHandler()
 
-	router := SetupRouter(productHandler, healthHandler)
+	router := SetupRouter(productHandler, handler.NewCategoryHandler(nil, nil), handler.NewSellerHandler(nil, nil), handler.NewReviewHandler(nil, nil), handler.NewQuestionHandler(nil, nil, nil, nil), handler.NewExchangeRateHandler(nil, nil), handler.NewShippingHandler(nil), handler.NewInstallmentHandler(nil), handler.NewPromotionHandler(nil, nil, nil), handler.NewProductStatusHandler(nil, nil), healthHandler, "")
 
 	assert.NotNil(t, router)
 }
@@ -38,10 +38,10 @@ func TestSetupRouter(t *testing.T) {
 func TestSetupRouter_ProductsEndpoint(t *testing.T) {
 	listUseCase := &mockListProductUseCase{}
 	getUseCase := &mockGetProductUseCase{}
-	productHandler := handler.NewProductHandler(listUseCase, getUseCase)
+	productHandler := handler.NewProductHandler(listUseCase, getUseCase, nil, nil, nil, nil, nil, nil, nil)
 	healthHandler := handler.NewHealthHandler()
 
-	router := SetupRouter(productHandler, healthHandler)
+	router := SetupRouter(productHandler, handler.NewCategoryHandler(nil, nil), handler.NewSellerHandler(nil, nil), handler.NewReviewHandler(nil, nil), handler.NewQuestionHandler(nil, nil, nil, nil), handler.NewExchangeRateHandler(nil, nil), handler.NewShippingHandler(nil), handler.NewInstallmentHandler(nil), handler.NewPromotionHandler(nil, nil, nil), handler.NewProductStatusHandler(nil, nil), healthHandler, "")
 
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("GET", "/api/v1/products", nil)
@@ -53,10 +53,10 @@ func TestSetupRouter_ProductsEndpoint(t *testing.T) {
 func TestSetupRouter_GetProductEndpoint(t *testing.T) {
 	listUseCase := &mockListProductUseCase{}
 	getUseCase := &mockGetProductUseCase{}
-	productHandler := handler.NewProductHandler(listUseCase, getUseCase)
+	productHandler := handler.NewProductHandler(listUseCase, getUseCase, nil, nil, nil, nil, nil, nil, nil)
 	healthHandler := handler.NewHealthHandler()
 
-	router := SetupRouter(productHandler, healthHandler)
+	router := SetupRouter(productHandler, handler.NewCategoryHandler(nil, nil), handler.NewSellerHandler(nil, nil), handler.NewReviewHandler(nil, nil), handler.NewQuestionHandler(nil, nil, nil, nil), handler.NewExchangeRateHandler(nil, nil), handler.NewShippingHandler(nil), handler.NewInstallmentHandler(nil), handler.NewPromotionHandler(nil, nil, nil), handler.NewProductStatusHandler(nil, nil), healthHandler, "")
 
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("GET", "/api/v1/products/PROD-123", nil)
@@ -68,10 +68,10 @@ func TestSetupRouter_GetProductEndpoint(t *testing.T) {
 func TestSetupRouter_ErrorMiddlewareIsApplied(t *testing.T) {
 	listUseCase := &mockListProductUseCase{}
 	getUseCase := &mockGetProductUseCase{}
-	productHandler := handler.NewProductHandler(listUseCase, getUseCase)
+	productHandler := handler.NewProductHandler(listUseCase, getUseCase, nil, nil, nil, nil, nil, nil, nil)
 	healthHandler := handler.NewHealthHandler()
 
-	router := SetupRouter(productHandler, healthHandler)
+	router := SetupRouter(productHandler, handler.NewCategoryHandler(nil, nil), handler.NewSellerHandler(nil, nil), handler.NewReviewHandler(nil, nil), handler.NewQuestionHandler(nil, nil, nil, nil), handler.NewExchangeRateHandler(nil, nil), handler.NewShippingHandler(nil), handler.NewInstallmentHandler(nil), handler.NewPromotionHandler(nil, nil, nil), handler.NewProductStatusHandler(nil, nil), healthHandler, "")
 
 	assert.NotNil(t, router)
 	assert.NotEmpty(t, router.Routes())
@@ -80,10 +80,10 @@ func TestSetupRouter_ErrorMiddlewareIsApplied(t *testing.T) {
 func TestSetupRouter_HealthEndpoint(t *testing.T) {
 	listUseCase := &mockListProductUseCase{}
 	getUseCase := &mockGetProductUseCase{}
-	productHandler := handler.NewProductHandler(listUseCase, getUseCase)
+	productHandler := handler.NewProductHandler(listUseCase, getUseCase, nil, nil, nil, nil, nil, nil, nil)
 	healthHandler := handler.NewHealthHandler()
 
-	router := SetupRouter(productHandler, healthHandler)
+	router := SetupRouter(productHandler, handler.NewCategoryHandler(nil, nil), handler.NewSellerHandler(nil, nil), handler.NewReviewHandler(nil, nil), handler.NewQuestionHandler(nil, nil, nil, nil), handler.NewExchangeRateHandler(nil, nil), handler.NewShippingHandler(nil), handler.NewInstallmentHandler(nil), handler.NewPromotionHandler(nil, nil, nil), handler.NewProductStatusHandler(nil, nil), healthHandler, "")
 
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("GET", "/health", nil)
@@ -92,3 +92,19 @@ func TestSetupRouter_HealthEndpoint(t *testing.T) {
 	assert.Equal(t, http.StatusOK, w.Code)
 	assert.Contains(t, w.Body.String(), "healthy")
 }
+
+func TestSetupRouter_RestoreRequiresAdmin(t *testing.T) {
+	listUseCase := &mockListProductUseCase{}
+	getUseCase := &mockGetProductUseCase{}
+	productHandler := handler.NewProductHandler(listUseCase, getUseCase, nil, nil, nil, nil, nil, nil, nil)
+	healthHandler := handler.NewHealthHandler()
+
+	router := SetupRouter(productHandler, handler.NewCategoryHandler(nil, nil), handler.NewSellerHandler(nil, nil), handler.NewReviewHandler(nil, nil), handler.NewQuestionHandler(nil, nil, nil, nil), handler.NewExchangeRateHandler(nil, nil), handler.NewShippingHandler(nil), handler.NewInstallmentHandler(nil), handler.NewPromotionHandler(nil, nil, nil), handler.NewProductStatusHandler(nil, nil), healthHandler, "secret")
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/products/MLB001/restore", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, w.Body.String(), "FORBIDDEN")
+}
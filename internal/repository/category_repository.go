@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"project/internal/entity"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type CategoryRepositoryInterface interface {
+	// ListCategories returns every category ordered by path, so parents come
+	// before their children.
+	ListCategories(ctx context.Context) ([]entity.Category, error)
+	GetCategory(ctx context.Context, id int64) (*entity.Category, error)
+	// FindCategoryPaths returns, for each of ids, the category and its
+	// ancestors root first. Unknown IDs have no entry.
+	FindCategoryPaths(ctx context.Context, ids []int64) (map[int64][]entity.Category, error)
+	// CountProductsByCategory counts the listings that are not deleted and
+	// are active at at, by their status and publishing window, directly in
+	// each category, without their subcategories.
+	CountProductsByCategory(ctx context.Context, at time.Time) (map[int64]int, error)
+	// FindCategoryAttributes returns the attributes defined for the category
+	// at path and for its ancestors, root first. A path without categories
+	// has none.
+	FindCategoryAttributes(ctx context.Context, path string) ([]entity.CategoryAttribute, error)
+}
+
+type MockCategoryRepository struct {
+	mock.Mock
+}
+
+func (m *MockCategoryRepository) ListCategories(ctx context.Context) ([]entity.Category, error) {
+	args := m.Called(ctx)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.Category), nil
+}
+
+func (m *MockCategoryRepository) GetCategory(ctx context.Context, id int64) (*entity.Category, error) {
+	args := m.Called(ctx, id)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Category), nil
+}
+
+func (m *MockCategoryRepository) FindCategoryPaths(ctx context.Context, ids []int64) (map[int64][]entity.Category, error) {
+	args := m.Called(ctx, ids)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[int64][]entity.Category), nil
+}
+
+func (m *MockCategoryRepository) CountProductsByCategory(ctx context.Context, at time.Time) (map[int64]int, error) {
+	args := m.Called(ctx, at)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[int64]int), nil
+}
+
+func (m *MockCategoryRepository) FindCategoryAttributes(ctx context.Context, path string) ([]entity.CategoryAttribute, error) {
+	args := m.Called(ctx, path)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.CategoryAttribute), nil
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"project/internal/entity"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type ExchangeRateRepositoryInterface interface {
+	// ListExchangeRates returns every rate, ordered by currency.
+	ListExchangeRates(ctx context.Context) ([]entity.ExchangeRate, error)
+	// SaveExchangeRates inserts or replaces rates all at once: either every
+	// rate is saved or none is.
+	SaveExchangeRates(ctx context.Context, rates []entity.ExchangeRate) error
+}
+
+type MockExchangeRateRepository struct {
+	mock.Mock
+}
+
+func (m *MockExchangeRateRepository) ListExchangeRates(ctx context.Context) ([]entity.ExchangeRate, error) {
+	args := m.Called(ctx)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.ExchangeRate), nil
+}
+
+func (m *MockExchangeRateRepository) SaveExchangeRates(ctx context.Context, rates []entity.ExchangeRate) error {
+	args := m.Called(ctx, rates)
+	return args.Error(0)
+}
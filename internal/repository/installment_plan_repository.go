@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"project/internal/entity"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type InstallmentPlanRepositoryInterface interface {
+	// ListInstallmentPlans returns every plan, ordered by currency and then
+	// by number of installments.
+	ListInstallmentPlans(ctx context.Context) ([]entity.InstallmentPlan, error)
+}
+
+type MockInstallmentPlanRepository struct {
+	mock.Mock
+}
+
+func (m *MockInstallmentPlanRepository) ListInstallmentPlans(ctx context.Context) ([]entity.InstallmentPlan, error) {
+	args := m.Called(ctx)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.InstallmentPlan), nil
+}
@@ -0,0 +1,81 @@
+package repository
+
+import "time"
+
+// ProductFilter narrows the products a query returns. Zero values and nil
+// pointers leave the corresponding filter off.
+type ProductFilter struct {
+	IncludeDeleted bool
+	// Status matches the products in this listing status at At, the status
+	// their publishing window gives them even before the scheduler stores it;
+	// empty matches every status.
+	Status string
+	At     time.Time
+
+	// CategoryPrefix matches the category itself and every category below it
+	// in the "Parent > Child" path.
+	CategoryPrefix string
+	Condition      string
+	SellerID       string
+	MinPrice       *float64
+	MaxPrice       *float64
+	InStock        *bool
+
+	// PriceCurrency is the currency of MinPrice, MaxPrice and the price
+	// breaks of facets; empty is entity.BaseCurrency. Prices in another
+	// currency are compared converted by the exchange rates, and a price in a
+	// currency without a rate matches no price filter and no price range.
+	PriceCurrency string
+
+	// Attributes matches products having every attribute with the given
+	// value, compared as text without regard to case.
+	Attributes map[string]string
+}
+
+// ProductQuery describes the page of products ListProducts returns.
+type ProductQuery struct {
+	ProductFilter
+
+	// AfterID starts the page after the product with this ID. Empty starts
+	// from the first product.
+	AfterID string
+	Limit   int
+}
+
+// ProductSearchQuery describes the page of full-text matches SearchProducts
+// returns. Results are ranked by relevance, so pages are addressed by offset.
+type ProductSearchQuery struct {
+	ProductFilter
+
+	Text   string
+	Offset int
+	Limit  int
+}
+
+// ProductFacetQuery selects the products facets are counted over: those
+// matching the filter and, when Text is set, the full-text search.
+type ProductFacetQuery struct {
+	ProductFilter
+
+	Text string
+	// PriceBreaks are the ascending upper bounds of the price ranges; the last
+	// range has no upper bound.
+	PriceBreaks []float64
+}
+
+// FacetCount is how many products share a value.
+type FacetCount struct {
+	Value string `db:"value"`
+	Label string `db:"label"`
+	Count int    `db:"count"`
+}
+
+// ProductFacetCounts holds the facet counts of a product set. Categories are
+// counted per full category path and PriceRanges per range, in the order of
+// ProductFacetQuery.PriceBreaks.
+type ProductFacetCounts struct {
+	Conditions  []FacetCount
+	Categories  []FacetCount
+	Sellers     []FacetCount
+	PriceRanges []int
+}
@@ -3,36 +3,110 @@ package repository
 import (
 	"context"
 	"project/internal/entity"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 )
 
 type ProductRepositoryInterface interface {
-	ListProducts(ctx context.Context) ([]entity.Product, error)
-	GetProduct(ctx context.Context, id string) (*entity.Product, error)
+	// ListProducts returns the products matching query ordered by ID.
+	ListProducts(ctx context.Context, query ProductQuery) ([]entity.Product, error)
+	// SearchProducts returns the products matching query.Text ranked by
+	// relevance. It fails with ErrSearchUnavailable when the database has no
+	// full-text index.
+	SearchProducts(ctx context.Context, query ProductSearchQuery) ([]entity.ProductSearchResult, error)
+	// CountProductFacets counts the products matching query by condition,
+	// category, seller and price range.
+	CountProductFacets(ctx context.Context, query ProductFacetQuery) (*ProductFacetCounts, error)
+	GetProduct(ctx context.Context, id string, includeDeleted bool) (*entity.Product, error)
+	// GetProductsByIDs loads the products with the given IDs in one query. IDs
+	// that do not exist are left out; the order of the result is unspecified.
+	GetProductsByIDs(ctx context.Context, ids []string, includeDeleted bool) ([]entity.Product, error)
 	FindImagesByProductID(ctx context.Context, productID string) ([]entity.ProductImage, error)
+	// FindVariationsByProductID loads the variations of a product with their
+	// attributes and images, in display order.
+	FindVariationsByProductID(ctx context.Context, productID string) ([]entity.Variation, error)
+	// FindImagesByProductIDs loads the images of several products in one query,
+	// keyed by product ID. Products without images have no entry.
+	FindImagesByProductIDs(ctx context.Context, productIDs []string) (map[string][]entity.ProductImage, error)
+	// FindVariationsByProductIDs loads the variations of several products in
+	// one query, keyed by product ID, each in display order. Products without
+	// variations have no entry.
+	FindVariationsByProductIDs(ctx context.Context, productIDs []string) (map[string][]entity.Variation, error)
+	// CreateProduct stores product with its images, attributes, variations
+	// and StatusChanges, filling in the IDs of the variations and changes.
+	CreateProduct(ctx context.Context, product *entity.Product, images []entity.ProductImage) error
+	// UpdateProduct saves product, attributes, variations and StatusChanges
+	// included, only if its stored UpdatedAt still equals expectedVersion. A nil images slice
+	// leaves the stored images untouched. Variations replace the stored ones;
+	// those with an ID keep it. A product moving to another seller takes its
+	// reviews along in the review counters of both sellers.
+	UpdateProduct(ctx context.Context, product *entity.Product, images []entity.ProductImage, expectedVersion time.Time) error
+	// UpdateProductStatus saves the status of product and records its
+	// StatusChanges, filling in their IDs, only if the stored status is still
+	// the one the first change moved from. It fails with
+	// ErrInvalidStatusTransition otherwise, or when the product is deleted.
+	UpdateProductStatus(ctx context.Context, product *entity.Product) error
+	// ListStatusChanges returns the status changes of a product, oldest
+	// first.
+	ListStatusChanges(ctx context.Context, productID string) ([]entity.StatusChange, error)
+	// ListScheduleDue returns the products that are not deleted whose
+	// publishing window gives them another status at at than the stored one,
+	// as entity.Product.ListingStatus.
+	ListScheduleDue(ctx context.Context, at time.Time) ([]entity.Product, error)
+	SoftDeleteProduct(ctx context.Context, id string, deletedAt time.Time) error
+	RestoreProduct(ctx context.Context, id string, restoredAt time.Time) error
+	// PurgeDeletedProducts hard deletes the products soft deleted before
+	// deletedBefore, together with their images, attributes, variations,
+	// reviews, questions, promotions and status changes, recounts the review
+	// counters of their sellers and returns how many were removed.
+	PurgeDeletedProducts(ctx context.Context, deletedBefore time.Time) (int64, error)
 }
 
 type MockProductRepository struct {
 	mock.Mock
 }
 
-func (m *MockProductRepository) ListProducts(ctx context.Context) ([]entity.Product, error) {
-	args := m.Called(ctx)
+func (m *MockProductRepository) ListProducts(ctx context.Context, query ProductQuery) ([]entity.Product, error) {
+	args := m.Called(ctx, query)
 	if args.Error(1) != nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]entity.Product), nil
 }
 
-func (m *MockProductRepository) GetProduct(ctx context.Context, id string) (*entity.Product, error) {
-	args := m.Called(ctx, id)
+func (m *MockProductRepository) SearchProducts(ctx context.Context, query ProductSearchQuery) ([]entity.ProductSearchResult, error) {
+	args := m.Called(ctx, query)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.ProductSearchResult), nil
+}
+
+func (m *MockProductRepository) CountProductFacets(ctx context.Context, query ProductFacetQuery) (*ProductFacetCounts, error) {
+	args := m.Called(ctx, query)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ProductFacetCounts), nil
+}
+
+func (m *MockProductRepository) GetProduct(ctx context.Context, id string, includeDeleted bool) (*entity.Product, error) {
+	args := m.Called(ctx, id, includeDeleted)
 	if args.Error(1) != nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*entity.Product), nil
 }
 
+func (m *MockProductRepository) GetProductsByIDs(ctx context.Context, ids []string, includeDeleted bool) ([]entity.Product, error) {
+	args := m.Called(ctx, ids, includeDeleted)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.Product), nil
+}
+
 func (m *MockProductRepository) FindImagesByProductID(ctx context.Context, productID string) ([]entity.ProductImage, error) {
 	args := m.Called(ctx, productID)
 	if args.Error(1) != nil {
@@ -40,3 +114,73 @@ func (m *MockProductRepository) FindImagesByProductID(ctx context.Context, produ
 	}
 	return args.Get(0).([]entity.ProductImage), nil
 }
+
+func (m *MockProductRepository) FindVariationsByProductID(ctx context.Context, productID string) ([]entity.Variation, error) {
+	args := m.Called(ctx, productID)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.Variation), nil
+}
+
+func (m *MockProductRepository) FindImagesByProductIDs(ctx context.Context, productIDs []string) (map[string][]entity.ProductImage, error) {
+	args := m.Called(ctx, productIDs)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string][]entity.ProductImage), nil
+}
+
+func (m *MockProductRepository) FindVariationsByProductIDs(ctx context.Context, productIDs []string) (map[string][]entity.Variation, error) {
+	args := m.Called(ctx, productIDs)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string][]entity.Variation), nil
+}
+
+func (m *MockProductRepository) CreateProduct(ctx context.Context, product *entity.Product, images []entity.ProductImage) error {
+	args := m.Called(ctx, product, images)
+	return args.Error(0)
+}
+
+func (m *MockProductRepository) UpdateProduct(ctx context.Context, product *entity.Product, images []entity.ProductImage, expectedVersion time.Time) error {
+	args := m.Called(ctx, product, images, expectedVersion)
+	return args.Error(0)
+}
+
+func (m *MockProductRepository) UpdateProductStatus(ctx context.Context, product *entity.Product) error {
+	args := m.Called(ctx, product)
+	return args.Error(0)
+}
+
+func (m *MockProductRepository) ListStatusChanges(ctx context.Context, productID string) ([]entity.StatusChange, error) {
+	args := m.Called(ctx, productID)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.StatusChange), nil
+}
+
+func (m *MockProductRepository) ListScheduleDue(ctx context.Context, at time.Time) ([]entity.Product, error) {
+	args := m.Called(ctx, at)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.Product), nil
+}
+
+func (m *MockProductRepository) SoftDeleteProduct(ctx context.Context, id string, deletedAt time.Time) error {
+	args := m.Called(ctx, id, deletedAt)
+	return args.Error(0)
+}
+
+func (m *MockProductRepository) RestoreProduct(ctx context.Context, id string, restoredAt time.Time) error {
+	args := m.Called(ctx, id, restoredAt)
+	return args.Error(0)
+}
+
+func (m *MockProductRepository) PurgeDeletedProducts(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	args := m.Called(ctx, deletedBefore)
+	return args.Get(0).(int64), args.Error(1)
+}
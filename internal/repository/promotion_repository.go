@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"project/internal/entity"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// PromotionQuery selects the promotions ListPromotions returns, ordered by
+// ID.
+type PromotionQuery struct {
+	// ActiveAt keeps the promotions active at that time. Nil keeps every
+	// promotion, past and future ones included.
+	ActiveAt *time.Time
+}
+
+type PromotionRepositoryInterface interface {
+	// CreatePromotion stores promotion, filling in its ID, unless a promotion
+	// for the same product or category with the same priority is active at
+	// some time promotion is; it fails with ErrPromotionConflict then. The
+	// check and the insert are atomic.
+	CreatePromotion(ctx context.Context, promotion *entity.Promotion) error
+	ListPromotions(ctx context.Context, query PromotionQuery) ([]entity.Promotion, error)
+	// DeletePromotion fails with ErrPromotionNotFound for an unknown ID.
+	DeletePromotion(ctx context.Context, id int64) error
+}
+
+type MockPromotionRepository struct {
+	mock.Mock
+}
+
+func (m *MockPromotionRepository) CreatePromotion(ctx context.Context, promotion *entity.Promotion) error {
+	args := m.Called(ctx, promotion)
+	return args.Error(0)
+}
+
+func (m *MockPromotionRepository) ListPromotions(ctx context.Context, query PromotionQuery) ([]entity.Promotion, error) {
+	args := m.Called(ctx, query)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.Promotion), nil
+}
+
+func (m *MockPromotionRepository) DeletePromotion(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"project/internal/entity"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// QuestionQuery describes the page of questions of one product ListQuestions
+// returns, newest first.
+type QuestionQuery struct {
+	ProductID string
+	// Statuses keeps the questions in any of these states. Empty keeps every
+	// state.
+	Statuses []string
+
+	// BeforeID starts the page after the question with this ID. Zero starts
+	// from the newest question.
+	BeforeID int64
+	Limit    int
+}
+
+type QuestionRepositoryInterface interface {
+	// CreateQuestion stores question, failing with ErrProductNotFound when the
+	// product does not exist or is deleted.
+	CreateQuestion(ctx context.Context, question *entity.Question) error
+	GetQuestion(ctx context.Context, productID string, id int64) (*entity.Question, error)
+	ListQuestions(ctx context.Context, query QuestionQuery) ([]entity.Question, error)
+	// AnswerQuestion saves the answer of question only while it is still
+	// unanswered, failing with ErrQuestionAnswered otherwise.
+	AnswerQuestion(ctx context.Context, question *entity.Question) error
+	HideQuestion(ctx context.Context, productID string, id int64) error
+}
+
+type MockQuestionRepository struct {
+	mock.Mock
+}
+
+func (m *MockQuestionRepository) CreateQuestion(ctx context.Context, question *entity.Question) error {
+	args := m.Called(ctx, question)
+	return args.Error(0)
+}
+
+func (m *MockQuestionRepository) GetQuestion(ctx context.Context, productID string, id int64) (*entity.Question, error) {
+	args := m.Called(ctx, productID, id)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Question), nil
+}
+
+func (m *MockQuestionRepository) ListQuestions(ctx context.Context, query QuestionQuery) ([]entity.Question, error) {
+	args := m.Called(ctx, query)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.Question), nil
+}
+
+func (m *MockQuestionRepository) AnswerQuestion(ctx context.Context, question *entity.Question) error {
+	args := m.Called(ctx, question)
+	return args.Error(0)
+}
+
+func (m *MockQuestionRepository) HideQuestion(ctx context.Context, productID string, id int64) error {
+	args := m.Called(ctx, productID, id)
+	return args.Error(0)
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"project/internal/entity"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// ReviewQuery describes the page of reviews of one product ListReviews
+// returns, newest first.
+type ReviewQuery struct {
+	ProductID string
+
+	// BeforeID starts the page after the review with this ID. Zero starts
+	// from the newest review.
+	BeforeID int64
+	Limit    int
+}
+
+type ReviewRepositoryInterface interface {
+	// CreateReview stores review and adds its rating to the counters of the
+	// product, recounting the review counters of its seller, in the same
+	// transaction. It fails with ErrProductNotFound when the product does not
+	// exist or is deleted.
+	CreateReview(ctx context.Context, review *entity.Review) error
+	ListReviews(ctx context.Context, query ReviewQuery) ([]entity.Review, error)
+}
+
+type MockReviewRepository struct {
+	mock.Mock
+}
+
+func (m *MockReviewRepository) CreateReview(ctx context.Context, review *entity.Review) error {
+	args := m.Called(ctx, review)
+	return args.Error(0)
+}
+
+func (m *MockReviewRepository) ListReviews(ctx context.Context, query ReviewQuery) ([]entity.Review, error) {
+	args := m.Called(ctx, query)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.Review), nil
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"project/internal/entity"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type SellerRepositoryInterface interface {
+	GetSeller(ctx context.Context, id string) (*entity.Seller, error)
+	// CountSellerProducts counts the listings of the seller that are not
+	// deleted and are active at at, by their status and publishing window.
+	CountSellerProducts(ctx context.Context, id string, at time.Time) (int, error)
+}
+
+type MockSellerRepository struct {
+	mock.Mock
+}
+
+func (m *MockSellerRepository) GetSeller(ctx context.Context, id string) (*entity.Seller, error) {
+	args := m.Called(ctx, id)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Seller), nil
+}
+
+func (m *MockSellerRepository) CountSellerProducts(ctx context.Context, id string, at time.Time) (int, error) {
+	args := m.Called(ctx, id, at)
+	if args.Error(1) != nil {
+		return 0, args.Error(1)
+	}
+	return args.Int(0), nil
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"project/internal/entity"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type ShippingRateRepositoryInterface interface {
+	// FindShippingRate returns the rate with the narrowest ranges among the
+	// ones covering both zip codes, or errors.ErrShippingUnavailable when
+	// there is none.
+	FindShippingRate(ctx context.Context, originZip, destinationZip string) (*entity.ShippingRate, error)
+}
+
+type MockShippingRateRepository struct {
+	mock.Mock
+}
+
+func (m *MockShippingRateRepository) FindShippingRate(ctx context.Context, originZip, destinationZip string) (*entity.ShippingRate, error) {
+	args := m.Called(ctx, originZip, destinationZip)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ShippingRate), nil
+}
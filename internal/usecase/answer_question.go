@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type AnswerQuestionUseCase struct {
+	productRepository  repository.ProductRepositoryInterface
+	questionRepository repository.QuestionRepositoryInterface
+}
+
+func NewAnswerQuestionUseCase(productRepo repository.ProductRepositoryInterface, questionRepo repository.QuestionRepositoryInterface) *AnswerQuestionUseCase {
+	return &AnswerQuestionUseCase{
+		productRepository:  productRepo,
+		questionRepository: questionRepo,
+	}
+}
+
+// Execute answers an unanswered question. Only the seller of the product may
+// answer; a question is answered once, and a hidden question is reported as
+// ErrQuestionNotFound.
+func (p *AnswerQuestionUseCase) Execute(ctx context.Context, input dto.AnswerQuestionInputDTO) (*dto.QuestionDTO, error) {
+	log.Debug().
+		Str("product_id", input.ProductID).
+		Int64("question_id", input.QuestionID).
+		Str("seller_id", input.SellerID).
+		Msg("Executing AnswerQuestion use case")
+
+	if strings.TrimSpace(input.ProductID) == "" {
+		log.Warn().Msg("Invalid product ID: empty or whitespace")
+		return nil, errors.ErrInvalidProductID
+	}
+
+	answer := strings.TrimSpace(input.Answer)
+	if answer == "" {
+		log.Warn().Int64("question_id", input.QuestionID).Msg("Invalid answer: empty or whitespace")
+		return nil, errors.NewInvalidInputError("answer is required")
+	}
+
+	product, err := p.productRepository.GetProduct(ctx, input.ProductID, false)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ProductID).
+			Msg("Failed to get product from repository")
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	if input.SellerID == "" || input.SellerID != product.SellerID {
+		log.Warn().
+			Str("product_id", input.ProductID).
+			Str("seller_id", input.SellerID).
+			Msg("Question answered by someone other than the seller")
+		return nil, errors.NewForbiddenError("Only the seller of the product can answer its questions")
+	}
+
+	question, err := p.questionRepository.GetQuestion(ctx, input.ProductID, input.QuestionID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Int64("question_id", input.QuestionID).
+			Msg("Failed to get question from repository")
+		return nil, fmt.Errorf("failed to get question: %w", err)
+	}
+
+	switch question.Status {
+	case entity.QuestionHidden:
+		return nil, errors.ErrQuestionNotFound
+	case entity.QuestionAnswered:
+		return nil, errors.ErrQuestionAnswered
+	}
+
+	answeredAt := time.Now()
+	question.Status = entity.QuestionAnswered
+	question.Answer = &answer
+	question.AnsweredAt = &answeredAt
+
+	if err := p.questionRepository.AnswerQuestion(ctx, question); err != nil {
+		log.Error().
+			Err(err).
+			Int64("question_id", input.QuestionID).
+			Msg("Failed to answer question in repository")
+		return nil, fmt.Errorf("failed to answer question: %w", err)
+	}
+
+	log.Info().
+		Str("product_id", question.ProductID).
+		Int64("question_id", question.ID).
+		Msg("Question answered successfully")
+
+	questionDto := toQuestionDTO(*question)
+	return &questionDto, nil
+}
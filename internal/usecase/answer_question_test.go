@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AnswerQuestionUseCaseTestSuite struct {
+	suite.Suite
+	repositoryMock         *repository.MockProductRepository
+	questionRepositoryMock *repository.MockQuestionRepository
+}
+
+func (suite *AnswerQuestionUseCaseTestSuite) BeforeTest(suiteName, testName string) {
+	suite.repositoryMock = new(repository.MockProductRepository)
+	suite.questionRepositoryMock = new(repository.MockQuestionRepository)
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(&entity.Product{ID: "MLB001", SellerID: "SELLER001"}, nil)
+}
+
+func (suite *AnswerQuestionUseCaseTestSuite) useCase() *AnswerQuestionUseCase {
+	return NewAnswerQuestionUseCase(suite.repositoryMock, suite.questionRepositoryMock)
+}
+
+func (suite *AnswerQuestionUseCaseTestSuite) input() dto.AnswerQuestionInputDTO {
+	return dto.AnswerQuestionInputDTO{ProductID: "MLB001", QuestionID: 3, SellerID: "SELLER001", Answer: " Yes. "}
+}
+
+func (suite *AnswerQuestionUseCaseTestSuite) TestAnswerQuestionUseCase_Execute_Success() {
+	suite.questionRepositoryMock.On("GetQuestion", mock.Anything, "MLB001", int64(3)).
+		Return(&entity.Question{ID: 3, ProductID: "MLB001", Text: "Is it unlocked?", Status: entity.QuestionUnanswered}, nil)
+	suite.questionRepositoryMock.On("AnswerQuestion", mock.Anything, mock.MatchedBy(func(question *entity.Question) bool {
+		return question.Status == entity.QuestionAnswered && *question.Answer == "Yes." && question.AnsweredAt != nil
+	})).Return(nil)
+
+	result, err := suite.useCase().Execute(context.Background(), suite.input())
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), entity.QuestionAnswered, result.Status)
+	assert.Equal(suite.T(), "Yes.", result.Answer)
+	assert.NotNil(suite.T(), result.AnsweredAt)
+	suite.questionRepositoryMock.AssertExpectations(suite.T())
+}
+
+func (suite *AnswerQuestionUseCaseTestSuite) TestAnswerQuestionUseCase_Execute_NotTheSeller() {
+	for _, sellerID := range []string{"", "SELLER002"} {
+		input := suite.input()
+		input.SellerID = sellerID
+
+		result, err := suite.useCase().Execute(context.Background(), input)
+
+		assert.Nil(suite.T(), result)
+		assert.ErrorIs(suite.T(), err, errors.ErrForbidden)
+		assert.Equal(suite.T(), http.StatusForbidden, errors.GetStatusCode(err))
+	}
+
+	suite.questionRepositoryMock.AssertNotCalled(suite.T(), "AnswerQuestion", mock.Anything, mock.Anything)
+}
+
+func (suite *AnswerQuestionUseCaseTestSuite) TestAnswerQuestionUseCase_Execute_EmptyAnswer() {
+	input := suite.input()
+	input.Answer = "  "
+
+	result, err := suite.useCase().Execute(context.Background(), input)
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrInvalidInput)
+	suite.repositoryMock.AssertNotCalled(suite.T(), "GetProduct", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *AnswerQuestionUseCaseTestSuite) TestAnswerQuestionUseCase_Execute_AlreadyAnswered() {
+	answer := "No."
+	suite.questionRepositoryMock.On("GetQuestion", mock.Anything, "MLB001", int64(3)).
+		Return(&entity.Question{ID: 3, ProductID: "MLB001", Status: entity.QuestionAnswered, Answer: &answer}, nil)
+
+	result, err := suite.useCase().Execute(context.Background(), suite.input())
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrQuestionAnswered)
+	suite.questionRepositoryMock.AssertNotCalled(suite.T(), "AnswerQuestion", mock.Anything, mock.Anything)
+}
+
+func (suite *AnswerQuestionUseCaseTestSuite) TestAnswerQuestionUseCase_Execute_Hidden() {
+	suite.questionRepositoryMock.On("GetQuestion", mock.Anything, "MLB001", int64(3)).
+		Return(&entity.Question{ID: 3, ProductID: "MLB001", Status: entity.QuestionHidden}, nil)
+
+	result, err := suite.useCase().Execute(context.Background(), suite.input())
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrQuestionNotFound)
+}
+
+func TestAnswerQuestionUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(AnswerQuestionUseCaseTestSuite))
+}
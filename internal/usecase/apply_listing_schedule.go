@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	stdErrors "errors"
+	"fmt"
+	"project/internal/errors"
+	"project/internal/repository"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ApplyListingScheduleUseCase publishes scheduled listings whose publish_at
+// has passed and closes listings past their expires_at. It keeps no state of
+// its own, so a run after a restart catches up with everything that fell due
+// while the service was down.
+type ApplyListingScheduleUseCase struct {
+	productRepository repository.ProductRepositoryInterface
+	now               func() time.Time
+}
+
+func NewApplyListingScheduleUseCase(productRepo repository.ProductRepositoryInterface) *ApplyListingScheduleUseCase {
+	return &ApplyListingScheduleUseCase{
+		productRepository: productRepo,
+		now:               time.Now,
+	}
+}
+
+// Execute returns how many listings changed status. A listing whose status
+// changed since it was read, as one a seller just paused, is skipped; the
+// next run sees it again if it is still due.
+func (p *ApplyListingScheduleUseCase) Execute(ctx context.Context) (int, error) {
+	now := p.now().UTC()
+
+	log.Debug().
+		Time("at", now).
+		Msg("Executing ApplyListingSchedule use case")
+
+	products, err := p.productRepository.ListScheduleDue(ctx, now)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to list listings due by their schedule")
+		return 0, fmt.Errorf("failed to list listings due by their schedule: %w", err)
+	}
+
+	applied := 0
+	for i := range products {
+		product := &products[i]
+		from := product.Status
+		product.ApplySchedule(now)
+
+		if err := p.productRepository.UpdateProductStatus(ctx, product); err != nil {
+			if stdErrors.Is(err, errors.ErrInvalidStatusTransition) {
+				log.Warn().
+					Str("product_id", product.ID).
+					Str("from", from).
+					Msg("Listing status changed before its schedule was applied")
+				continue
+			}
+			log.Error().
+				Err(err).
+				Str("product_id", product.ID).
+				Msg("Failed to update product status in repository")
+			return applied, fmt.Errorf("failed to update product status: %w", err)
+		}
+
+		log.Debug().
+			Str("product_id", product.ID).
+			Str("from", from).
+			Str("to", product.Status).
+			Msg("Listing schedule applied")
+		applied++
+	}
+
+	log.Info().
+		Int("applied_count", applied).
+		Msg("Listing schedules applied")
+
+	return applied, nil
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestApplyListingScheduleUseCase_Execute_PublishesAndExpires(t *testing.T) {
+	now := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+	publishAt := now.Add(-time.Minute)
+	expiresAt := now.Add(-time.Second)
+
+	repositoryMock := new(repository.MockProductRepository)
+	repositoryMock.On("ListScheduleDue", mock.Anything, now).Return([]entity.Product{
+		{ID: "MLB001", Stock: 5, Status: entity.ProductScheduled, PublishAt: &publishAt},
+		{ID: "MLB002", Stock: 0, Status: entity.ProductScheduled, PublishAt: &publishAt},
+		{ID: "MLB003", Stock: 5, Status: entity.ProductActive, ExpiresAt: &expiresAt},
+	}, nil)
+	repositoryMock.On("UpdateProductStatus", mock.Anything, mock.Anything).Return(nil)
+
+	useCase := NewApplyListingScheduleUseCase(repositoryMock)
+	useCase.now = func() time.Time { return now }
+	applied, err := useCase.Execute(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, applied)
+
+	expected := map[string]struct{ status, reason string }{
+		"MLB001": {entity.ProductActive, entity.ReasonPublished},
+		"MLB002": {entity.ProductPaused, entity.ReasonOutOfStock},
+		"MLB003": {entity.ProductClosed, entity.ReasonExpired},
+	}
+	for _, call := range repositoryMock.Calls {
+		if call.Method != "UpdateProductStatus" {
+			continue
+		}
+		product := call.Arguments.Get(1).(*entity.Product)
+		assert.Equal(t, expected[product.ID].status, product.Status)
+		assert.Len(t, product.StatusChanges, 1)
+		assert.Equal(t, entity.ActorSystem, product.StatusChanges[0].Actor)
+		assert.Equal(t, expected[product.ID].reason, product.StatusChanges[0].Reason)
+		assert.Equal(t, now, product.StatusChanges[0].CreatedAt)
+	}
+}
+
+func TestApplyListingScheduleUseCase_Execute_SkipsChangedListings(t *testing.T) {
+	now := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+	publishAt := now.Add(-time.Minute)
+
+	repositoryMock := new(repository.MockProductRepository)
+	repositoryMock.On("ListScheduleDue", mock.Anything, now).Return([]entity.Product{
+		{ID: "MLB001", Stock: 5, Status: entity.ProductScheduled, PublishAt: &publishAt},
+		{ID: "MLB002", Stock: 5, Status: entity.ProductScheduled, PublishAt: &publishAt},
+	}, nil)
+	repositoryMock.On("UpdateProductStatus", mock.Anything, mock.MatchedBy(func(product *entity.Product) bool {
+		return product.ID == "MLB001"
+	})).Return(errors.ErrInvalidStatusTransition)
+	repositoryMock.On("UpdateProductStatus", mock.Anything, mock.MatchedBy(func(product *entity.Product) bool {
+		return product.ID == "MLB002"
+	})).Return(nil)
+
+	useCase := NewApplyListingScheduleUseCase(repositoryMock)
+	useCase.now = func() time.Time { return now }
+	applied, err := useCase.Execute(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, applied)
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestApplyListingScheduleUseCase_Execute_RepositoryError(t *testing.T) {
+	repositoryMock := new(repository.MockProductRepository)
+	repositoryMock.On("ListScheduleDue", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("%w: disk I/O error", errors.ErrDatabaseError))
+
+	useCase := NewApplyListingScheduleUseCase(repositoryMock)
+	applied, err := useCase.Execute(context.Background())
+
+	assert.Zero(t, applied)
+	assert.ErrorIs(t, err, errors.ErrDatabaseError)
+	repositoryMock.AssertNotCalled(t, "UpdateProductStatus", mock.Anything, mock.Anything)
+}
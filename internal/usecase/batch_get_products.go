@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// MaxBatchGetIDs is the most product IDs accepted by one batch get.
+const MaxBatchGetIDs = MaxPageLimit
+
+type BatchGetProductsUseCase struct {
+	productRepository         repository.ProductRepositoryInterface
+	categoryRepository        repository.CategoryRepositoryInterface
+	exchangeRateRepository    repository.ExchangeRateRepositoryInterface
+	installmentPlanRepository repository.InstallmentPlanRepositoryInterface
+	promotionRepository       repository.PromotionRepositoryInterface
+	now                       func() time.Time
+}
+
+func NewBatchGetProductsUseCase(productRepo repository.ProductRepositoryInterface, categoryRepo repository.CategoryRepositoryInterface, exchangeRateRepo repository.ExchangeRateRepositoryInterface, installmentPlanRepo repository.InstallmentPlanRepositoryInterface, promotionRepo repository.PromotionRepositoryInterface) *BatchGetProductsUseCase {
+	return &BatchGetProductsUseCase{
+		productRepository:         productRepo,
+		categoryRepository:        categoryRepo,
+		exchangeRateRepository:    exchangeRateRepo,
+		installmentPlanRepository: installmentPlanRepo,
+		promotionRepository:       promotionRepo,
+		now:                       time.Now,
+	}
+}
+
+// Execute loads the requested products, all their images, variations and
+// category breadcrumbs in four queries, whatever the number of IDs. Duplicate
+// IDs are returned once and each ID that is not found gets its own error
+// instead of failing the whole batch.
+func (p *BatchGetProductsUseCase) Execute(ctx context.Context, input dto.BatchGetProductsInputDTO) (*dto.ProductBatchDTO, error) {
+	log.Debug().
+		Strs("product_ids", input.IDs).
+		Bool("include_deleted", input.IncludeDeleted).
+		Str("currency", input.Currency).
+		Str("price_format", input.PriceFormat).
+		Msg("Executing BatchGetProducts use case")
+
+	ids := uniqueIDs(input.IDs)
+	if len(ids) == 0 {
+		log.Warn().Msg("Invalid batch get: no product IDs")
+		return nil, errors.NewInvalidInputError("ids must contain at least one product ID")
+	}
+	if len(ids) > MaxBatchGetIDs {
+		log.Warn().Int("ids_count", len(ids)).Msg("Invalid batch get: too many product IDs")
+		return nil, errors.NewInvalidInputError("ids must contain at most " + strconv.Itoa(MaxBatchGetIDs) + " product IDs")
+	}
+
+	converter, err := newPriceConverter(ctx, p.exchangeRateRepository, input.Currency, input.PriceFormat)
+	if err != nil {
+		log.Warn().Err(err).Str("currency", input.Currency).Msg("Failed to prepare price conversion")
+		return nil, err
+	}
+
+	products, err := p.productRepository.GetProductsByIDs(ctx, ids, input.IncludeDeleted)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to get products from repository")
+		return nil, fmt.Errorf("failed to get products: %w", err)
+	}
+
+	now := p.now()
+	showListingStatus(products, now)
+
+	// Listings the caller may not see are reported as not found.
+	visible := make([]entity.Product, 0, len(products))
+	for _, product := range products {
+		if isListingVisible(product, input.SellerID, input.IsAdmin) {
+			visible = append(visible, product)
+		}
+	}
+	products = visible
+
+	productsByID := make(map[string]entity.Product, len(products))
+	foundIDs := make([]string, 0, len(products))
+	for _, product := range products {
+		productsByID[product.ID] = product
+		foundIDs = append(foundIDs, product.ID)
+	}
+
+	imagesByProduct, err := p.productRepository.FindImagesByProductIDs(ctx, foundIDs)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to get product images")
+		return nil, fmt.Errorf("failed to get product images: %w", err)
+	}
+
+	variationsByProduct, err := p.productRepository.FindVariationsByProductIDs(ctx, foundIDs)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to get product variations")
+		return nil, fmt.Errorf("failed to get product variations: %w", err)
+	}
+
+	breadcrumbs, err := productBreadcrumbs(ctx, p.categoryRepository, products)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to get product category breadcrumbs")
+		return nil, err
+	}
+
+	promotions, err := newPromotionApplier(ctx, p.promotionRepository, p.categoryRepository, products, now)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to prepare promotions")
+		return nil, err
+	}
+
+	result := &dto.ProductBatchDTO{
+		Products: make([]dto.ProductDTO, 0, len(products)),
+		Errors:   []dto.ProductBatchErrorDTO{},
+	}
+	for _, id := range ids {
+		product, ok := productsByID[id]
+		if !ok {
+			result.Errors = append(result.Errors, toProductBatchErrorDTO(id, errors.ErrProductNotFound))
+			continue
+		}
+		product.Variations = variationsByProduct[id]
+		productDto := toGetProductDTO(product, imagesByProduct[id])
+		if product.CategoryID != nil {
+			productDto.Breadcrumbs = breadcrumbs[*product.CategoryID]
+		}
+		promotions.applyProduct(product, productDto)
+		result.Products = append(result.Products, *productDto)
+	}
+	converter.convert(result.Products)
+
+	installments, err := newInstallmentCalculator(ctx, p.installmentPlanRepository)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to prepare installments")
+		return nil, err
+	}
+	installments.apply(result.Products)
+
+	log.Info().
+		Int("products_count", len(result.Products)).
+		Int("missing_count", len(result.Errors)).
+		Msg("Products batch retrieved successfully")
+
+	return result, nil
+}
+
+// uniqueIDs trims ids and drops empty and repeated ones, keeping the first
+// occurrence order.
+func uniqueIDs(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	unique := make([]string, 0, len(ids))
+
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+	}
+
+	return unique
+}
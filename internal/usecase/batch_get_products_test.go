@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type BatchGetProductsUseCaseTestSuite struct {
+	suite.Suite
+	repositoryMock                *repository.MockProductRepository
+	categoryRepositoryMock        *repository.MockCategoryRepository
+	exchangeRateRepositoryMock    *repository.MockExchangeRateRepository
+	installmentPlanRepositoryMock *repository.MockInstallmentPlanRepository
+	promotionRepositoryMock       *repository.MockPromotionRepository
+}
+
+func (suite *BatchGetProductsUseCaseTestSuite) BeforeTest(suiteName, testName string) {
+	suite.repositoryMock = new(repository.MockProductRepository)
+	suite.categoryRepositoryMock = new(repository.MockCategoryRepository)
+	suite.exchangeRateRepositoryMock = new(repository.MockExchangeRateRepository)
+	suite.installmentPlanRepositoryMock = new(repository.MockInstallmentPlanRepository)
+	suite.installmentPlanRepositoryMock.On("ListInstallmentPlans", mock.Anything).Return([]entity.InstallmentPlan{}, nil)
+	suite.promotionRepositoryMock = new(repository.MockPromotionRepository)
+	suite.promotionRepositoryMock.On("ListPromotions", mock.Anything, mock.Anything).Return([]entity.Promotion{}, nil)
+}
+
+func (suite *BatchGetProductsUseCaseTestSuite) TestBatchGetProductsUseCase_Execute_Success() {
+	products := []entity.Product{
+		{ID: "MLB002", Status: entity.ProductActive, Title: "Notebook", SellerID: "SELLER002"},
+		{ID: "MLB001", Status: entity.ProductActive, Title: "iPhone", SellerID: "SELLER001"},
+	}
+	images := map[string][]entity.ProductImage{
+		"MLB001": {{ID: 1, ProductID: "MLB001", ImageURL: "https://example.com/1.jpg"}},
+	}
+	variations := map[string][]entity.Variation{
+		"MLB001": {
+			{ID: 1, ProductID: "MLB001", Attributes: entity.ProductAttributes{"color": "Black"}, Price: entity.Money{Amount: 99900, Currency: "USD"}, Stock: 3},
+			{ID: 2, ProductID: "MLB001", Attributes: entity.ProductAttributes{"color": "White"}, Price: entity.Money{Amount: 109900, Currency: "USD"}, Stock: 2},
+		},
+	}
+
+	suite.repositoryMock.On("GetProductsByIDs", mock.Anything, []string{"MLB001", "MLB999", "MLB002"}, false).Return(products, nil).Once()
+	suite.repositoryMock.On("FindImagesByProductIDs", mock.Anything, []string{"MLB002", "MLB001"}).Return(images, nil).Once()
+	suite.repositoryMock.On("FindVariationsByProductIDs", mock.Anything, []string{"MLB002", "MLB001"}).Return(variations, nil).Once()
+
+	useCase := NewBatchGetProductsUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.BatchGetProductsInputDTO{
+		IDs: []string{"MLB001", " MLB999 ", "", "MLB002", "MLB001"},
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Products, 2)
+	assert.Equal(suite.T(), "MLB001", result.Products[0].ID)
+	assert.Equal(suite.T(), "SELLER001", result.Products[0].SellerID)
+	assert.Len(suite.T(), result.Products[0].Images, 1)
+	assert.Len(suite.T(), result.Products[0].Variations, 2)
+	assert.Equal(suite.T(), "White", result.Products[0].Variations[1].Attributes["color"])
+	assert.Equal(suite.T(), 1099.0, result.Products[0].Variations[1].Price)
+	assert.Equal(suite.T(), "MLB002", result.Products[1].ID)
+	assert.Empty(suite.T(), result.Products[1].Images)
+	assert.Empty(suite.T(), result.Products[1].Variations)
+	assert.Equal(suite.T(), []dto.ProductBatchErrorDTO{{
+		ID:    "MLB999",
+		Code:  "PRODUCT_NOT_FOUND",
+		Error: "The requested product was not found",
+	}}, result.Errors)
+	suite.repositoryMock.AssertNotCalled(suite.T(), "GetProduct", mock.Anything, mock.Anything, mock.Anything)
+	suite.repositoryMock.AssertNotCalled(suite.T(), "FindImagesByProductID", mock.Anything, mock.Anything)
+	suite.repositoryMock.AssertNotCalled(suite.T(), "FindVariationsByProductID", mock.Anything, mock.Anything)
+}
+
+func (suite *BatchGetProductsUseCaseTestSuite) TestBatchGetProductsUseCase_Execute_IncludeDeleted() {
+	suite.repositoryMock.On("GetProductsByIDs", mock.Anything, []string{"MLB001"}, true).Return([]entity.Product{}, nil)
+	suite.repositoryMock.On("FindImagesByProductIDs", mock.Anything, []string{}).Return(map[string][]entity.ProductImage{}, nil)
+	suite.repositoryMock.On("FindVariationsByProductIDs", mock.Anything, []string{}).Return(map[string][]entity.Variation{}, nil)
+
+	useCase := NewBatchGetProductsUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.BatchGetProductsInputDTO{
+		IDs:            []string{"MLB001"},
+		IncludeDeleted: true,
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), result.Products)
+	assert.Len(suite.T(), result.Errors, 1)
+	suite.repositoryMock.AssertExpectations(suite.T())
+}
+
+func (suite *BatchGetProductsUseCaseTestSuite) TestBatchGetProductsUseCase_Execute_ListingNotActive() {
+	products := []entity.Product{
+		{ID: "MLB001", SellerID: "SELLER001", Status: entity.ProductActive},
+		{ID: "MLB002", SellerID: "SELLER002", Status: entity.ProductPaused},
+	}
+
+	suite.repositoryMock.On("GetProductsByIDs", mock.Anything, []string{"MLB001", "MLB002"}, false).Return(products, nil)
+	suite.repositoryMock.On("FindImagesByProductIDs", mock.Anything, mock.Anything).Return(map[string][]entity.ProductImage{}, nil)
+	suite.repositoryMock.On("FindVariationsByProductIDs", mock.Anything, mock.Anything).Return(map[string][]entity.Variation{}, nil)
+
+	useCase := NewBatchGetProductsUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+
+	result, err := useCase.Execute(context.Background(), dto.BatchGetProductsInputDTO{IDs: []string{"MLB001", "MLB002"}})
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Products, 1)
+	assert.Equal(suite.T(), "MLB001", result.Products[0].ID)
+	assert.Len(suite.T(), result.Errors, 1)
+	assert.Equal(suite.T(), "MLB002", result.Errors[0].ID)
+
+	result, err = useCase.Execute(context.Background(), dto.BatchGetProductsInputDTO{IDs: []string{"MLB001", "MLB002"}, SellerID: "SELLER002"})
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Products, 2)
+	assert.Empty(suite.T(), result.Errors)
+}
+
+func (suite *BatchGetProductsUseCaseTestSuite) TestBatchGetProductsUseCase_Execute_InvalidInput() {
+	tooMany := make([]string, 0, MaxBatchGetIDs+1)
+	for i := 0; i <= MaxBatchGetIDs; i++ {
+		tooMany = append(tooMany, fmt.Sprintf("MLB%03d", i))
+	}
+
+	tests := []struct {
+		name string
+		ids  []string
+	}{
+		{name: "no IDs", ids: nil},
+		{name: "only blank IDs", ids: []string{" ", ""}},
+		{name: "too many IDs", ids: tooMany},
+	}
+
+	useCase := NewBatchGetProductsUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			result, err := useCase.Execute(context.Background(), dto.BatchGetProductsInputDTO{IDs: tt.ids})
+
+			assert.Nil(suite.T(), result)
+			assert.ErrorIs(suite.T(), err, errors.ErrInvalidInput)
+		})
+	}
+	suite.repositoryMock.AssertNotCalled(suite.T(), "GetProductsByIDs", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *BatchGetProductsUseCaseTestSuite) TestBatchGetProductsUseCase_Execute_DatabaseError() {
+	suite.repositoryMock.On("GetProductsByIDs", mock.Anything, mock.Anything, false).Return(nil, fmt.Errorf("%w: connection timeout", errors.ErrDatabaseError))
+
+	useCase := NewBatchGetProductsUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.BatchGetProductsInputDTO{IDs: []string{"MLB001"}})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrDatabaseError)
+}
+
+func (suite *BatchGetProductsUseCaseTestSuite) TestBatchGetProductsUseCase_Execute_VariationsError() {
+	suite.repositoryMock.On("GetProductsByIDs", mock.Anything, []string{"MLB001"}, false).Return([]entity.Product{{ID: "MLB001", Status: entity.ProductActive}}, nil)
+	suite.repositoryMock.On("FindImagesByProductIDs", mock.Anything, []string{"MLB001"}).Return(map[string][]entity.ProductImage{}, nil)
+	suite.repositoryMock.On("FindVariationsByProductIDs", mock.Anything, []string{"MLB001"}).Return(nil, fmt.Errorf("%w: connection timeout", errors.ErrDatabaseError))
+
+	useCase := NewBatchGetProductsUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.BatchGetProductsInputDTO{IDs: []string{"MLB001"}})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrDatabaseError)
+}
+
+func TestBatchGetProductsUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(BatchGetProductsUseCaseTestSuite))
+}
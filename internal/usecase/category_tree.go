@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/repository"
+	"time"
+)
+
+// categoryTree indexes the categories by ID and parent, with the product
+// count of every category including its subcategories.
+type categoryTree struct {
+	byID          map[int64]entity.Category
+	children      map[int64][]entity.Category
+	roots         []entity.Category
+	productCounts map[int64]int
+}
+
+// loadCategoryTree reads every category and the per-category counts of the
+// products active at now in two queries.
+func loadCategoryTree(ctx context.Context, categoryRepository repository.CategoryRepositoryInterface, now time.Time) (*categoryTree, error) {
+	categories, err := categoryRepository.ListCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	directCounts, err := categoryRepository.CountProductsByCategory(ctx, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count products by category: %w", err)
+	}
+
+	tree := &categoryTree{
+		byID:          make(map[int64]entity.Category, len(categories)),
+		children:      map[int64][]entity.Category{},
+		productCounts: make(map[int64]int, len(categories)),
+	}
+
+	for _, category := range categories {
+		tree.byID[category.ID] = category
+		if category.ParentID == nil {
+			tree.roots = append(tree.roots, category)
+		} else {
+			tree.children[*category.ParentID] = append(tree.children[*category.ParentID], category)
+		}
+	}
+
+	// Each direct count is added to the category and all of its ancestors.
+	for id, count := range directCounts {
+		for category, ok := tree.byID[id]; ok; {
+			tree.productCounts[category.ID] += count
+			if category.ParentID == nil {
+				break
+			}
+			category, ok = tree.byID[*category.ParentID]
+		}
+	}
+
+	return tree, nil
+}
+
+func (t *categoryTree) node(category entity.Category) dto.CategoryNodeDTO {
+	node := dto.CategoryNodeDTO{
+		ID:           category.ID,
+		Name:         category.Name,
+		Path:         category.Path,
+		ProductCount: t.productCounts[category.ID],
+		Children:     make([]dto.CategoryNodeDTO, 0, len(t.children[category.ID])),
+	}
+
+	for _, child := range t.children[category.ID] {
+		node.Children = append(node.Children, t.node(child))
+	}
+
+	return node
+}
+
+// breadcrumbs returns the categories from the root down to id.
+func (t *categoryTree) breadcrumbs(id int64) []dto.CategoryRefDTO {
+	var path []entity.Category
+	for category, ok := t.byID[id]; ok; {
+		path = append([]entity.Category{category}, path...)
+		if category.ParentID == nil {
+			break
+		}
+		category, ok = t.byID[*category.ParentID]
+	}
+
+	return toCategoryRefsDTO(path)
+}
+
+// productBreadcrumbs loads the breadcrumbs of the categories of products in
+// a single query, keyed by category ID.
+func productBreadcrumbs(ctx context.Context, categoryRepository repository.CategoryRepositoryInterface, products []entity.Product) (map[int64][]dto.CategoryRefDTO, error) {
+	breadcrumbs := map[int64][]dto.CategoryRefDTO{}
+
+	seen := map[int64]bool{}
+	var categoryIDs []int64
+	for _, product := range products {
+		if product.CategoryID != nil && !seen[*product.CategoryID] {
+			seen[*product.CategoryID] = true
+			categoryIDs = append(categoryIDs, *product.CategoryID)
+		}
+	}
+	if len(categoryIDs) == 0 {
+		return breadcrumbs, nil
+	}
+
+	paths, err := categoryRepository.FindCategoryPaths(ctx, categoryIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category breadcrumbs: %w", err)
+	}
+
+	for id, path := range paths {
+		breadcrumbs[id] = toCategoryRefsDTO(path)
+	}
+
+	return breadcrumbs, nil
+}
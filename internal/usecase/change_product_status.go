@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type ChangeProductStatusUseCase struct {
+	productRepository repository.ProductRepositoryInterface
+	detail            productDetail
+	now               func() time.Time
+}
+
+func NewChangeProductStatusUseCase(productRepo repository.ProductRepositoryInterface, categoryRepo repository.CategoryRepositoryInterface, installmentPlanRepo repository.InstallmentPlanRepositoryInterface, promotionRepo repository.PromotionRepositoryInterface) *ChangeProductStatusUseCase {
+	return &ChangeProductStatusUseCase{
+		productRepository: productRepo,
+		detail:            newProductDetail(productRepo, categoryRepo, installmentPlanRepo, promotionRepo),
+		now:               time.Now,
+	}
+}
+
+// Execute moves a listing to another status and records who did it. The
+// seller of the product and administrators may change its status, but only
+// administrators put a listing under review or take it out of review.
+// Changing to the current status is a no-op, and scheduling a listing needs a
+// future publish_at. The product is returned as GetProduct returns it.
+func (p *ChangeProductStatusUseCase) Execute(ctx context.Context, input dto.ChangeProductStatusInputDTO) (*dto.ProductDTO, error) {
+	log.Debug().
+		Str("product_id", input.ProductID).
+		Str("status", input.Status).
+		Str("seller_id", input.SellerID).
+		Bool("is_admin", input.IsAdmin).
+		Msg("Executing ChangeProductStatus use case")
+
+	if strings.TrimSpace(input.ProductID) == "" {
+		log.Warn().Msg("Invalid product ID: empty or whitespace")
+		return nil, errors.ErrInvalidProductID
+	}
+
+	product, err := p.productRepository.GetProduct(ctx, input.ProductID, false)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ProductID).
+			Msg("Failed to get product from repository")
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	// The publishing window settles the status first, so that a listing past
+	// its expires_at cannot be activated again.
+	now := p.now().UTC()
+	product.ApplySchedule(now)
+
+	actor, actorID := entity.ActorAdmin, ""
+	if !input.IsAdmin {
+		if input.SellerID == "" || input.SellerID != product.SellerID {
+			log.Warn().
+				Str("product_id", input.ProductID).
+				Str("seller_id", input.SellerID).
+				Msg("Status changed by someone other than the seller")
+			return nil, errors.NewForbiddenError("Only the seller of the product can change its status")
+		}
+
+		if input.Status != product.Status && (input.Status == entity.ProductUnderReview || product.Status == entity.ProductUnderReview) {
+			log.Warn().
+				Str("product_id", input.ProductID).
+				Str("status", input.Status).
+				Msg("Review status changed by the seller")
+			return nil, errors.NewForbiddenError("Only administrators can put a listing under review or take it out of review")
+		}
+
+		actor, actorID = entity.ActorSeller, input.SellerID
+	}
+
+	from := product.Status
+	if err := product.ChangeStatus(input.Status, actor, actorID, strings.TrimSpace(input.Reason), now); err != nil {
+		log.Warn().
+			Err(err).
+			Str("product_id", input.ProductID).
+			Str("from", from).
+			Str("to", input.Status).
+			Msg("Invalid status change")
+		return nil, err
+	}
+
+	if err := p.productRepository.UpdateProductStatus(ctx, product); err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ProductID).
+			Msg("Failed to update product status in repository")
+		return nil, fmt.Errorf("failed to update product status: %w", err)
+	}
+
+	log.Info().
+		Str("product_id", product.ID).
+		Str("from", from).
+		Str("to", product.Status).
+		Str("actor", actor).
+		Msg("Product status changed successfully")
+
+	return p.detail.build(ctx, product, nil, now)
+}
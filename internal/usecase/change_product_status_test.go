@@ -0,0 +1,244 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ChangeProductStatusUseCaseTestSuite struct {
+	suite.Suite
+	repositoryMock                *repository.MockProductRepository
+	categoryRepositoryMock        *repository.MockCategoryRepository
+	installmentPlanRepositoryMock *repository.MockInstallmentPlanRepository
+	promotionRepositoryMock       *repository.MockPromotionRepository
+	now                           time.Time
+}
+
+func (suite *ChangeProductStatusUseCaseTestSuite) BeforeTest(suiteName, testName string) {
+	suite.repositoryMock = new(repository.MockProductRepository)
+	suite.repositoryMock.On("FindVariationsByProductID", mock.Anything, mock.Anything).Return([]entity.Variation{}, nil)
+	suite.categoryRepositoryMock = new(repository.MockCategoryRepository)
+	suite.installmentPlanRepositoryMock = new(repository.MockInstallmentPlanRepository)
+	suite.installmentPlanRepositoryMock.On("ListInstallmentPlans", mock.Anything).Return([]entity.InstallmentPlan{}, nil)
+	suite.promotionRepositoryMock = new(repository.MockPromotionRepository)
+	suite.promotionRepositoryMock.On("ListPromotions", mock.Anything, mock.Anything).Return([]entity.Promotion{}, nil)
+	suite.now = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+}
+
+func (suite *ChangeProductStatusUseCaseTestSuite) useCase() *ChangeProductStatusUseCase {
+	useCase := NewChangeProductStatusUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	useCase.now = func() time.Time { return suite.now }
+	return useCase
+}
+
+func (suite *ChangeProductStatusUseCaseTestSuite) product(status string) *entity.Product {
+	return &entity.Product{ID: "MLB001", SellerID: "SELLER001", Status: status, Stock: 5}
+}
+
+func (suite *ChangeProductStatusUseCaseTestSuite) TestChangeProductStatusUseCase_Execute_BySeller() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(suite.product(entity.ProductActive), nil)
+	suite.repositoryMock.On("UpdateProductStatus", mock.Anything, mock.Anything).Return(nil)
+	suite.repositoryMock.On("FindImagesByProductID", mock.Anything, "MLB001").Return([]entity.ProductImage{}, nil)
+
+	result, err := suite.useCase().Execute(context.Background(), dto.ChangeProductStatusInputDTO{
+		ProductID: "MLB001",
+		SellerID:  "SELLER001",
+		Status:    entity.ProductPaused,
+		Reason:    " Seller on vacation ",
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), entity.ProductPaused, result.Status)
+	assert.Equal(suite.T(), suite.now, result.UpdatedAt)
+	suite.repositoryMock.AssertCalled(suite.T(), "UpdateProductStatus", mock.Anything, mock.MatchedBy(func(product *entity.Product) bool {
+		return assert.ObjectsAreEqual([]entity.StatusChange{{
+			ProductID: "MLB001",
+			From:      entity.ProductActive,
+			To:        entity.ProductPaused,
+			Actor:     entity.ActorSeller,
+			ActorID:   "SELLER001",
+			Reason:    "Seller on vacation",
+			CreatedAt: suite.now,
+		}}, product.StatusChanges)
+	}))
+}
+
+func (suite *ChangeProductStatusUseCaseTestSuite) TestChangeProductStatusUseCase_Execute_ByAdmin() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(suite.product(entity.ProductActive), nil)
+	suite.repositoryMock.On("UpdateProductStatus", mock.Anything, mock.Anything).Return(nil)
+	suite.repositoryMock.On("FindImagesByProductID", mock.Anything, "MLB001").Return([]entity.ProductImage{}, nil)
+
+	result, err := suite.useCase().Execute(context.Background(), dto.ChangeProductStatusInputDTO{ProductID: "MLB001", IsAdmin: true, Status: entity.ProductUnderReview})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), entity.ProductUnderReview, result.Status)
+	suite.repositoryMock.AssertCalled(suite.T(), "UpdateProductStatus", mock.Anything, mock.MatchedBy(func(product *entity.Product) bool {
+		return len(product.StatusChanges) == 1 && product.StatusChanges[0].Actor == entity.ActorAdmin && product.StatusChanges[0].ActorID == ""
+	}))
+}
+
+func (suite *ChangeProductStatusUseCaseTestSuite) TestChangeProductStatusUseCase_Execute_MatchesGetProduct() {
+	categoryID := int64(6)
+	product := suite.product(entity.ProductActive)
+	product.Price = entity.Money{Amount: 99999, Currency: "USD"}
+	product.Category = "Electronics > Smartphones"
+	product.CategoryID = &categoryID
+
+	repositoryMock := new(repository.MockProductRepository)
+	repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(product, nil)
+	repositoryMock.On("UpdateProductStatus", mock.Anything, mock.Anything).Return(nil)
+	repositoryMock.On("FindImagesByProductID", mock.Anything, "MLB001").Return([]entity.ProductImage{
+		{ID: 1, ProductID: "MLB001", ImageURL: "http://example.com/image1.jpg"},
+	}, nil)
+	repositoryMock.On("FindVariationsByProductID", mock.Anything, "MLB001").Return([]entity.Variation{
+		{ID: 1, ProductID: "MLB001", Price: entity.Money{Amount: 109999, Currency: "USD"}, Stock: 2},
+	}, nil)
+	suite.categoryRepositoryMock.On("FindCategoryPaths", mock.Anything, []int64{6}).Return(map[int64][]entity.Category{
+		6: {
+			{ID: 1, Name: "Electronics", Path: "Electronics"},
+			{ID: 6, ParentID: ptr(int64(1)), Name: "Smartphones", Path: "Electronics > Smartphones"},
+		},
+	}, nil)
+	installmentPlanRepositoryMock := new(repository.MockInstallmentPlanRepository)
+	installmentPlanRepositoryMock.On("ListInstallmentPlans", mock.Anything).Return([]entity.InstallmentPlan{
+		{Installments: 3, MinInstallment: entity.Money{Amount: 1000, Currency: "USD"}},
+	}, nil)
+	promotionRepositoryMock := new(repository.MockPromotionRepository)
+	promotionRepositoryMock.On("ListPromotions", mock.Anything, mock.Anything).Return([]entity.Promotion{
+		{ID: 3, ProductID: ptr("MLB001"), Type: entity.PromotionPercentage, PercentOff: 1500},
+	}, nil)
+
+	changeUseCase := NewChangeProductStatusUseCase(repositoryMock, suite.categoryRepositoryMock, installmentPlanRepositoryMock, promotionRepositoryMock)
+	changeUseCase.now = func() time.Time { return suite.now }
+	changed, err := changeUseCase.Execute(context.Background(), dto.ChangeProductStatusInputDTO{ProductID: "MLB001", SellerID: "SELLER001", Status: entity.ProductPaused})
+	assert.NoError(suite.T(), err)
+
+	getUseCase := NewGetProductUseCase(repositoryMock, suite.categoryRepositoryMock, new(repository.MockQuestionRepository), new(repository.MockExchangeRateRepository), installmentPlanRepositoryMock, promotionRepositoryMock)
+	getUseCase.now = func() time.Time { return suite.now }
+	got, err := getUseCase.Execute(context.Background(), dto.ProductInputDTO{ID: "MLB001", SellerID: "SELLER001"})
+	assert.NoError(suite.T(), err)
+
+	// The status change answers with the product as GetProduct shows it.
+	assert.Equal(suite.T(), got, changed)
+	assert.Equal(suite.T(), entity.ProductPaused, changed.Status)
+	assert.Len(suite.T(), changed.Variations, 1)
+	assert.Len(suite.T(), changed.Breadcrumbs, 2)
+	assert.Equal(suite.T(), int64(3), *changed.PromotionID)
+	assert.NotNil(suite.T(), changed.Installments)
+}
+
+func (suite *ChangeProductStatusUseCaseTestSuite) TestChangeProductStatusUseCase_Execute_NotTheSeller() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(suite.product(entity.ProductActive), nil)
+
+	for _, sellerID := range []string{"", "SELLER002"} {
+		result, err := suite.useCase().Execute(context.Background(), dto.ChangeProductStatusInputDTO{ProductID: "MLB001", SellerID: sellerID, Status: entity.ProductPaused})
+
+		assert.Nil(suite.T(), result)
+		assert.ErrorIs(suite.T(), err, errors.ErrForbidden)
+	}
+	suite.repositoryMock.AssertNotCalled(suite.T(), "UpdateProductStatus", mock.Anything, mock.Anything)
+}
+
+func (suite *ChangeProductStatusUseCaseTestSuite) TestChangeProductStatusUseCase_Execute_ReviewIsForAdmins() {
+	tests := []struct {
+		name string
+		from string
+		to   string
+	}{
+		{"Put under review", entity.ProductActive, entity.ProductUnderReview},
+		{"Take out of review", entity.ProductUnderReview, entity.ProductActive},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			repositoryMock := new(repository.MockProductRepository)
+			repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(suite.product(tt.from), nil)
+
+			useCase := NewChangeProductStatusUseCase(repositoryMock, suite.categoryRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+			result, err := useCase.Execute(context.Background(), dto.ChangeProductStatusInputDTO{ProductID: "MLB001", SellerID: "SELLER001", Status: tt.to})
+
+			assert.Nil(suite.T(), result)
+			assert.ErrorIs(suite.T(), err, errors.ErrForbidden)
+			repositoryMock.AssertNotCalled(suite.T(), "UpdateProductStatus", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func (suite *ChangeProductStatusUseCaseTestSuite) TestChangeProductStatusUseCase_Execute_InvalidTransition() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(suite.product(entity.ProductClosed), nil)
+
+	result, err := suite.useCase().Execute(context.Background(), dto.ChangeProductStatusInputDTO{ProductID: "MLB001", SellerID: "SELLER001", Status: entity.ProductActive})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrInvalidStatusTransition)
+	suite.repositoryMock.AssertNotCalled(suite.T(), "UpdateProductStatus", mock.Anything, mock.Anything)
+}
+
+func (suite *ChangeProductStatusUseCaseTestSuite) TestChangeProductStatusUseCase_Execute_Expired() {
+	product := suite.product(entity.ProductPaused)
+	expiresAt := suite.now.Add(-time.Hour)
+	product.ExpiresAt = &expiresAt
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(product, nil)
+
+	result, err := suite.useCase().Execute(context.Background(), dto.ChangeProductStatusInputDTO{ProductID: "MLB001", SellerID: "SELLER001", Status: entity.ProductActive})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrInvalidStatusTransition)
+	suite.repositoryMock.AssertNotCalled(suite.T(), "UpdateProductStatus", mock.Anything, mock.Anything)
+}
+
+func (suite *ChangeProductStatusUseCaseTestSuite) TestChangeProductStatusUseCase_Execute_UnknownStatus() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(suite.product(entity.ProductActive), nil)
+
+	result, err := suite.useCase().Execute(context.Background(), dto.ChangeProductStatusInputDTO{ProductID: "MLB001", IsAdmin: true, Status: "deleted"})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrValidation)
+}
+
+func (suite *ChangeProductStatusUseCaseTestSuite) TestChangeProductStatusUseCase_Execute_SameStatus() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(suite.product(entity.ProductPaused), nil)
+	suite.repositoryMock.On("UpdateProductStatus", mock.Anything, mock.Anything).Return(nil)
+	suite.repositoryMock.On("FindImagesByProductID", mock.Anything, "MLB001").Return([]entity.ProductImage{}, nil)
+
+	result, err := suite.useCase().Execute(context.Background(), dto.ChangeProductStatusInputDTO{ProductID: "MLB001", SellerID: "SELLER001", Status: entity.ProductPaused})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), entity.ProductPaused, result.Status)
+	suite.repositoryMock.AssertCalled(suite.T(), "UpdateProductStatus", mock.Anything, mock.MatchedBy(func(product *entity.Product) bool {
+		return len(product.StatusChanges) == 0
+	}))
+}
+
+func (suite *ChangeProductStatusUseCaseTestSuite) TestChangeProductStatusUseCase_Execute_ProductNotFound() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB999", false).Return(nil, errors.ErrProductNotFound)
+
+	result, err := suite.useCase().Execute(context.Background(), dto.ChangeProductStatusInputDTO{ProductID: "MLB999", IsAdmin: true, Status: entity.ProductPaused})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrProductNotFound)
+}
+
+func (suite *ChangeProductStatusUseCaseTestSuite) TestChangeProductStatusUseCase_Execute_ChangedMeanwhile() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(suite.product(entity.ProductActive), nil)
+	suite.repositoryMock.On("UpdateProductStatus", mock.Anything, mock.Anything).Return(errors.ErrInvalidStatusTransition)
+
+	result, err := suite.useCase().Execute(context.Background(), dto.ChangeProductStatusInputDTO{ProductID: "MLB001", IsAdmin: true, Status: entity.ProductClosed})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrInvalidStatusTransition)
+}
+
+func TestChangeProductStatusUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ChangeProductStatusUseCaseTestSuite))
+}
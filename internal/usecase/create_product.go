@@ -0,0 +1,225 @@
+package usecase
+
+import (
+	"context"
+	stdErrors "errors"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+type CreateProductUseCase struct {
+	productRepository  repository.ProductRepositoryInterface
+	categoryRepository repository.CategoryRepositoryInterface
+}
+
+func NewCreateProductUseCase(productRepo repository.ProductRepositoryInterface, categoryRepo repository.CategoryRepositoryInterface) *CreateProductUseCase {
+	return &CreateProductUseCase{
+		productRepository:  productRepo,
+		categoryRepository: categoryRepo,
+	}
+}
+
+func (p *CreateProductUseCase) Execute(ctx context.Context, input dto.CreateProductInputDTO) (*dto.ProductDTO, error) {
+	id := strings.TrimSpace(input.ID)
+	if id == "" {
+		id = newProductID()
+	}
+
+	log.Debug().
+		Str("product_id", id).
+		Msg("Executing CreateProduct use case")
+
+	fields := input.ProductFieldsDTO
+	validation := &errors.ValidationError{}
+
+	price := newProductPrice(fields, validation)
+
+	product, err := entity.NewProduct(
+		id,
+		fields.Title,
+		fields.Description,
+		price,
+		fields.Condition,
+		fields.Stock,
+		fields.SellerID,
+		fields.SellerName,
+		fields.Category,
+		entity.ProductAttributes(fields.Attributes),
+		newProductShipping(fields),
+	)
+	validation.Merge("", err)
+	if product != nil {
+		validation.Merge("", product.SetSchedule(fields.PublishAt, fields.ExpiresAt, product.CreatedAt))
+	}
+
+	images, err := newProductImages(id, fields.Images)
+	validation.Merge("", err)
+
+	variations := newProductVariations(id, fields, validation)
+	if product != nil {
+		product.SetVariations(variations)
+	}
+
+	if err := validateCategoryAttributes(ctx, p.categoryRepository, fields, variations, validation); err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", id).
+			Msg("Failed to get category attributes")
+		return nil, err
+	}
+
+	if err := validation.Err(); err != nil {
+		log.Warn().
+			Err(err).
+			Str("product_id", id).
+			Msg("Invalid product data")
+		return nil, err
+	}
+
+	product.ApplySchedule(product.CreatedAt)
+	product.PauseIfOutOfStock(product.CreatedAt)
+
+	if err := p.productRepository.CreateProduct(ctx, product, images); err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", id).
+			Msg("Failed to create product in repository")
+		return nil, fmt.Errorf("failed to create product: %w", sellerInputError(err))
+	}
+
+	log.Info().
+		Str("product_id", product.ID).
+		Int("images_count", len(images)).
+		Int("variations_count", len(variations)).
+		Msg("Product created successfully")
+
+	return toGetProductDTO(*product, images), nil
+}
+
+// newProductPrice converts the price of fields to Money, recording in
+// validation why it is invalid. An invalid price keeps its currency, so that
+// validating the product does not report the currency as missing as well.
+func newProductPrice(fields dto.ProductFieldsDTO, validation *errors.ValidationError) entity.Money {
+	price, err := entity.NewMoney(fields.Price, fields.Currency)
+	if err != nil {
+		validation.Merge("", err)
+		return entity.Money{Currency: fields.Currency}
+	}
+
+	return price
+}
+
+// newProductShipping builds the shipping profile of fields, which is empty
+// when fields has none.
+func newProductShipping(fields dto.ProductFieldsDTO) entity.ShippingProfile {
+	if fields.Shipping == nil {
+		return entity.ShippingProfile{}
+	}
+
+	return entity.ShippingProfile{
+		WeightGrams:  fields.Shipping.WeightGrams,
+		LengthCm:     fields.Shipping.LengthCm,
+		WidthCm:      fields.Shipping.WidthCm,
+		HeightCm:     fields.Shipping.HeightCm,
+		FreeShipping: fields.Shipping.FreeShipping,
+		OriginZip:    entity.NormalizeZip(fields.Shipping.OriginZip),
+	}
+}
+
+// newProductImages builds the product images in the order the URLs were
+// given. Every invalid image is reported, under images[i].
+func newProductImages(productID string, urls []string) ([]entity.ProductImage, error) {
+	images := make([]entity.ProductImage, 0, len(urls))
+	validation := &errors.ValidationError{}
+
+	for order, url := range urls {
+		image, err := entity.NewProductImage(productID, strings.TrimSpace(url), order)
+		if err != nil {
+			validation.Merge(fmt.Sprintf("images[%d].", order), err)
+			continue
+		}
+		images = append(images, *image)
+	}
+
+	if err := validation.Err(); err != nil {
+		return nil, err
+	}
+
+	return images, nil
+}
+
+// newProductVariations builds the variations of fields in the order they were
+// given, priced in the currency of the product. Every invalid variation is
+// reported, under variations[i]; an invalid variation is still returned so
+// that the indexes of the others hold.
+func newProductVariations(productID string, fields dto.ProductFieldsDTO, validation *errors.ValidationError) []entity.Variation {
+	variations := make([]entity.Variation, 0, len(fields.Variations))
+
+	for i, variationFields := range fields.Variations {
+		prefix := fmt.Sprintf("variations[%d].", i)
+
+		// An invalid currency is reported once, by the product.
+		price := entity.Money{Currency: fields.Currency}
+		if entity.IsValidCurrency(fields.Currency) {
+			money, err := entity.NewMoney(variationFields.Price, fields.Currency)
+			validation.Merge(prefix, err)
+			if err == nil {
+				price = money
+			}
+		}
+
+		images := make(entity.ImageURLs, 0, len(variationFields.Images))
+		for _, url := range variationFields.Images {
+			images = append(images, strings.TrimSpace(url))
+		}
+
+		variation := entity.Variation{
+			ProductID:  productID,
+			Attributes: entity.ProductAttributes(variationFields.Attributes),
+			Price:      price,
+			Stock:      variationFields.Stock,
+			Images:     images,
+		}
+		validation.Merge(prefix, variation.Validate())
+
+		variations = append(variations, variation)
+	}
+
+	validation.Merge("", entity.ValidateVariations(variations))
+
+	return variations
+}
+
+// validateCategoryAttributes records in validation every attribute of fields
+// or of variations that the category, or one of its ancestors, does not
+// allow, and every required attribute that is missing.
+func validateCategoryAttributes(ctx context.Context, categoryRepo repository.CategoryRepositoryInterface, fields dto.ProductFieldsDTO, variations []entity.Variation, validation *errors.ValidationError) error {
+	definitions, err := categoryRepo.FindCategoryAttributes(ctx, fields.Category)
+	if err != nil {
+		return fmt.Errorf("failed to get category attributes: %w", err)
+	}
+
+	validation.Merge("", entity.ValidateProductAttributes(entity.ProductAttributes(fields.Attributes), variations, definitions))
+
+	return nil
+}
+
+// sellerInputError reports a product write that names an unknown seller
+// without the seller_name needed to register it as invalid input.
+func sellerInputError(err error) error {
+	if stdErrors.Is(err, errors.ErrSellerNotFound) {
+		return errors.NewInvalidInputError("seller_id does not match any seller; send seller_name to register a new seller")
+	}
+	return err
+}
+
+func newProductID() string {
+	return "MLB" + strings.ToUpper(strings.ReplaceAll(uuid.NewString(), "-", "")[:12])
+}
@@ -0,0 +1,420 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CreateProductUseCaseTestSuite struct {
+	suite.Suite
+	repositoryMock         *repository.MockProductRepository
+	categoryRepositoryMock *repository.MockCategoryRepository
+}
+
+func (suite *CreateProductUseCaseTestSuite) BeforeTest(suiteName, testName string) {
+	suite.repositoryMock = new(repository.MockProductRepository)
+	suite.categoryRepositoryMock = new(repository.MockCategoryRepository)
+	suite.categoryRepositoryMock.On("FindCategoryAttributes", mock.Anything, mock.Anything).Return(smartphoneAttributes(), nil)
+}
+
+// smartphoneAttributes are the attribute definitions of Electronics and of
+// Electronics > Smartphones.
+func smartphoneAttributes() []entity.CategoryAttribute {
+	return []entity.CategoryAttribute{
+		{CategoryID: 1, Name: "brand", Type: entity.AttributeString},
+		{CategoryID: 6, Name: "ram_gb", Type: entity.AttributeNumber},
+		{CategoryID: 6, Name: "storage", Type: entity.AttributeString, Required: true},
+	}
+}
+
+func validCreateProductInput() dto.CreateProductInputDTO {
+	return dto.CreateProductInputDTO{
+		ID:               "MLB100",
+		ProductFieldsDTO: validProductFields(),
+	}
+}
+
+func validProductFields() dto.ProductFieldsDTO {
+	return dto.ProductFieldsDTO{
+		Title:       "iPhone 15",
+		Description: "Latest iPhone",
+		Price:       999.99,
+		Currency:    "USD",
+		Condition:   entity.New,
+		Stock:       10,
+		SellerID:    "SELLER001",
+		SellerName:  "TechWorld Store",
+		Category:    "Electronics > Smartphones",
+		Attributes:  map[string]string{"brand": "Apple", "storage": "256GB"},
+		Images: []string{
+			"http://example.com/image1.jpg",
+			"http://example.com/image2.jpg",
+		},
+	}
+}
+
+func (suite *CreateProductUseCaseTestSuite) TestCreateProductUseCase_Execute_Success() {
+	suite.repositoryMock.On("CreateProduct", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			images := args.Get(2).([]entity.ProductImage)
+			for i := range images {
+				images[i].ID = i + 1
+			}
+		}).
+		Return(nil)
+
+	useCase := NewCreateProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), validCreateProductInput())
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	assert.Equal(suite.T(), "MLB100", result.ID)
+	assert.Equal(suite.T(), "iPhone 15", result.Title)
+	assert.Equal(suite.T(), 999.99, result.Price)
+	assert.Equal(suite.T(), "TechWorld Store", result.SellerName)
+	assert.False(suite.T(), result.CreatedAt.IsZero())
+	assert.Len(suite.T(), result.Images, 2)
+	assert.Equal(suite.T(), 1, result.Images[0].ID)
+	assert.Equal(suite.T(), "http://example.com/image2.jpg", result.Images[1].ImageURL)
+	assert.Equal(suite.T(), 1, result.Images[1].DisplayOrder)
+}
+
+func (suite *CreateProductUseCaseTestSuite) TestCreateProductUseCase_Execute_GeneratesID() {
+	suite.repositoryMock.On("CreateProduct", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	input := validCreateProductInput()
+	input.ID = "  "
+
+	useCase := NewCreateProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), input)
+
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), strings.HasPrefix(result.ID, "MLB"))
+	assert.Len(suite.T(), result.ID, 15)
+
+	product := suite.repositoryMock.Calls[0].Arguments.Get(1).(*entity.Product)
+	assert.Equal(suite.T(), result.ID, product.ID)
+}
+
+func (suite *CreateProductUseCaseTestSuite) TestCreateProductUseCase_Execute_InvalidProduct() {
+	input := validCreateProductInput()
+	input.Title = ""
+
+	useCase := NewCreateProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), input)
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrValidation)
+	assert.Equal(suite.T(), "title is required", errors.GetUserFriendlyMessage(err, http.StatusUnprocessableEntity))
+	suite.repositoryMock.AssertNotCalled(suite.T(), "CreateProduct", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *CreateProductUseCaseTestSuite) TestCreateProductUseCase_Execute_ReportsEveryInvalidField() {
+	input := validCreateProductInput()
+	input.Title = ""
+	input.Price = 10.005
+	input.Stock = -1
+	input.SellerID = ""
+	input.Images = []string{"", "http://example.com/image1.jpg", " "}
+
+	useCase := NewCreateProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), input)
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrValidation)
+	assert.Equal(suite.T(), []errors.FieldError{
+		{Field: "price", Rule: errors.RuleDecimals, Message: "USD amounts cannot have more than 2 decimal places"},
+		{Field: "title", Rule: errors.RuleRequired, Message: "title is required"},
+		{Field: "stock", Rule: errors.RuleMin, Message: "stock must be greater than or equal to 0"},
+		{Field: "seller_id", Rule: errors.RuleRequired, Message: "seller_id is required"},
+		{Field: "images[0].image_url", Rule: errors.RuleRequired, Message: "images[0]: image_url is required"},
+		{Field: "images[2].image_url", Rule: errors.RuleRequired, Message: "images[2]: image_url is required"},
+	}, errors.GetErrorDetails(err))
+	suite.repositoryMock.AssertNotCalled(suite.T(), "CreateProduct", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *CreateProductUseCaseTestSuite) TestCreateProductUseCase_Execute_InvalidPrice() {
+	tests := []struct {
+		name     string
+		price    float64
+		currency string
+		expected string
+	}{
+		{"Fraction of a cent", 999.999, "USD", "USD amounts cannot have more than 2 decimal places"},
+		{"Fraction of a yen", 1500.5, "JPY", "JPY amounts cannot have more than 0 decimal places"},
+		{"Unknown currency", 999.99, "XYZ", "currency must be an ISO 4217 code"},
+	}
+
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			input := validCreateProductInput()
+			input.Price = tt.price
+			input.Currency = tt.currency
+
+			useCase := NewCreateProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+			result, err := useCase.Execute(context.Background(), input)
+
+			assert.Nil(t, result)
+			assert.ErrorIs(t, err, errors.ErrValidation)
+			assert.Equal(t, tt.expected, errors.GetUserFriendlyMessage(err, http.StatusUnprocessableEntity))
+		})
+	}
+
+	suite.repositoryMock.AssertNotCalled(suite.T(), "CreateProduct", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *CreateProductUseCaseTestSuite) TestCreateProductUseCase_Execute_StoresMinorUnits() {
+	suite.repositoryMock.On("CreateProduct", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	input := validCreateProductInput()
+	input.Price = 1500
+	input.Currency = "JPY"
+
+	useCase := NewCreateProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), input)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1500.0, result.Price)
+
+	product := suite.repositoryMock.Calls[0].Arguments.Get(1).(*entity.Product)
+	assert.Equal(suite.T(), entity.Money{Amount: 1500, Currency: "JPY"}, product.Price)
+}
+
+func (suite *CreateProductUseCaseTestSuite) TestCreateProductUseCase_Execute_InvalidImage() {
+	input := validCreateProductInput()
+	input.Images = []string{"http://example.com/image1.jpg", " "}
+
+	useCase := NewCreateProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), input)
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrValidation)
+	assert.Equal(suite.T(), []errors.FieldError{
+		{Field: "images[1].image_url", Rule: errors.RuleRequired, Message: "images[1]: image_url is required"},
+	}, errors.GetErrorDetails(err))
+	suite.repositoryMock.AssertNotCalled(suite.T(), "CreateProduct", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *CreateProductUseCaseTestSuite) TestCreateProductUseCase_Execute_InvalidAttributes() {
+	input := validCreateProductInput()
+	input.Attributes = map[string]string{"brand": "Apple", "ram_gb": "eight", "size": "10"}
+
+	useCase := NewCreateProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), input)
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrValidation)
+	assert.Equal(suite.T(), []errors.FieldError{
+		{Field: "attributes.ram_gb", Rule: errors.RuleType, Message: "attributes.ram_gb must be a number"},
+		{Field: "attributes.storage", Rule: errors.RuleRequired, Message: "attributes.storage is required"},
+		{Field: "attributes.size", Rule: errors.RuleAllowed, Message: "attributes.size is not an attribute of the category"},
+	}, errors.GetErrorDetails(err))
+	suite.categoryRepositoryMock.AssertCalled(suite.T(), "FindCategoryAttributes", mock.Anything, "Electronics > Smartphones")
+	suite.repositoryMock.AssertNotCalled(suite.T(), "CreateProduct", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *CreateProductUseCaseTestSuite) TestCreateProductUseCase_Execute_CategoryAttributesError() {
+	suite.categoryRepositoryMock = new(repository.MockCategoryRepository)
+	suite.categoryRepositoryMock.On("FindCategoryAttributes", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("%w: disk I/O error", errors.ErrDatabaseError))
+
+	useCase := NewCreateProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), validCreateProductInput())
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrDatabaseError)
+	suite.repositoryMock.AssertNotCalled(suite.T(), "CreateProduct", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *CreateProductUseCaseTestSuite) TestCreateProductUseCase_Execute_Variations() {
+	suite.repositoryMock.On("CreateProduct", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	input := validCreateProductInput()
+	input.Attributes = map[string]string{"brand": "Apple"}
+	input.Variations = []dto.VariationFieldsDTO{
+		{Attributes: map[string]string{"storage": "512GB"}, Price: 1199.99, Stock: 4, Images: []string{" http://example.com/512.jpg "}},
+		{Attributes: map[string]string{"storage": "256GB"}, Price: 999.99, Stock: 6},
+	}
+
+	useCase := NewCreateProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), input)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 999.99, result.Price)
+	assert.Equal(suite.T(), 10, result.Stock)
+	assert.Len(suite.T(), result.Variations, 2)
+	assert.Equal(suite.T(), 1199.99, result.Variations[0].Price)
+	assert.Equal(suite.T(), []string{"http://example.com/512.jpg"}, result.Variations[0].Images)
+
+	product := suite.repositoryMock.Calls[0].Arguments.Get(1).(*entity.Product)
+	assert.Equal(suite.T(), entity.Money{Amount: 119999, Currency: "USD"}, product.Variations[0].Price)
+	assert.Equal(suite.T(), "MLB100", product.Variations[1].ProductID)
+}
+
+func (suite *CreateProductUseCaseTestSuite) TestCreateProductUseCase_Execute_InvalidVariations() {
+	input := validCreateProductInput()
+	input.Attributes = map[string]string{"brand": "Apple"}
+	input.Variations = []dto.VariationFieldsDTO{
+		{Attributes: map[string]string{"storage": "256GB"}, Price: 999.99, Stock: 6},
+		{Attributes: map[string]string{"storage": "256gb"}, Price: 10.005, Stock: -1},
+		{Attributes: map[string]string{"ram_gb": "8"}, Price: 899.99},
+	}
+
+	useCase := NewCreateProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), input)
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), []errors.FieldError{
+		{Field: "variations[1].price", Rule: errors.RuleDecimals, Message: "variations[1]: USD amounts cannot have more than 2 decimal places"},
+		{Field: "variations[1].stock", Rule: errors.RuleMin, Message: "variations[1]: stock must be greater than or equal to 0"},
+		{Field: "variations[1].attributes", Rule: errors.RuleUnique, Message: "variations[1].attributes must differ from variations[0]"},
+		{Field: "variations[2].attributes", Rule: errors.RuleFormat, Message: "variations[2].attributes must set the attributes of variations[0]: storage"},
+		{Field: "variations[2].attributes.storage", Rule: errors.RuleRequired, Message: "variations[2].attributes.storage is required"},
+	}, errors.GetErrorDetails(err))
+	suite.repositoryMock.AssertNotCalled(suite.T(), "CreateProduct", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *CreateProductUseCaseTestSuite) TestCreateProductUseCase_Execute_Status() {
+	tests := []struct {
+		name     string
+		stock    int
+		expected string
+		changes  int
+	}{
+		{"In stock", 10, entity.ProductActive, 0},
+		{"Out of stock", 0, entity.ProductPaused, 1},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			repositoryMock := new(repository.MockProductRepository)
+			repositoryMock.On("CreateProduct", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+			input := validCreateProductInput()
+			input.Stock = tt.stock
+
+			useCase := NewCreateProductUseCase(repositoryMock, suite.categoryRepositoryMock)
+			result, err := useCase.Execute(context.Background(), input)
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.expected, result.Status)
+
+			product := repositoryMock.Calls[0].Arguments.Get(1).(*entity.Product)
+			assert.Len(suite.T(), product.StatusChanges, tt.changes)
+		})
+	}
+}
+
+func (suite *CreateProductUseCaseTestSuite) TestCreateProductUseCase_Execute_Scheduled() {
+	suite.repositoryMock.On("CreateProduct", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	publishAt := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	expiresAt := publishAt.Add(7 * 24 * time.Hour)
+
+	input := validCreateProductInput()
+	input.PublishAt = &publishAt
+	input.ExpiresAt = &expiresAt
+
+	useCase := NewCreateProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), input)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), entity.ProductScheduled, result.Status)
+	assert.True(suite.T(), publishAt.Equal(*result.PublishAt))
+	assert.True(suite.T(), expiresAt.Equal(*result.ExpiresAt))
+
+	product := suite.repositoryMock.Calls[0].Arguments.Get(1).(*entity.Product)
+	assert.Len(suite.T(), product.StatusChanges, 1)
+	assert.Equal(suite.T(), entity.ActorSystem, product.StatusChanges[0].Actor)
+	assert.Equal(suite.T(), entity.ReasonScheduled, product.StatusChanges[0].Reason)
+}
+
+func (suite *CreateProductUseCaseTestSuite) TestCreateProductUseCase_Execute_InvalidSchedule() {
+	publishAt := time.Now().Add(24 * time.Hour)
+	expiresAt := publishAt.Add(-time.Hour)
+
+	input := validCreateProductInput()
+	input.PublishAt = &publishAt
+	input.ExpiresAt = &expiresAt
+
+	useCase := NewCreateProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), input)
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), []errors.FieldError{
+		{Field: "expires_at", Rule: errors.RuleMin, Message: "expires_at must be after publish_at"},
+	}, errors.GetErrorDetails(err))
+	suite.repositoryMock.AssertNotCalled(suite.T(), "CreateProduct", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *CreateProductUseCaseTestSuite) TestCreateProductUseCase_Execute_Shipping() {
+	suite.repositoryMock.On("CreateProduct", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	input := validCreateProductInput()
+	input.Shipping = &dto.ShippingDTO{WeightGrams: 240, LengthCm: 18, WidthCm: 10, HeightCm: 6, FreeShipping: true, OriginZip: " 01310-100"}
+
+	useCase := NewCreateProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), input)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "01310100", result.Shipping.OriginZip)
+
+	product := suite.repositoryMock.Calls[0].Arguments.Get(1).(*entity.Product)
+	assert.Equal(suite.T(), entity.ShippingProfile{WeightGrams: 240, LengthCm: 18, WidthCm: 10, HeightCm: 6, FreeShipping: true, OriginZip: "01310100"}, product.Shipping)
+}
+
+func (suite *CreateProductUseCaseTestSuite) TestCreateProductUseCase_Execute_AlreadyExists() {
+	suite.repositoryMock.On("CreateProduct", mock.Anything, mock.Anything, mock.Anything).Return(errors.ErrProductAlreadyExists)
+
+	useCase := NewCreateProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), validCreateProductInput())
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrProductAlreadyExists)
+}
+
+func (suite *CreateProductUseCaseTestSuite) TestCreateProductUseCase_Execute_UnknownSeller() {
+	suite.repositoryMock.On("CreateProduct", mock.Anything, mock.Anything, mock.Anything).Return(errors.ErrSellerNotFound)
+
+	input := validCreateProductInput()
+	input.SellerName = ""
+
+	useCase := NewCreateProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), input)
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrInvalidInput)
+	assert.Equal(suite.T(), http.StatusBadRequest, errors.GetStatusCode(err))
+}
+
+func (suite *CreateProductUseCaseTestSuite) TestCreateProductUseCase_Execute_RepositoryError() {
+	suite.repositoryMock.On("CreateProduct", mock.Anything, mock.Anything, mock.Anything).
+		Return(fmt.Errorf("%w: disk I/O error", errors.ErrDatabaseError))
+
+	useCase := NewCreateProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), validCreateProductInput())
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrDatabaseError)
+}
+
+func TestCreateProductUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateProductUseCaseTestSuite))
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	stdErrors "errors"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type CreatePromotionUseCase struct {
+	promotionRepository repository.PromotionRepositoryInterface
+	productRepository   repository.ProductRepositoryInterface
+	categoryRepository  repository.CategoryRepositoryInterface
+	now                 func() time.Time
+}
+
+func NewCreatePromotionUseCase(promotionRepo repository.PromotionRepositoryInterface, productRepo repository.ProductRepositoryInterface, categoryRepo repository.CategoryRepositoryInterface) *CreatePromotionUseCase {
+	return &CreatePromotionUseCase{
+		promotionRepository: promotionRepo,
+		productRepository:   productRepo,
+		categoryRepository:  categoryRepo,
+		now:                 time.Now,
+	}
+}
+
+// Execute returns ErrProductNotFound or ErrCategoryNotFound for an unknown
+// target, and ErrPromotionConflict when a promotion for the same target with
+// the same priority overlaps it, as neither would win over the other.
+func (p *CreatePromotionUseCase) Execute(ctx context.Context, input dto.CreatePromotionInputDTO) (*dto.PromotionDTO, error) {
+	log.Debug().
+		Interface("product_id", input.ProductID).
+		Interface("category_id", input.CategoryID).
+		Str("type", input.Type).
+		Msg("Executing CreatePromotion use case")
+
+	productID := input.ProductID
+	if productID != nil {
+		trimmed := strings.TrimSpace(*productID)
+		productID = &trimmed
+	}
+
+	promotion, err := entity.NewPromotion(
+		productID,
+		input.CategoryID,
+		input.Type,
+		input.Value,
+		strings.ToUpper(strings.TrimSpace(input.Currency)),
+		input.Priority,
+		input.StartsAt,
+		input.EndsAt,
+	)
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Msg("Invalid promotion data")
+		return nil, err
+	}
+
+	if promotion.ProductID != nil {
+		if _, err := p.productRepository.GetProduct(ctx, *promotion.ProductID, false); err != nil {
+			log.Error().
+				Err(err).
+				Str("product_id", *promotion.ProductID).
+				Msg("Failed to get promoted product from repository")
+			return nil, fmt.Errorf("failed to get product: %w", err)
+		}
+	} else {
+		if _, err := p.categoryRepository.GetCategory(ctx, *promotion.CategoryID); err != nil {
+			log.Error().
+				Err(err).
+				Int64("category_id", *promotion.CategoryID).
+				Msg("Failed to get promoted category from repository")
+			return nil, fmt.Errorf("failed to get category: %w", err)
+		}
+	}
+
+	if err := p.promotionRepository.CreatePromotion(ctx, promotion); err != nil {
+		if stdErrors.Is(err, errors.ErrPromotionConflict) {
+			log.Warn().
+				Err(err).
+				Int("priority", promotion.Priority).
+				Msg("Promotion overlaps another with the same priority")
+			return nil, err
+		}
+		log.Error().
+			Err(err).
+			Msg("Failed to create promotion in repository")
+		return nil, fmt.Errorf("failed to create promotion: %w", err)
+	}
+
+	log.Info().
+		Int64("promotion_id", promotion.ID).
+		Msg("Promotion created successfully")
+
+	promotionDto := toPromotionDTO(*promotion, p.now())
+	return &promotionDto, nil
+}
@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CreatePromotionUseCaseTestSuite struct {
+	suite.Suite
+	promotionRepositoryMock *repository.MockPromotionRepository
+	productRepositoryMock   *repository.MockProductRepository
+	categoryRepositoryMock  *repository.MockCategoryRepository
+	useCase                 *CreatePromotionUseCase
+	startsAt                time.Time
+}
+
+func (suite *CreatePromotionUseCaseTestSuite) BeforeTest(suiteName, testName string) {
+	suite.promotionRepositoryMock = new(repository.MockPromotionRepository)
+	suite.productRepositoryMock = new(repository.MockProductRepository)
+	suite.categoryRepositoryMock = new(repository.MockCategoryRepository)
+	suite.startsAt = time.Date(2024, 11, 29, 0, 0, 0, 0, time.UTC)
+
+	suite.useCase = NewCreatePromotionUseCase(suite.promotionRepositoryMock, suite.productRepositoryMock, suite.categoryRepositoryMock)
+	suite.useCase.now = func() time.Time { return suite.startsAt.Add(time.Hour) }
+}
+
+func (suite *CreatePromotionUseCaseTestSuite) TestCreatePromotionUseCase_Execute_ProductPromotion() {
+	suite.productRepositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(&entity.Product{ID: "MLB001"}, nil)
+	suite.promotionRepositoryMock.On("CreatePromotion", mock.Anything, mock.MatchedBy(func(promotion *entity.Promotion) bool {
+		return *promotion.ProductID == "MLB001" && promotion.PercentOff == 1250 && promotion.Priority == 2
+	})).Run(func(args mock.Arguments) {
+		args.Get(1).(*entity.Promotion).ID = 7
+	}).Return(nil)
+
+	result, err := suite.useCase.Execute(context.Background(), dto.CreatePromotionInputDTO{
+		ProductID: ptr(" MLB001 "),
+		Type:      entity.PromotionPercentage,
+		Value:     12.5,
+		Priority:  2,
+		StartsAt:  suite.startsAt,
+		EndsAt:    suite.startsAt.Add(72 * time.Hour),
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(7), result.ID)
+	assert.Equal(suite.T(), 12.5, result.Value)
+	assert.Empty(suite.T(), result.Currency)
+	assert.True(suite.T(), result.Active)
+	suite.categoryRepositoryMock.AssertNotCalled(suite.T(), "GetCategory", mock.Anything, mock.Anything)
+	suite.promotionRepositoryMock.AssertExpectations(suite.T())
+}
+
+func (suite *CreatePromotionUseCaseTestSuite) TestCreatePromotionUseCase_Execute_CategoryPromotion() {
+	suite.categoryRepositoryMock.On("GetCategory", mock.Anything, int64(6)).Return(&entity.Category{ID: 6}, nil)
+	suite.promotionRepositoryMock.On("CreatePromotion", mock.Anything, mock.Anything).Return(nil)
+
+	result, err := suite.useCase.Execute(context.Background(), dto.CreatePromotionInputDTO{
+		CategoryID: ptr(int64(6)),
+		Type:       entity.PromotionFixed,
+		Value:      50,
+		Currency:   "brl",
+		StartsAt:   suite.startsAt.Add(24 * time.Hour),
+		EndsAt:     suite.startsAt.Add(48 * time.Hour),
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 50.0, result.Value)
+	assert.Equal(suite.T(), "BRL", result.Currency)
+	assert.False(suite.T(), result.Active)
+	suite.productRepositoryMock.AssertNotCalled(suite.T(), "GetProduct", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *CreatePromotionUseCaseTestSuite) TestCreatePromotionUseCase_Execute_InvalidInput() {
+	result, err := suite.useCase.Execute(context.Background(), dto.CreatePromotionInputDTO{
+		Type:     entity.PromotionPercentage,
+		Value:    100,
+		StartsAt: suite.startsAt,
+		EndsAt:   suite.startsAt,
+	})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrValidation)
+	fields := map[string]string{}
+	for _, detail := range errors.GetErrorDetails(err) {
+		fields[detail.Field] = detail.Rule
+	}
+	assert.Equal(suite.T(), map[string]string{
+		"product_id": errors.RuleRequired,
+		"value":      errors.RuleMin,
+		"ends_at":    errors.RuleMin,
+	}, fields)
+	suite.promotionRepositoryMock.AssertNotCalled(suite.T(), "CreatePromotion", mock.Anything, mock.Anything)
+}
+
+func (suite *CreatePromotionUseCaseTestSuite) TestCreatePromotionUseCase_Execute_ProductNotFound() {
+	suite.productRepositoryMock.On("GetProduct", mock.Anything, "MLB999", false).Return(nil, errors.ErrProductNotFound)
+
+	result, err := suite.useCase.Execute(context.Background(), dto.CreatePromotionInputDTO{
+		ProductID: ptr("MLB999"),
+		Type:      entity.PromotionPercentage,
+		Value:     10,
+		StartsAt:  suite.startsAt,
+		EndsAt:    suite.startsAt.Add(time.Hour),
+	})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrProductNotFound)
+	suite.promotionRepositoryMock.AssertNotCalled(suite.T(), "CreatePromotion", mock.Anything, mock.Anything)
+}
+
+func (suite *CreatePromotionUseCaseTestSuite) TestCreatePromotionUseCase_Execute_CategoryNotFound() {
+	suite.categoryRepositoryMock.On("GetCategory", mock.Anything, int64(999)).Return(nil, errors.ErrCategoryNotFound)
+
+	result, err := suite.useCase.Execute(context.Background(), dto.CreatePromotionInputDTO{
+		CategoryID: ptr(int64(999)),
+		Type:       entity.PromotionPercentage,
+		Value:      10,
+		StartsAt:   suite.startsAt,
+		EndsAt:     suite.startsAt.Add(time.Hour),
+	})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrCategoryNotFound)
+}
+
+func (suite *CreatePromotionUseCaseTestSuite) TestCreatePromotionUseCase_Execute_Conflict() {
+	suite.productRepositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(&entity.Product{ID: "MLB001"}, nil)
+	suite.promotionRepositoryMock.On("CreatePromotion", mock.Anything, mock.Anything).Return(fmt.Errorf("%w: overlaps promotion 3", errors.ErrPromotionConflict))
+
+	result, err := suite.useCase.Execute(context.Background(), dto.CreatePromotionInputDTO{
+		ProductID: ptr("MLB001"),
+		Type:      entity.PromotionPercentage,
+		Value:     10,
+		StartsAt:  suite.startsAt,
+		EndsAt:    suite.startsAt.Add(time.Hour),
+	})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrPromotionConflict)
+}
+
+func TestCreatePromotionUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(CreatePromotionUseCaseTestSuite))
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type CreateQuestionUseCase struct {
+	productRepository  repository.ProductRepositoryInterface
+	questionRepository repository.QuestionRepositoryInterface
+	now                func() time.Time
+}
+
+func NewCreateQuestionUseCase(productRepo repository.ProductRepositoryInterface, questionRepo repository.QuestionRepositoryInterface) *CreateQuestionUseCase {
+	return &CreateQuestionUseCase{
+		productRepository:  productRepo,
+		questionRepository: questionRepo,
+		now:                time.Now,
+	}
+}
+
+// Execute returns ErrProductNotFound when the product does not exist, is
+// deleted or is a listing the caller may not see.
+func (p *CreateQuestionUseCase) Execute(ctx context.Context, input dto.CreateQuestionInputDTO) (*dto.QuestionDTO, error) {
+	log.Debug().
+		Str("product_id", input.ProductID).
+		Msg("Executing CreateQuestion use case")
+
+	if strings.TrimSpace(input.ProductID) == "" {
+		log.Warn().Msg("Invalid product ID: empty or whitespace")
+		return nil, errors.ErrInvalidProductID
+	}
+
+	question, err := entity.NewQuestion(input.ProductID, strings.TrimSpace(input.Text))
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("product_id", input.ProductID).
+			Msg("Invalid question data")
+		return nil, errors.NewInvalidInputError(err.Error())
+	}
+
+	product, err := p.productRepository.GetProduct(ctx, input.ProductID, false)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ProductID).
+			Msg("Failed to get product from repository")
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	product.Status = product.ListingStatus(p.now())
+
+	if !isListingVisible(*product, input.SellerID, input.IsAdmin) {
+		log.Warn().
+			Str("product_id", input.ProductID).
+			Str("status", product.Status).
+			Msg("Listing is not visible to the caller")
+		return nil, fmt.Errorf("failed to get product: %w", errors.ErrProductNotFound)
+	}
+
+	if err := p.questionRepository.CreateQuestion(ctx, question); err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ProductID).
+			Msg("Failed to create question in repository")
+		return nil, fmt.Errorf("failed to create question: %w", err)
+	}
+
+	log.Info().
+		Str("product_id", question.ProductID).
+		Int64("question_id", question.ID).
+		Msg("Question created successfully")
+
+	questionDto := toQuestionDTO(*question)
+	return &questionDto, nil
+}
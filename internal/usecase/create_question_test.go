@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CreateQuestionUseCaseTestSuite struct {
+	suite.Suite
+	repositoryMock         *repository.MockProductRepository
+	questionRepositoryMock *repository.MockQuestionRepository
+}
+
+func (suite *CreateQuestionUseCaseTestSuite) BeforeTest(suiteName, testName string) {
+	suite.repositoryMock = new(repository.MockProductRepository)
+	suite.questionRepositoryMock = new(repository.MockQuestionRepository)
+}
+
+func (suite *CreateQuestionUseCaseTestSuite) useCase() *CreateQuestionUseCase {
+	return NewCreateQuestionUseCase(suite.repositoryMock, suite.questionRepositoryMock)
+}
+
+func (suite *CreateQuestionUseCaseTestSuite) TestCreateQuestionUseCase_Execute_Success() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(&entity.Product{ID: "MLB001", Status: entity.ProductActive}, nil)
+	suite.questionRepositoryMock.On("CreateQuestion", mock.Anything, mock.MatchedBy(func(question *entity.Question) bool {
+		return question.ProductID == "MLB001" && question.Text == "Is it unlocked?" && question.Status == entity.QuestionUnanswered
+	})).Run(func(args mock.Arguments) {
+		args.Get(1).(*entity.Question).ID = 5
+	}).Return(nil)
+
+	result, err := suite.useCase().Execute(context.Background(), dto.CreateQuestionInputDTO{
+		ProductID: "MLB001",
+		Text:      " Is it unlocked? ",
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(5), result.ID)
+	assert.Equal(suite.T(), entity.QuestionUnanswered, result.Status)
+	assert.Empty(suite.T(), result.Answer)
+	suite.questionRepositoryMock.AssertExpectations(suite.T())
+}
+
+func (suite *CreateQuestionUseCaseTestSuite) TestCreateQuestionUseCase_Execute_EmptyText() {
+	result, err := suite.useCase().Execute(context.Background(), dto.CreateQuestionInputDTO{ProductID: "MLB001", Text: "   "})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrInvalidInput)
+	assert.Equal(suite.T(), "text is required", errors.GetUserFriendlyMessage(err, http.StatusBadRequest))
+	suite.questionRepositoryMock.AssertNotCalled(suite.T(), "CreateQuestion", mock.Anything, mock.Anything)
+}
+
+func (suite *CreateQuestionUseCaseTestSuite) TestCreateQuestionUseCase_Execute_ProductNotFound() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB999", false).Return(nil, errors.ErrProductNotFound)
+
+	result, err := suite.useCase().Execute(context.Background(), dto.CreateQuestionInputDTO{ProductID: "MLB999", Text: "Is it unlocked?"})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrProductNotFound)
+	suite.questionRepositoryMock.AssertNotCalled(suite.T(), "CreateQuestion", mock.Anything, mock.Anything)
+}
+
+func (suite *CreateQuestionUseCaseTestSuite) TestCreateQuestionUseCase_Execute_ListingNotActive() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(&entity.Product{ID: "MLB001", SellerID: "SELLER001", Status: entity.ProductPaused}, nil)
+	suite.questionRepositoryMock.On("CreateQuestion", mock.Anything, mock.Anything).Return(nil)
+
+	result, err := suite.useCase().Execute(context.Background(), dto.CreateQuestionInputDTO{ProductID: "MLB001", Text: "Is it unlocked?", SellerID: "SELLER002"})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrProductNotFound)
+	suite.questionRepositoryMock.AssertNotCalled(suite.T(), "CreateQuestion", mock.Anything, mock.Anything)
+
+	result, err = suite.useCase().Execute(context.Background(), dto.CreateQuestionInputDTO{ProductID: "MLB001", Text: "Is it unlocked?", SellerID: "SELLER001"})
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+}
+
+func TestCreateQuestionUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateQuestionUseCaseTestSuite))
+}
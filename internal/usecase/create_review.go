@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type CreateReviewUseCase struct {
+	productRepository repository.ProductRepositoryInterface
+	reviewRepository  repository.ReviewRepositoryInterface
+	now               func() time.Time
+}
+
+func NewCreateReviewUseCase(productRepo repository.ProductRepositoryInterface, reviewRepo repository.ReviewRepositoryInterface) *CreateReviewUseCase {
+	return &CreateReviewUseCase{
+		productRepository: productRepo,
+		reviewRepository:  reviewRepo,
+		now:               time.Now,
+	}
+}
+
+// Execute returns ErrProductNotFound when the product does not exist, is
+// deleted or is a listing the caller may not see.
+func (p *CreateReviewUseCase) Execute(ctx context.Context, input dto.CreateReviewInputDTO) (*dto.ReviewDTO, error) {
+	log.Debug().
+		Str("product_id", input.ProductID).
+		Int("rating", input.Rating).
+		Msg("Executing CreateReview use case")
+
+	if strings.TrimSpace(input.ProductID) == "" {
+		log.Warn().Msg("Invalid product ID: empty or whitespace")
+		return nil, errors.ErrInvalidProductID
+	}
+
+	review, err := entity.NewReview(input.ProductID, input.Rating, strings.TrimSpace(input.Title), strings.TrimSpace(input.Comment))
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("product_id", input.ProductID).
+			Msg("Invalid review data")
+		return nil, errors.NewInvalidInputError(err.Error())
+	}
+
+	product, err := p.productRepository.GetProduct(ctx, input.ProductID, false)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ProductID).
+			Msg("Failed to get product from repository")
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	product.Status = product.ListingStatus(p.now())
+
+	if !isListingVisible(*product, input.SellerID, input.IsAdmin) {
+		log.Warn().
+			Str("product_id", input.ProductID).
+			Str("status", product.Status).
+			Msg("Listing is not visible to the caller")
+		return nil, fmt.Errorf("failed to get product: %w", errors.ErrProductNotFound)
+	}
+
+	if err := p.reviewRepository.CreateReview(ctx, review); err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ProductID).
+			Msg("Failed to create review in repository")
+		return nil, fmt.Errorf("failed to create review: %w", err)
+	}
+
+	log.Info().
+		Str("product_id", review.ProductID).
+		Int64("review_id", review.ID).
+		Int("rating", review.Rating).
+		Msg("Review created successfully")
+
+	reviewDto := toReviewDTO(*review)
+	return &reviewDto, nil
+}
@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CreateReviewUseCaseTestSuite struct {
+	suite.Suite
+	repositoryMock       *repository.MockProductRepository
+	reviewRepositoryMock *repository.MockReviewRepository
+}
+
+func (suite *CreateReviewUseCaseTestSuite) BeforeTest(suiteName, testName string) {
+	suite.repositoryMock = new(repository.MockProductRepository)
+	suite.reviewRepositoryMock = new(repository.MockReviewRepository)
+}
+
+func (suite *CreateReviewUseCaseTestSuite) useCase() *CreateReviewUseCase {
+	return NewCreateReviewUseCase(suite.repositoryMock, suite.reviewRepositoryMock)
+}
+
+func (suite *CreateReviewUseCaseTestSuite) TestCreateReviewUseCase_Execute_Success() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(&entity.Product{ID: "MLB001", Status: entity.ProductActive}, nil)
+	suite.reviewRepositoryMock.On("CreateReview", mock.Anything, mock.MatchedBy(func(review *entity.Review) bool {
+		return review.ProductID == "MLB001" && review.Rating == 4 && review.Title == "Great" && review.Comment == "Works well"
+	})).Run(func(args mock.Arguments) {
+		args.Get(1).(*entity.Review).ID = 7
+	}).Return(nil)
+
+	result, err := suite.useCase().Execute(context.Background(), dto.CreateReviewInputDTO{
+		ProductID: "MLB001",
+		Rating:    4,
+		Title:     " Great ",
+		Comment:   "Works well",
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(7), result.ID)
+	assert.Equal(suite.T(), 4, result.Rating)
+	assert.Equal(suite.T(), "Great", result.Title)
+	assert.False(suite.T(), result.CreatedAt.IsZero())
+	suite.reviewRepositoryMock.AssertExpectations(suite.T())
+}
+
+func (suite *CreateReviewUseCaseTestSuite) TestCreateReviewUseCase_Execute_InvalidRating() {
+	useCase := suite.useCase()
+
+	for _, rating := range []int{0, 6, -1} {
+		result, err := useCase.Execute(context.Background(), dto.CreateReviewInputDTO{ProductID: "MLB001", Rating: rating})
+
+		assert.Nil(suite.T(), result)
+		assert.ErrorIs(suite.T(), err, errors.ErrInvalidInput)
+		assert.Equal(suite.T(), http.StatusBadRequest, errors.GetStatusCode(err))
+		assert.Equal(suite.T(), "rating must be between 1 and 5", errors.GetUserFriendlyMessage(err, http.StatusBadRequest))
+	}
+
+	suite.reviewRepositoryMock.AssertNotCalled(suite.T(), "CreateReview", mock.Anything, mock.Anything)
+}
+
+func (suite *CreateReviewUseCaseTestSuite) TestCreateReviewUseCase_Execute_EmptyProductID() {
+	result, err := suite.useCase().Execute(context.Background(), dto.CreateReviewInputDTO{ProductID: " ", Rating: 5})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrInvalidProductID)
+}
+
+func (suite *CreateReviewUseCaseTestSuite) TestCreateReviewUseCase_Execute_ProductNotFound() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB999", false).Return(nil, errors.ErrProductNotFound)
+
+	result, err := suite.useCase().Execute(context.Background(), dto.CreateReviewInputDTO{ProductID: "MLB999", Rating: 5})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrProductNotFound)
+	suite.reviewRepositoryMock.AssertNotCalled(suite.T(), "CreateReview", mock.Anything, mock.Anything)
+}
+
+func (suite *CreateReviewUseCaseTestSuite) TestCreateReviewUseCase_Execute_ListingNotActive() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(&entity.Product{ID: "MLB001", SellerID: "SELLER001", Status: entity.ProductPaused}, nil)
+	suite.reviewRepositoryMock.On("CreateReview", mock.Anything, mock.Anything).Return(nil)
+
+	result, err := suite.useCase().Execute(context.Background(), dto.CreateReviewInputDTO{ProductID: "MLB001", Rating: 5, SellerID: "SELLER002"})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrProductNotFound)
+	suite.reviewRepositoryMock.AssertNotCalled(suite.T(), "CreateReview", mock.Anything, mock.Anything)
+
+	result, err = suite.useCase().Execute(context.Background(), dto.CreateReviewInputDTO{ProductID: "MLB001", Rating: 5, SellerID: "SELLER001"})
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+}
+
+func TestCreateReviewUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateReviewUseCaseTestSuite))
+}
@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+	"strings"
+)
+
+// priceConverter converts product prices to the currency and the format a
+// client asked for.
+type priceConverter struct {
+	target *entity.ExchangeRate
+	rates  map[string]entity.ExchangeRate
+	money  bool
+}
+
+// newPriceConverter loads the exchange rates when currency is set, and
+// returns nil when neither a currency nor the money format is requested,
+// which converts nothing. The code is case-insensitive; a currency without a
+// rate is ErrUnknownCurrency.
+func newPriceConverter(ctx context.Context, exchangeRateRepository repository.ExchangeRateRepositoryInterface, currency, format string) (*priceConverter, error) {
+	if format != "" && format != dto.PriceFormatNumber && format != dto.PriceFormatMoney {
+		return nil, errors.NewInvalidInputError("price_format must be '" + dto.PriceFormatNumber + "' or '" + dto.PriceFormatMoney + "'")
+	}
+
+	money := format == dto.PriceFormatMoney
+	if currency == "" {
+		if !money {
+			return nil, nil
+		}
+		return &priceConverter{money: true}, nil
+	}
+
+	rates, err := exchangeRateRepository.ListExchangeRates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchange rates: %w", err)
+	}
+
+	converter := &priceConverter{rates: make(map[string]entity.ExchangeRate, len(rates)), money: money}
+	for _, rate := range rates {
+		converter.rates[rate.Currency] = rate
+	}
+
+	target, ok := converter.rates[strings.ToUpper(strings.TrimSpace(currency))]
+	if !ok {
+		return nil, errors.ErrUnknownCurrency
+	}
+	converter.target = &target
+
+	return converter, nil
+}
+
+// priceCurrency is the currency prices are shown in: the target currency,
+// or entity.BaseCurrency when prices are not converted. Price filters and
+// facets are in it too.
+func (c *priceConverter) priceCurrency() string {
+	if c == nil || c.target == nil {
+		return entity.BaseCurrency
+	}
+	return c.target.Currency
+}
+
+// convert rewrites the prices of productsDto in the target currency.
+func (c *priceConverter) convert(productsDto []dto.ProductDTO) {
+	for i := range productsDto {
+		c.convertProduct(&productsDto[i])
+	}
+}
+
+// convertProduct rewrites the price of product and of its variations in the
+// target currency, keeping the stored one as the original price unless a
+// promotion already did. A price already in the target currency is left as
+// it is, and so is one in a currency without a rate, which is marked as
+// NotConverted so that it is not mistaken for a converted one.
+func (c *priceConverter) convertProduct(product *dto.ProductDTO) {
+	if c == nil {
+		return
+	}
+
+	// The price of product came from entity.Money.Float64, so it turns back
+	// into the exact stored amount.
+	price, err := entity.NewMoney(product.Price, product.Currency)
+	if err != nil {
+		return
+	}
+
+	from, hasRate := c.rates[price.Currency]
+	convert := c.target != nil && price.Currency != c.target.Currency
+	if convert && !hasRate {
+		product.NotConverted = true
+		convert = false
+	}
+
+	// Variations are priced in the currency of the product.
+	for i := range product.Variations {
+		variation := &product.Variations[i]
+		variationPrice, err := entity.NewMoney(variation.Price, price.Currency)
+		if err != nil {
+			continue
+		}
+		if convert {
+			variationPrice = entity.ConvertPrice(variationPrice, from, *c.target)
+			variation.Price = variationPrice.Float64()
+		}
+		if c.money {
+			variation.PriceMoney = toMoneyDTO(variationPrice)
+		}
+	}
+
+	if convert {
+		converted := entity.ConvertPrice(price, from, *c.target)
+
+		// A promotion already kept the list price as the original one.
+		if product.OriginalPrice == nil {
+			originalPrice := product.Price
+			product.OriginalPrice = &originalPrice
+			product.OriginalCurrency = price.Currency
+		}
+		product.Price = converted.Float64()
+		product.Currency = converted.Currency
+		product.Converted = true
+		price = converted
+	}
+
+	if c.money {
+		product.PriceMoney = toMoneyDTO(price)
+		if product.OriginalPrice != nil {
+			if original, err := entity.NewMoney(*product.OriginalPrice, product.OriginalCurrency); err == nil {
+				product.OriginalPriceMoney = toMoneyDTO(original)
+			}
+		}
+	}
+}
+
+// convertPrice returns price in the target currency, or price itself when
+// it is already in the target currency. A price in a currency without a rate
+// is returned as it is, and false reports that it could not be converted.
+func (c *priceConverter) convertPrice(price entity.Money) (entity.Money, bool) {
+	if c == nil || c.target == nil || price.Currency == c.target.Currency {
+		return price, true
+	}
+
+	from, ok := c.rates[price.Currency]
+	if !ok {
+		return price, false
+	}
+
+	return entity.ConvertPrice(price, from, *c.target), true
+}
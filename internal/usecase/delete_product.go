@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/errors"
+	"project/internal/repository"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type DeleteProductUseCase struct {
+	productRepository repository.ProductRepositoryInterface
+}
+
+func NewDeleteProductUseCase(productRepo repository.ProductRepositoryInterface) *DeleteProductUseCase {
+	return &DeleteProductUseCase{
+		productRepository: productRepo,
+	}
+}
+
+// Execute soft deletes the product: it stays in the database, hidden from
+// reads, until it is restored or purged.
+func (p *DeleteProductUseCase) Execute(ctx context.Context, input dto.ProductInputDTO) error {
+	log.Debug().
+		Str("product_id", input.ID).
+		Msg("Executing DeleteProduct use case")
+
+	if strings.TrimSpace(input.ID) == "" {
+		log.Warn().Msg("Invalid product ID: empty or whitespace")
+		return errors.ErrInvalidProductID
+	}
+
+	if err := p.productRepository.SoftDeleteProduct(ctx, input.ID, time.Now().UTC()); err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ID).
+			Msg("Failed to delete product in repository")
+		return fmt.Errorf("failed to delete product: %w", err)
+	}
+
+	log.Info().
+		Str("product_id", input.ID).
+		Msg("Product soft deleted successfully")
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"project/internal/dto"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type DeleteProductUseCaseTestSuite struct {
+	suite.Suite
+	repositoryMock *repository.MockProductRepository
+}
+
+func (suite *DeleteProductUseCaseTestSuite) BeforeTest(suiteName, testName string) {
+	suite.repositoryMock = new(repository.MockProductRepository)
+}
+
+func (suite *DeleteProductUseCaseTestSuite) TestDeleteProductUseCase_Execute_Success() {
+	suite.repositoryMock.On("SoftDeleteProduct", mock.Anything, "MLB001", mock.Anything).Return(nil)
+
+	useCase := NewDeleteProductUseCase(suite.repositoryMock)
+	err := useCase.Execute(context.Background(), dto.ProductInputDTO{ID: "MLB001"})
+
+	assert.NoError(suite.T(), err)
+	suite.repositoryMock.AssertExpectations(suite.T())
+}
+
+func (suite *DeleteProductUseCaseTestSuite) TestDeleteProductUseCase_Execute_NotFound() {
+	suite.repositoryMock.On("SoftDeleteProduct", mock.Anything, "MLB999", mock.Anything).Return(errors.ErrProductNotFound)
+
+	useCase := NewDeleteProductUseCase(suite.repositoryMock)
+	err := useCase.Execute(context.Background(), dto.ProductInputDTO{ID: "MLB999"})
+
+	assert.ErrorIs(suite.T(), err, errors.ErrProductNotFound)
+}
+
+func (suite *DeleteProductUseCaseTestSuite) TestDeleteProductUseCase_Execute_EmptyID() {
+	useCase := NewDeleteProductUseCase(suite.repositoryMock)
+	err := useCase.Execute(context.Background(), dto.ProductInputDTO{ID: ""})
+
+	assert.Equal(suite.T(), errors.ErrInvalidProductID, err)
+	suite.repositoryMock.AssertNotCalled(suite.T(), "SoftDeleteProduct", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDeleteProductUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(DeleteProductUseCaseTestSuite))
+}
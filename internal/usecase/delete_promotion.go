@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/repository"
+
+	"github.com/rs/zerolog/log"
+)
+
+type DeletePromotionUseCase struct {
+	promotionRepository repository.PromotionRepositoryInterface
+}
+
+func NewDeletePromotionUseCase(promotionRepo repository.PromotionRepositoryInterface) *DeletePromotionUseCase {
+	return &DeletePromotionUseCase{
+		promotionRepository: promotionRepo,
+	}
+}
+
+// Execute ends a promotion for good, restoring the prices it discounted.
+func (p *DeletePromotionUseCase) Execute(ctx context.Context, input dto.DeletePromotionInputDTO) error {
+	log.Debug().
+		Int64("promotion_id", input.ID).
+		Msg("Executing DeletePromotion use case")
+
+	if err := p.promotionRepository.DeletePromotion(ctx, input.ID); err != nil {
+		log.Error().
+			Err(err).
+			Int64("promotion_id", input.ID).
+			Msg("Failed to delete promotion from repository")
+		return fmt.Errorf("failed to delete promotion: %w", err)
+	}
+
+	log.Info().
+		Int64("promotion_id", input.ID).
+		Msg("Promotion deleted successfully")
+
+	return nil
+}
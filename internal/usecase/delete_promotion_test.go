@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"project/internal/dto"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type DeletePromotionUseCaseTestSuite struct {
+	suite.Suite
+	promotionRepositoryMock *repository.MockPromotionRepository
+}
+
+func (suite *DeletePromotionUseCaseTestSuite) BeforeTest(suiteName, testName string) {
+	suite.promotionRepositoryMock = new(repository.MockPromotionRepository)
+}
+
+func (suite *DeletePromotionUseCaseTestSuite) TestDeletePromotionUseCase_Execute_Success() {
+	suite.promotionRepositoryMock.On("DeletePromotion", mock.Anything, int64(7)).Return(nil)
+
+	useCase := NewDeletePromotionUseCase(suite.promotionRepositoryMock)
+	err := useCase.Execute(context.Background(), dto.DeletePromotionInputDTO{ID: 7})
+
+	assert.NoError(suite.T(), err)
+	suite.promotionRepositoryMock.AssertExpectations(suite.T())
+}
+
+func (suite *DeletePromotionUseCaseTestSuite) TestDeletePromotionUseCase_Execute_NotFound() {
+	suite.promotionRepositoryMock.On("DeletePromotion", mock.Anything, int64(999)).Return(errors.ErrPromotionNotFound)
+
+	useCase := NewDeletePromotionUseCase(suite.promotionRepositoryMock)
+	err := useCase.Execute(context.Background(), dto.DeletePromotionInputDTO{ID: 999})
+
+	assert.ErrorIs(suite.T(), err, errors.ErrPromotionNotFound)
+}
+
+func TestDeletePromotionUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(DeletePromotionUseCaseTestSuite))
+}
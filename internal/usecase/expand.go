@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/repository"
+)
+
+// expandProducts embeds the related data selected by expand into productsDto,
+// which must be the mapping of products in the same order. All images are
+// loaded in a single query regardless of the page size.
+func expandProducts(ctx context.Context, productRepository repository.ProductRepositoryInterface, products []entity.Product, productsDto []dto.ProductDTO, expand dto.ProductExpandDTO) error {
+	if expand.Seller {
+		for i, product := range products {
+			productsDto[i].Seller = toSellerDTO(product)
+			productsDto[i].SellerReputation = toSellerReputationSummaryDTO(product.SellerReputation)
+		}
+	}
+
+	if !expand.Images || len(products) == 0 {
+		return nil
+	}
+
+	productIDs := make([]string, 0, len(products))
+	for _, product := range products {
+		productIDs = append(productIDs, product.ID)
+	}
+
+	imagesByProduct, err := productRepository.FindImagesByProductIDs(ctx, productIDs)
+	if err != nil {
+		return fmt.Errorf("failed to get product images: %w", err)
+	}
+
+	for i, product := range products {
+		productsDto[i].Images = toProductImagesDTO(imagesByProduct[product.ID])
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/repository"
+	"sort"
+	"strings"
+)
+
+// priceBreaks are the bounds of the price range facet buckets.
+var priceBreaks = []float64{50, 100, 250, 500, 1000}
+
+// countProductFacets counts the facets of every product matching filter and,
+// for searches, text.
+func countProductFacets(ctx context.Context, productRepository repository.ProductRepositoryInterface, filter repository.ProductFilter, text string) (dto.ProductFacetsDTO, error) {
+	counts, err := productRepository.CountProductFacets(ctx, repository.ProductFacetQuery{
+		ProductFilter: filter,
+		Text:          text,
+		PriceBreaks:   priceBreaks,
+	})
+	if err != nil {
+		return dto.ProductFacetsDTO{}, fmt.Errorf("failed to count product facets: %w", err)
+	}
+
+	return dto.ProductFacetsDTO{
+		Condition:  toFacetBuckets(counts.Conditions),
+		Category:   categoryFacet(counts.Categories, filter.CategoryPrefix),
+		Seller:     toFacetBuckets(counts.Sellers),
+		PriceRange: priceRangeFacet(counts.PriceRanges, filter.PriceCurrency),
+	}, nil
+}
+
+func toFacetBuckets(counts []repository.FacetCount) []dto.FacetBucketDTO {
+	buckets := make([]dto.FacetBucketDTO, 0, len(counts))
+	for _, count := range counts {
+		if count.Value == "" {
+			continue
+		}
+		buckets = append(buckets, dto.FacetBucketDTO{
+			Value: count.Value,
+			Label: count.Label,
+			Count: count.Count,
+		})
+	}
+
+	return buckets
+}
+
+// categoryFacet rolls the per-path counts up to the level right below
+// categoryPrefix, so the buckets are the next choices to drill into. Products
+// sitting exactly at categoryPrefix have no deeper level and are left out.
+func categoryFacet(counts []repository.FacetCount, categoryPrefix string) dto.CategoryFacetDTO {
+	level := 1
+	if categoryPrefix != "" {
+		level = len(entity.SplitCategoryPath(categoryPrefix)) + 1
+	}
+
+	facet := dto.CategoryFacetDTO{Level: level, Buckets: []dto.FacetBucketDTO{}}
+	positions := map[string]int{}
+
+	for _, count := range counts {
+		segments := entity.SplitCategoryPath(count.Value)
+		if count.Value == "" || len(segments) < level {
+			continue
+		}
+
+		path := strings.Join(segments[:level], entity.CategoryPathSeparator)
+		position, ok := positions[path]
+		if !ok {
+			position = len(facet.Buckets)
+			positions[path] = position
+			facet.Buckets = append(facet.Buckets, dto.FacetBucketDTO{
+				Value: path,
+				Label: segments[level-1],
+			})
+		}
+		facet.Buckets[position].Count += count.Count
+	}
+
+	sortFacetBuckets(facet.Buckets)
+
+	return facet
+}
+
+// priceRangeFacet turns the per-range counts into buckets in currency,
+// skipping empty ranges.
+func priceRangeFacet(counts []int, currency string) []dto.PriceRangeBucketDTO {
+	buckets := []dto.PriceRangeBucketDTO{}
+
+	for i, count := range counts {
+		if count == 0 {
+			continue
+		}
+
+		bucket := dto.PriceRangeBucketDTO{Currency: currency, Count: count}
+		if i > 0 {
+			bucket.Min = priceBreaks[i-1]
+		}
+		if i < len(priceBreaks) {
+			upper := priceBreaks[i]
+			bucket.Max = &upper
+		}
+		buckets = append(buckets, bucket)
+	}
+
+	return buckets
+}
+
+// sortFacetBuckets orders buckets by count, most common first, then by value.
+func sortFacetBuckets(buckets []dto.FacetBucketDTO) {
+	sort.SliceStable(buckets, func(i, j int) bool {
+		if buckets[i].Count != buckets[j].Count {
+			return buckets[i].Count > buckets[j].Count
+		}
+		return buckets[i].Value < buckets[j].Value
+	})
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type GetCategoryUseCase struct {
+	categoryRepository repository.CategoryRepositoryInterface
+	now                func() time.Time
+}
+
+func NewGetCategoryUseCase(categoryRepo repository.CategoryRepositoryInterface) *GetCategoryUseCase {
+	return &GetCategoryUseCase{
+		categoryRepository: categoryRepo,
+		now:                time.Now,
+	}
+}
+
+// Execute returns one category with its breadcrumbs, direct children, the
+// number of products under it, subcategories included, and the attributes its
+// products may have.
+func (p *GetCategoryUseCase) Execute(ctx context.Context, input dto.CategoryInputDTO) (*dto.CategoryDTO, error) {
+	log.Debug().
+		Int64("category_id", input.ID).
+		Msg("Executing GetCategory use case")
+
+	tree, err := loadCategoryTree(ctx, p.categoryRepository, p.now())
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to load category tree")
+		return nil, err
+	}
+
+	category, ok := tree.byID[input.ID]
+	if !ok {
+		log.Warn().
+			Int64("category_id", input.ID).
+			Msg("Category not found")
+		return nil, errors.ErrCategoryNotFound
+	}
+
+	attributes, err := p.categoryRepository.FindCategoryAttributes(ctx, category.Path)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Int64("category_id", input.ID).
+			Msg("Failed to get category attributes")
+		return nil, fmt.Errorf("failed to get category attributes: %w", err)
+	}
+
+	return &dto.CategoryDTO{
+		ID:           category.ID,
+		Name:         category.Name,
+		Path:         category.Path,
+		ParentID:     category.ParentID,
+		ProductCount: tree.productCounts[category.ID],
+		Breadcrumbs:  tree.breadcrumbs(category.ID),
+		Children:     toCategoryRefsDTO(tree.children[category.ID]),
+		Attributes:   toCategoryAttributesDTO(entity.ResolveCategoryAttributes(attributes)),
+	}, nil
+}
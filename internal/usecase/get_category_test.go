@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetCategoryUseCaseTestSuite struct {
+	suite.Suite
+	categoryRepositoryMock *repository.MockCategoryRepository
+}
+
+func (suite *GetCategoryUseCaseTestSuite) BeforeTest(suiteName, testName string) {
+	suite.categoryRepositoryMock = new(repository.MockCategoryRepository)
+	suite.categoryRepositoryMock.On("ListCategories", context.Background()).Return(testCategories(), nil)
+	suite.categoryRepositoryMock.On("CountProductsByCategory", context.Background(), mock.Anything).Return(map[int64]int{3: 2}, nil)
+	suite.categoryRepositoryMock.On("FindCategoryAttributes", context.Background(), "Electronics > Audio").Return([]entity.CategoryAttribute{
+		{CategoryID: 1, Name: "brand", Type: entity.AttributeString},
+		{CategoryID: 1, Name: "wireless", Type: entity.AttributeBoolean},
+		{CategoryID: 2, Name: "wireless", Type: entity.AttributeBoolean, Required: true},
+	}, nil)
+	suite.categoryRepositoryMock.On("FindCategoryAttributes", context.Background(), "Fashion").Return([]entity.CategoryAttribute{}, nil)
+}
+
+func (suite *GetCategoryUseCaseTestSuite) TestGetCategoryUseCase_Execute_Success() {
+	useCase := NewGetCategoryUseCase(suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.CategoryInputDTO{ID: 2})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), &dto.CategoryDTO{
+		ID:           2,
+		Name:         "Audio",
+		Path:         "Electronics > Audio",
+		ParentID:     ptr(int64(1)),
+		ProductCount: 2,
+		Breadcrumbs:  []dto.CategoryRefDTO{{ID: 1, Name: "Electronics"}, {ID: 2, Name: "Audio"}},
+		Children:     []dto.CategoryRefDTO{{ID: 3, Name: "Headphones"}},
+		Attributes: []dto.CategoryAttributeDTO{
+			{Name: "brand", Type: entity.AttributeString},
+			{Name: "wireless", Type: entity.AttributeBoolean, Required: true},
+		},
+	}, result)
+}
+
+func (suite *GetCategoryUseCaseTestSuite) TestGetCategoryUseCase_Execute_Leaf() {
+	useCase := NewGetCategoryUseCase(suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.CategoryInputDTO{ID: 5})
+
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), result.ParentID)
+	assert.Equal(suite.T(), []dto.CategoryRefDTO{{ID: 5, Name: "Fashion"}}, result.Breadcrumbs)
+	assert.Empty(suite.T(), result.Children)
+	assert.NotNil(suite.T(), result.Children)
+	assert.Empty(suite.T(), result.Attributes)
+	assert.NotNil(suite.T(), result.Attributes)
+}
+
+func (suite *GetCategoryUseCaseTestSuite) TestGetCategoryUseCase_Execute_NotFound() {
+	useCase := NewGetCategoryUseCase(suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.CategoryInputDTO{ID: 99})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrCategoryNotFound)
+}
+
+func TestGetCategoryUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(GetCategoryUseCaseTestSuite))
+}
@@ -4,26 +4,43 @@ import (
 	"context"
 	"fmt"
 	"project/internal/dto"
+	"project/internal/entity"
 	"project/internal/errors"
 	"project/internal/repository"
 	"strings"
+	"time"
 
 	"github.com/rs/zerolog/log"
 )
 
+// LatestQuestionsLimit is how many answered questions GetProduct embeds with
+// expand=questions. The rest are read from the questions of the product.
+const LatestQuestionsLimit = 5
+
 type GetProductUseCase struct {
-	productRepository repository.ProductRepositoryInterface
+	productRepository      repository.ProductRepositoryInterface
+	questionRepository     repository.QuestionRepositoryInterface
+	exchangeRateRepository repository.ExchangeRateRepositoryInterface
+	detail                 productDetail
+	now                    func() time.Time
 }
 
-func NewGetProductUseCase(productRepo repository.ProductRepositoryInterface) *GetProductUseCase {
+func NewGetProductUseCase(productRepo repository.ProductRepositoryInterface, categoryRepo repository.CategoryRepositoryInterface, questionRepo repository.QuestionRepositoryInterface, exchangeRateRepo repository.ExchangeRateRepositoryInterface, installmentPlanRepo repository.InstallmentPlanRepositoryInterface, promotionRepo repository.PromotionRepositoryInterface) *GetProductUseCase {
 	return &GetProductUseCase{
-		productRepository: productRepo,
+		productRepository:      productRepo,
+		questionRepository:     questionRepo,
+		exchangeRateRepository: exchangeRateRepo,
+		detail:                 newProductDetail(productRepo, categoryRepo, installmentPlanRepo, promotionRepo),
+		now:                    time.Now,
 	}
 }
 
 func (p *GetProductUseCase) Execute(ctx context.Context, input dto.ProductInputDTO) (*dto.ProductDTO, error) {
 	log.Debug().
 		Str("product_id", input.ID).
+		Interface("expand", input.Expand).
+		Str("currency", input.Currency).
+		Str("price_format", input.PriceFormat).
 		Msg("Executing GetProduct use case")
 
 	if strings.TrimSpace(input.ID) == "" {
@@ -31,7 +48,13 @@ func (p *GetProductUseCase) Execute(ctx context.Context, input dto.ProductInputD
 		return nil, errors.ErrInvalidProductID
 	}
 
-	product, err := p.productRepository.GetProduct(ctx, input.ID)
+	converter, err := newPriceConverter(ctx, p.exchangeRateRepository, input.Currency, input.PriceFormat)
+	if err != nil {
+		log.Warn().Err(err).Str("currency", input.Currency).Msg("Failed to prepare price conversion")
+		return nil, err
+	}
+
+	product, err := p.productRepository.GetProduct(ctx, input.ID, input.IncludeDeleted)
 	if err != nil {
 		log.Error().
 			Err(err).
@@ -40,24 +63,137 @@ func (p *GetProductUseCase) Execute(ctx context.Context, input dto.ProductInputD
 		return nil, fmt.Errorf("failed to get product: %w", err)
 	}
 
+	now := p.now()
+	product.Status = product.ListingStatus(now)
+
+	if !isListingVisible(*product, input.SellerID, input.IsAdmin) {
+		log.Warn().
+			Str("product_id", input.ID).
+			Str("status", product.Status).
+			Msg("Listing is not visible to the caller")
+		return nil, fmt.Errorf("failed to get product: %w", errors.ErrProductNotFound)
+	}
+
 	log.Debug().
 		Str("product_id", input.ID).
 		Str("product_title", product.Title).
 		Msg("Product found successfully")
 
-	images, err := p.productRepository.FindImagesByProductID(ctx, input.ID)
+	productDto, err := p.detail.build(ctx, product, converter, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Expand.Questions {
+		questions, err := p.questionRepository.ListQuestions(ctx, repository.QuestionQuery{
+			ProductID: input.ID,
+			Statuses:  []string{entity.QuestionAnswered},
+			Limit:     LatestQuestionsLimit,
+		})
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("product_id", input.ID).
+				Msg("Failed to get latest product questions")
+			return nil, fmt.Errorf("failed to get product questions: %w", err)
+		}
+		productDto.Questions = toQuestionsDTO(questions)
+	}
+
+	return productDto, nil
+}
+
+// productDetail builds the product detail returned by GetProduct: the product
+// with all its images and variations, its category breadcrumbs, the price of
+// its active promotion and its installments. Use cases that answer with a
+// product build it here too, so that it reads the same everywhere.
+type productDetail struct {
+	productRepository         repository.ProductRepositoryInterface
+	categoryRepository        repository.CategoryRepositoryInterface
+	installmentPlanRepository repository.InstallmentPlanRepositoryInterface
+	promotionRepository       repository.PromotionRepositoryInterface
+}
+
+func newProductDetail(productRepo repository.ProductRepositoryInterface, categoryRepo repository.CategoryRepositoryInterface, installmentPlanRepo repository.InstallmentPlanRepositoryInterface, promotionRepo repository.PromotionRepositoryInterface) productDetail {
+	return productDetail{
+		productRepository:         productRepo,
+		categoryRepository:        categoryRepo,
+		installmentPlanRepository: installmentPlanRepo,
+		promotionRepository:       promotionRepo,
+	}
+}
+
+// build returns the detail of product as of now, with its prices converted by
+// converter, which may be nil.
+func (d productDetail) build(ctx context.Context, product *entity.Product, converter *priceConverter, now time.Time) (*dto.ProductDTO, error) {
+	images, err := d.productRepository.FindImagesByProductID(ctx, product.ID)
 	if err != nil {
 		log.Error().
 			Err(err).
-			Str("product_id", input.ID).
+			Str("product_id", product.ID).
 			Msg("Failed to get product images")
 		return nil, fmt.Errorf("failed to get product images: %w", err)
 	}
 
 	log.Debug().
-		Str("product_id", input.ID).
+		Str("product_id", product.ID).
 		Int("images_count", len(images)).
 		Msg("Product images retrieved")
 
-	return toGetProductDTO(*product, images), nil
+	variations, err := d.productRepository.FindVariationsByProductID(ctx, product.ID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", product.ID).
+			Msg("Failed to get product variations")
+		return nil, fmt.Errorf("failed to get product variations: %w", err)
+	}
+	product.Variations = variations
+
+	breadcrumbs, err := productBreadcrumbs(ctx, d.categoryRepository, []entity.Product{*product})
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", product.ID).
+			Msg("Failed to get product category breadcrumbs")
+		return nil, err
+	}
+
+	productDto := toGetProductDTO(*product, images)
+	if product.CategoryID != nil {
+		productDto.Breadcrumbs = breadcrumbs[*product.CategoryID]
+	}
+
+	promotions, err := newPromotionApplier(ctx, d.promotionRepository, d.categoryRepository, []entity.Product{*product}, now)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to prepare promotions")
+		return nil, err
+	}
+	promotions.applyProduct(*product, productDto)
+
+	converter.convertProduct(productDto)
+
+	installments, err := newInstallmentCalculator(ctx, d.installmentPlanRepository)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to prepare installments")
+		return nil, err
+	}
+	installments.applyProduct(productDto)
+
+	return productDto, nil
+}
+
+// showListingStatus sets the status of products to the one their publishing
+// windows give at now, which the scheduler may not have stored yet.
+func showListingStatus(products []entity.Product, now time.Time) {
+	for i := range products {
+		products[i].Status = products[i].ListingStatus(now)
+	}
+}
+
+// isListingVisible reports whether product may be shown to the caller:
+// active listings are public, the others are only shown to administrators
+// and to their seller.
+func isListingVisible(product entity.Product, sellerID string, isAdmin bool) bool {
+	return product.Status == entity.ProductActive || isAdmin || (sellerID != "" && sellerID == product.SellerID)
 }
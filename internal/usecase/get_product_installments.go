@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// GetProductInstallmentsUseCase lists every way to pay the price of a product
+// in installments, by the installment plans of its currency.
+type GetProductInstallmentsUseCase struct {
+	productRepository         repository.ProductRepositoryInterface
+	categoryRepository        repository.CategoryRepositoryInterface
+	exchangeRateRepository    repository.ExchangeRateRepositoryInterface
+	installmentPlanRepository repository.InstallmentPlanRepositoryInterface
+	promotionRepository       repository.PromotionRepositoryInterface
+	now                       func() time.Time
+}
+
+func NewGetProductInstallmentsUseCase(productRepo repository.ProductRepositoryInterface, categoryRepo repository.CategoryRepositoryInterface, exchangeRateRepo repository.ExchangeRateRepositoryInterface, installmentPlanRepo repository.InstallmentPlanRepositoryInterface, promotionRepo repository.PromotionRepositoryInterface) *GetProductInstallmentsUseCase {
+	return &GetProductInstallmentsUseCase{
+		productRepository:         productRepo,
+		categoryRepository:        categoryRepo,
+		exchangeRateRepository:    exchangeRateRepo,
+		installmentPlanRepository: installmentPlanRepo,
+		promotionRepository:       promotionRepo,
+		now:                       time.Now,
+	}
+}
+
+// Execute splits the price of the product, discounted by its active
+// promotion and converted to input.Currency when set, the same price
+// GetProduct shows. A currency without installment plans has no options
+// rather than failing, and a price in a currency without an exchange rate is
+// split in its own currency and marked as NotConverted. A listing the caller
+// may not see, as in GetProduct, is ErrProductNotFound.
+func (p *GetProductInstallmentsUseCase) Execute(ctx context.Context, input dto.ProductInstallmentsInputDTO) (*dto.ProductInstallmentsDTO, error) {
+	log.Debug().
+		Str("product_id", input.ProductID).
+		Str("currency", input.Currency).
+		Msg("Executing GetProductInstallments use case")
+
+	if strings.TrimSpace(input.ProductID) == "" {
+		log.Warn().Msg("Invalid product ID: empty or whitespace")
+		return nil, errors.ErrInvalidProductID
+	}
+
+	converter, err := newPriceConverter(ctx, p.exchangeRateRepository, input.Currency, "")
+	if err != nil {
+		log.Warn().Err(err).Str("currency", input.Currency).Msg("Failed to prepare price conversion")
+		return nil, err
+	}
+
+	product, err := p.productRepository.GetProduct(ctx, input.ProductID, false)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ProductID).
+			Msg("Failed to get product from repository")
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	now := p.now()
+	product.Status = product.ListingStatus(now)
+
+	if !isListingVisible(*product, input.SellerID, input.IsAdmin) {
+		log.Warn().
+			Str("product_id", input.ProductID).
+			Str("status", product.Status).
+			Msg("Listing is not visible to the caller")
+		return nil, fmt.Errorf("failed to get product: %w", errors.ErrProductNotFound)
+	}
+
+	promotions, err := newPromotionApplier(ctx, p.promotionRepository, p.categoryRepository, []entity.Product{*product}, now)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to prepare promotions")
+		return nil, err
+	}
+
+	installments, err := newInstallmentCalculator(ctx, p.installmentPlanRepository)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to prepare installments")
+		return nil, err
+	}
+
+	price, converted := converter.convertPrice(promotions.price(*product))
+	if !converted {
+		log.Warn().
+			Str("product_id", input.ProductID).
+			Str("currency", price.Currency).
+			Msg("Product price has no exchange rate to convert it")
+	}
+	options := installments.options(price)
+
+	log.Info().
+		Str("product_id", input.ProductID).
+		Str("currency", price.Currency).
+		Int("options_count", len(options)).
+		Msg("Product installments calculated successfully")
+
+	return &dto.ProductInstallmentsDTO{
+		ProductID:    product.ID,
+		Price:        price.Float64(),
+		Currency:     price.Currency,
+		NotConverted: !converted,
+		Options:      toInstallmentsDTO(options),
+	}, nil
+}
@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetProductInstallmentsUseCaseTestSuite struct {
+	suite.Suite
+	repositoryMock                *repository.MockProductRepository
+	categoryRepositoryMock        *repository.MockCategoryRepository
+	exchangeRateRepositoryMock    *repository.MockExchangeRateRepository
+	installmentPlanRepositoryMock *repository.MockInstallmentPlanRepository
+	promotionRepositoryMock       *repository.MockPromotionRepository
+	useCase                       *GetProductInstallmentsUseCase
+}
+
+func (suite *GetProductInstallmentsUseCaseTestSuite) BeforeTest(suiteName, testName string) {
+	suite.repositoryMock = new(repository.MockProductRepository)
+	suite.categoryRepositoryMock = new(repository.MockCategoryRepository)
+	suite.exchangeRateRepositoryMock = new(repository.MockExchangeRateRepository)
+	suite.installmentPlanRepositoryMock = new(repository.MockInstallmentPlanRepository)
+	suite.installmentPlanRepositoryMock.On("ListInstallmentPlans", mock.Anything).Return([]entity.InstallmentPlan{
+		{Installments: 1, MinInstallment: entity.Money{Currency: "BRL"}},
+		{Installments: 12, MinInstallment: entity.Money{Amount: 500, Currency: "BRL"}},
+		{Installments: 1, MinInstallment: entity.Money{Currency: "USD"}},
+		{Installments: 6, MinInstallment: entity.Money{Amount: 2000, Currency: "USD"}},
+		{Installments: 12, InterestRate: 199, MinInstallment: entity.Money{Amount: 2000, Currency: "USD"}},
+		{Installments: 18, InterestRate: 249, MinInstallment: entity.Money{Amount: 20000, Currency: "USD"}},
+	}, nil)
+	suite.promotionRepositoryMock = new(repository.MockPromotionRepository)
+	suite.promotionRepositoryMock.On("ListPromotions", mock.Anything, mock.Anything).Return([]entity.Promotion{}, nil)
+
+	suite.useCase = NewGetProductInstallmentsUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+}
+
+func (suite *GetProductInstallmentsUseCaseTestSuite) TestGetProductInstallmentsUseCase_Execute_Success() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(&entity.Product{ID: "MLB001", Status: entity.ProductActive, Price: entity.Money{Amount: 129999, Currency: "USD"}}, nil)
+
+	result, err := suite.useCase.Execute(context.Background(), dto.ProductInstallmentsInputDTO{ProductID: "MLB001"})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), &dto.ProductInstallmentsDTO{
+		ProductID: "MLB001",
+		Price:     1299.99,
+		Currency:  "USD",
+		Options: []dto.InstallmentDTO{
+			{Quantity: 1, Amount: 1299.99, FirstAmount: 1299.99, Total: 1299.99, Currency: "USD", InterestFree: true},
+			{Quantity: 6, Amount: 216.66, FirstAmount: 216.69, Total: 1299.99, Currency: "USD", InterestFree: true},
+			{Quantity: 12, Amount: 122.85, FirstAmount: 122.85, Total: 1474.2, Currency: "USD", MonthlyInterestRate: 1.99},
+		},
+	}, result)
+	suite.exchangeRateRepositoryMock.AssertNotCalled(suite.T(), "ListExchangeRates", mock.Anything)
+}
+
+func (suite *GetProductInstallmentsUseCaseTestSuite) TestGetProductInstallmentsUseCase_Execute_ConvertsCurrency() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(&entity.Product{ID: "MLB001", Status: entity.ProductActive, Price: entity.Money{Amount: 129999, Currency: "USD"}}, nil)
+	suite.exchangeRateRepositoryMock.On("ListExchangeRates", mock.Anything).Return([]entity.ExchangeRate{
+		{Currency: "BRL", Rate: 4.92},
+		{Currency: "USD", Rate: 1},
+	}, nil)
+
+	result, err := suite.useCase.Execute(context.Background(), dto.ProductInstallmentsInputDTO{ProductID: "MLB001", Currency: "brl"})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 6395.95, result.Price)
+	assert.Equal(suite.T(), "BRL", result.Currency)
+	assert.Len(suite.T(), result.Options, 2)
+	assert.Equal(suite.T(), dto.InstallmentDTO{
+		Quantity:     12,
+		Amount:       532.99,
+		FirstAmount:  533.06,
+		Total:        6395.95,
+		Currency:     "BRL",
+		InterestFree: true,
+	}, result.Options[1])
+}
+
+func (suite *GetProductInstallmentsUseCaseTestSuite) TestGetProductInstallmentsUseCase_Execute_CurrencyWithoutRate() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB003", false).Return(&entity.Product{ID: "MLB003", Status: entity.ProductActive, Price: entity.Money{Amount: 8990, Currency: "EUR"}}, nil)
+	suite.exchangeRateRepositoryMock.On("ListExchangeRates", mock.Anything).Return([]entity.ExchangeRate{
+		{Currency: "BRL", Rate: 4.92},
+		{Currency: "USD", Rate: 1},
+	}, nil)
+
+	result, err := suite.useCase.Execute(context.Background(), dto.ProductInstallmentsInputDTO{ProductID: "MLB003", Currency: "BRL"})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 89.9, result.Price)
+	assert.Equal(suite.T(), "EUR", result.Currency)
+	assert.True(suite.T(), result.NotConverted)
+}
+
+func (suite *GetProductInstallmentsUseCaseTestSuite) TestGetProductInstallmentsUseCase_Execute_NoPlans() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB003", false).Return(&entity.Product{ID: "MLB003", Status: entity.ProductActive, Price: entity.Money{Amount: 8990, Currency: "EUR"}}, nil)
+
+	result, err := suite.useCase.Execute(context.Background(), dto.ProductInstallmentsInputDTO{ProductID: "MLB003"})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "EUR", result.Currency)
+	assert.Empty(suite.T(), result.Options)
+}
+
+func (suite *GetProductInstallmentsUseCaseTestSuite) TestGetProductInstallmentsUseCase_Execute_InvalidID() {
+	result, err := suite.useCase.Execute(context.Background(), dto.ProductInstallmentsInputDTO{ProductID: " "})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrInvalidProductID)
+	suite.repositoryMock.AssertNotCalled(suite.T(), "GetProduct", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *GetProductInstallmentsUseCaseTestSuite) TestGetProductInstallmentsUseCase_Execute_ProductNotFound() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB999", false).Return(nil, errors.ErrProductNotFound)
+
+	result, err := suite.useCase.Execute(context.Background(), dto.ProductInstallmentsInputDTO{ProductID: "MLB999"})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrProductNotFound)
+}
+
+func (suite *GetProductInstallmentsUseCaseTestSuite) TestGetProductInstallmentsUseCase_Execute_ListingNotActive() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(&entity.Product{ID: "MLB001", Status: entity.ProductClosed, SellerID: "SELLER001", Price: entity.Money{Amount: 129999, Currency: "USD"}}, nil)
+
+	result, err := suite.useCase.Execute(context.Background(), dto.ProductInstallmentsInputDTO{ProductID: "MLB001"})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrProductNotFound)
+
+	result, err = suite.useCase.Execute(context.Background(), dto.ProductInstallmentsInputDTO{ProductID: "MLB001", SellerID: "SELLER001"})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1299.99, result.Price)
+}
+
+func (suite *GetProductInstallmentsUseCaseTestSuite) TestGetProductInstallmentsUseCase_Execute_Promotion() {
+	now := time.Date(2024, 11, 29, 12, 0, 0, 0, time.UTC)
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(&entity.Product{ID: "MLB001", Status: entity.ProductActive, Price: entity.Money{Amount: 129999, Currency: "USD"}}, nil)
+	promotionRepositoryMock := new(repository.MockPromotionRepository)
+	promotionRepositoryMock.On("ListPromotions", mock.Anything, repository.PromotionQuery{ActiveAt: &now}).Return([]entity.Promotion{
+		{ID: 1, ProductID: ptr("MLB001"), Type: entity.PromotionPercentage, PercentOff: 1000},
+	}, nil)
+
+	useCase := NewGetProductInstallmentsUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, promotionRepositoryMock)
+	useCase.now = func() time.Time { return now }
+	result, err := useCase.Execute(context.Background(), dto.ProductInstallmentsInputDTO{ProductID: "MLB001"})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1169.99, result.Price)
+	assert.Equal(suite.T(), dto.InstallmentDTO{Quantity: 6, Amount: 194.99, FirstAmount: 195.04, Total: 1169.99, Currency: "USD", InterestFree: true}, result.Options[1])
+}
+
+func TestGetProductInstallmentsUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(GetProductInstallmentsUseCaseTestSuite))
+}
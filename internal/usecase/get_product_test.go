@@ -18,20 +18,33 @@ import (
 
 type GetProductUseCaseTestSuite struct {
 	suite.Suite
-	repositoryMock *repository.MockProductRepository
+	repositoryMock                *repository.MockProductRepository
+	categoryRepositoryMock        *repository.MockCategoryRepository
+	questionRepositoryMock        *repository.MockQuestionRepository
+	exchangeRateRepositoryMock    *repository.MockExchangeRateRepository
+	installmentPlanRepositoryMock *repository.MockInstallmentPlanRepository
+	promotionRepositoryMock       *repository.MockPromotionRepository
 }
 
 func (suite *GetProductUseCaseTestSuite) BeforeTest(suiteName, testName string) {
 	suite.repositoryMock = new(repository.MockProductRepository)
+	suite.categoryRepositoryMock = new(repository.MockCategoryRepository)
+	suite.questionRepositoryMock = new(repository.MockQuestionRepository)
+	suite.exchangeRateRepositoryMock = new(repository.MockExchangeRateRepository)
+	suite.installmentPlanRepositoryMock = new(repository.MockInstallmentPlanRepository)
+	suite.installmentPlanRepositoryMock.On("ListInstallmentPlans", mock.Anything).Return([]entity.InstallmentPlan{}, nil)
+	suite.promotionRepositoryMock = new(repository.MockPromotionRepository)
+	suite.promotionRepositoryMock.On("ListPromotions", mock.Anything, mock.Anything).Return([]entity.Promotion{}, nil)
+	suite.repositoryMock.On("FindVariationsByProductID", mock.Anything, mock.Anything).Return([]entity.Variation{}, nil)
 }
 
 func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_Success() {
 	product := &entity.Product{
 		ID:          "PROD-123",
+		Status:      entity.ProductActive,
 		Title:       "iPhone 15",
 		Description: "Latest iPhone",
-		Price:       999.99,
-		Currency:    "USD",
+		Price:       entity.Money{Amount: 99999, Currency: "USD"},
 		Condition:   "new",
 		Stock:       10,
 		SellerID:    "seller-1",
@@ -45,11 +58,11 @@ func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_Success()
 		{ID: 2, ProductID: "PROD-123", ImageURL: "http://example.com/image2.jpg", DisplayOrder: 1},
 	}
 
-	suite.repositoryMock.On("GetProduct", mock.Anything, mock.Anything).Return(product, nil)
+	suite.repositoryMock.On("GetProduct", mock.Anything, mock.Anything, false).Return(product, nil)
 
 	suite.repositoryMock.On("FindImagesByProductID", mock.Anything, mock.Anything).Return(images, nil)
 
-	useCase := NewGetProductUseCase(suite.repositoryMock)
+	useCase := NewGetProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.questionRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
 	result, err := useCase.Execute(context.Background(), dto.ProductInputDTO{ID: "PROD-123"})
 
 	assert.NoError(suite.T(), err)
@@ -61,8 +74,72 @@ func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_Success()
 	assert.Equal(suite.T(), "http://example.com/image1.jpg", result.Images[0].ImageURL)
 }
 
+func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_Breadcrumbs() {
+	categoryID := int64(6)
+	product := &entity.Product{ID: "MLB001", Status: entity.ProductActive, Category: "Electronics > Smartphones", CategoryID: &categoryID}
+
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(product, nil)
+	suite.repositoryMock.On("FindImagesByProductID", mock.Anything, "MLB001").Return([]entity.ProductImage{}, nil)
+	suite.categoryRepositoryMock.On("FindCategoryPaths", mock.Anything, []int64{6}).Return(map[int64][]entity.Category{
+		6: {
+			{ID: 1, Name: "Electronics", Path: "Electronics"},
+			{ID: 6, ParentID: ptr(int64(1)), Name: "Smartphones", Path: "Electronics > Smartphones"},
+		},
+	}, nil)
+
+	useCase := NewGetProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.questionRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.ProductInputDTO{ID: "MLB001"})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), &categoryID, result.CategoryID)
+	assert.Equal(suite.T(), []dto.CategoryRefDTO{{ID: 1, Name: "Electronics"}, {ID: 6, Name: "Smartphones"}}, result.Breadcrumbs)
+}
+
+func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_WithoutCategory() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(&entity.Product{ID: "MLB001", Status: entity.ProductActive}, nil)
+	suite.repositoryMock.On("FindImagesByProductID", mock.Anything, "MLB001").Return([]entity.ProductImage{}, nil)
+
+	useCase := NewGetProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.questionRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.ProductInputDTO{ID: "MLB001"})
+
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), result.Breadcrumbs)
+	suite.categoryRepositoryMock.AssertNotCalled(suite.T(), "FindCategoryPaths", mock.Anything, mock.Anything)
+}
+
+func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_ListingNotActive() {
+	tests := []struct {
+		name    string
+		input   dto.ProductInputDTO
+		visible bool
+	}{
+		{"Public", dto.ProductInputDTO{ID: "MLB001"}, false},
+		{"Another seller", dto.ProductInputDTO{ID: "MLB001", SellerID: "SELLER002"}, false},
+		{"Its seller", dto.ProductInputDTO{ID: "MLB001", SellerID: "SELLER001"}, true},
+		{"Administrator", dto.ProductInputDTO{ID: "MLB001", IsAdmin: true}, true},
+	}
+
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(&entity.Product{ID: "MLB001", SellerID: "SELLER001", Status: entity.ProductPaused}, nil)
+	suite.repositoryMock.On("FindImagesByProductID", mock.Anything, "MLB001").Return([]entity.ProductImage{}, nil)
+
+	useCase := NewGetProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.questionRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			result, err := useCase.Execute(context.Background(), tt.input)
+
+			if !tt.visible {
+				assert.Nil(suite.T(), result)
+				assert.ErrorIs(suite.T(), err, errors.ErrProductNotFound)
+				return
+			}
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), entity.ProductPaused, result.Status)
+		})
+	}
+}
+
 func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_EmptyID() {
-	useCase := NewGetProductUseCase(suite.repositoryMock)
+	useCase := NewGetProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.questionRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
 
 	tests := []struct {
 		name string
@@ -85,9 +162,9 @@ func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_EmptyID()
 }
 
 func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_ProductNotFound() {
-	suite.repositoryMock.On("GetProduct", mock.Anything, mock.Anything).Return(nil, errors.ErrProductNotFound)
+	suite.repositoryMock.On("GetProduct", mock.Anything, mock.Anything, false).Return(nil, errors.ErrProductNotFound)
 
-	useCase := NewGetProductUseCase(suite.repositoryMock)
+	useCase := NewGetProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.questionRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
 	result, err := useCase.Execute(context.Background(), dto.ProductInputDTO{ID: "PROD-999"})
 
 	assert.Error(suite.T(), err)
@@ -96,9 +173,9 @@ func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_ProductNo
 }
 
 func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_DatabaseError() {
-	suite.repositoryMock.On("GetProduct", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("%w: connection failed", errors.ErrDatabaseError))
+	suite.repositoryMock.On("GetProduct", mock.Anything, mock.Anything, false).Return(nil, fmt.Errorf("%w: connection failed", errors.ErrDatabaseError))
 
-	useCase := NewGetProductUseCase(suite.repositoryMock)
+	useCase := NewGetProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.questionRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
 	result, err := useCase.Execute(context.Background(), dto.ProductInputDTO{ID: "PROD-123"})
 
 	assert.Error(suite.T(), err)
@@ -108,15 +185,16 @@ func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_DatabaseE
 
 func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_ImagesError() {
 	product := &entity.Product{
-		ID:    "PROD-123",
-		Title: "Test Product",
+		ID:     "PROD-123",
+		Status: entity.ProductActive,
+		Title:  "Test Product",
 	}
 
-	suite.repositoryMock.On("GetProduct", mock.Anything, mock.Anything).Return(product, nil)
+	suite.repositoryMock.On("GetProduct", mock.Anything, mock.Anything, false).Return(product, nil)
 
 	suite.repositoryMock.On("FindImagesByProductID", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("%w: failed to fetch images", errors.ErrDatabaseError))
 
-	useCase := NewGetProductUseCase(suite.repositoryMock)
+	useCase := NewGetProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.questionRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
 	result, err := useCase.Execute(context.Background(), dto.ProductInputDTO{ID: "PROD-123"})
 
 	assert.Error(suite.T(), err)
@@ -127,10 +205,10 @@ func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_ImagesErr
 func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_NoImages() {
 	product := &entity.Product{
 		ID:          "PROD-123",
+		Status:      entity.ProductActive,
 		Title:       "Product Without Images",
 		Description: "Test",
-		Price:       50.0,
-		Currency:    "USD",
+		Price:       entity.Money{Amount: 5000, Currency: "USD"},
 		Condition:   "new",
 		Stock:       5,
 		SellerID:    "seller-1",
@@ -142,11 +220,11 @@ func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_NoImages(
 
 	images := []entity.ProductImage{}
 
-	suite.repositoryMock.On("GetProduct", mock.Anything, mock.Anything).Return(product, nil)
+	suite.repositoryMock.On("GetProduct", mock.Anything, mock.Anything, false).Return(product, nil)
 
 	suite.repositoryMock.On("FindImagesByProductID", mock.Anything, mock.Anything).Return(images, nil)
 
-	useCase := NewGetProductUseCase(suite.repositoryMock)
+	useCase := NewGetProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.questionRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
 	result, err := useCase.Execute(context.Background(), dto.ProductInputDTO{ID: "PROD-123"})
 
 	assert.NoError(suite.T(), err)
@@ -155,6 +233,221 @@ func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_NoImages(
 	assert.Empty(suite.T(), result.Images)
 }
 
+func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_ExpandQuestions() {
+	product := &entity.Product{ID: "PROD-123", Status: entity.ProductActive, Title: "iPhone 15"}
+	answer := "Yes, it works with every carrier."
+	questions := []entity.Question{
+		{ID: 7, ProductID: "PROD-123", Text: "Is it unlocked?", Status: entity.QuestionAnswered, Answer: &answer},
+	}
+
+	suite.repositoryMock.On("GetProduct", mock.Anything, "PROD-123", false).Return(product, nil)
+	suite.repositoryMock.On("FindImagesByProductID", mock.Anything, "PROD-123").Return([]entity.ProductImage{}, nil)
+	suite.questionRepositoryMock.On("ListQuestions", mock.Anything, repository.QuestionQuery{
+		ProductID: "PROD-123",
+		Statuses:  []string{entity.QuestionAnswered},
+		Limit:     LatestQuestionsLimit,
+	}).Return(questions, nil)
+
+	useCase := NewGetProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.questionRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.ProductInputDTO{
+		ID:     "PROD-123",
+		Expand: dto.ProductExpandDTO{Questions: true},
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Questions, 1)
+	assert.Equal(suite.T(), int64(7), result.Questions[0].ID)
+	assert.Equal(suite.T(), answer, result.Questions[0].Answer)
+	suite.questionRepositoryMock.AssertExpectations(suite.T())
+}
+
+func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_WithoutExpandSkipsQuestions() {
+	product := &entity.Product{ID: "PROD-123", Status: entity.ProductActive, Title: "iPhone 15"}
+
+	suite.repositoryMock.On("GetProduct", mock.Anything, "PROD-123", false).Return(product, nil)
+	suite.repositoryMock.On("FindImagesByProductID", mock.Anything, "PROD-123").Return([]entity.ProductImage{}, nil)
+
+	useCase := NewGetProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.questionRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.ProductInputDTO{ID: "PROD-123"})
+
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), result.Questions)
+	suite.questionRepositoryMock.AssertNotCalled(suite.T(), "ListQuestions", mock.Anything, mock.Anything)
+}
+
+func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_ConvertsCurrency() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(&entity.Product{ID: "MLB001", Status: entity.ProductActive, Price: entity.Money{Amount: 99999, Currency: "USD"}}, nil)
+	suite.repositoryMock.On("FindImagesByProductID", mock.Anything, "MLB001").Return([]entity.ProductImage{}, nil)
+	suite.exchangeRateRepositoryMock.On("ListExchangeRates", mock.Anything).Return([]entity.ExchangeRate{
+		{Currency: "BRL", Rate: 4.92},
+		{Currency: "USD", Rate: 1},
+	}, nil)
+
+	useCase := NewGetProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.questionRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.ProductInputDTO{ID: "MLB001", Currency: "brl"})
+
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), result.Converted)
+	assert.Equal(suite.T(), 4919.95, result.Price)
+	assert.Equal(suite.T(), "BRL", result.Currency)
+	assert.Equal(suite.T(), 999.99, *result.OriginalPrice)
+	assert.Equal(suite.T(), "USD", result.OriginalCurrency)
+}
+
+func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_Installments() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(&entity.Product{ID: "MLB001", Status: entity.ProductActive, Price: entity.Money{Amount: 99999, Currency: "USD"}}, nil)
+	suite.repositoryMock.On("FindImagesByProductID", mock.Anything, "MLB001").Return([]entity.ProductImage{}, nil)
+	suite.exchangeRateRepositoryMock.On("ListExchangeRates", mock.Anything).Return([]entity.ExchangeRate{
+		{Currency: "BRL", Rate: 4.92},
+		{Currency: "USD", Rate: 1},
+	}, nil)
+	installmentPlanRepositoryMock := new(repository.MockInstallmentPlanRepository)
+	installmentPlanRepositoryMock.On("ListInstallmentPlans", mock.Anything).Return([]entity.InstallmentPlan{
+		{Installments: 1, MinInstallment: entity.Money{Currency: "BRL"}},
+		{Installments: 12, MinInstallment: entity.Money{Amount: 500, Currency: "BRL"}},
+		{Installments: 18, InterestRate: 199, MinInstallment: entity.Money{Amount: 500, Currency: "BRL"}},
+		{Installments: 3, MinInstallment: entity.Money{Amount: 1000, Currency: "USD"}},
+	}, nil)
+
+	useCase := NewGetProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.questionRepositoryMock, suite.exchangeRateRepositoryMock, installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.ProductInputDTO{ID: "MLB001", Currency: "BRL"})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), &dto.InstallmentDTO{
+		Quantity:     12,
+		Amount:       409.99,
+		FirstAmount:  410.06,
+		Total:        4919.95,
+		Currency:     "BRL",
+		InterestFree: true,
+	}, result.Installments)
+}
+
+func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_Promotion() {
+	now := time.Date(2024, 11, 29, 12, 0, 0, 0, time.UTC)
+	productRepositoryMock := new(repository.MockProductRepository)
+	productRepositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(&entity.Product{ID: "MLB001", Status: entity.ProductActive, Price: entity.Money{Amount: 99999, Currency: "USD"}}, nil)
+	productRepositoryMock.On("FindImagesByProductID", mock.Anything, "MLB001").Return([]entity.ProductImage{}, nil)
+	productRepositoryMock.On("FindVariationsByProductID", mock.Anything, "MLB001").Return([]entity.Variation{
+		{ID: 1, Price: entity.Money{Amount: 109999, Currency: "USD"}},
+	}, nil)
+	suite.exchangeRateRepositoryMock.On("ListExchangeRates", mock.Anything).Return([]entity.ExchangeRate{
+		{Currency: "BRL", Rate: 4.92},
+		{Currency: "USD", Rate: 1},
+	}, nil)
+	promotionRepositoryMock := new(repository.MockPromotionRepository)
+	promotionRepositoryMock.On("ListPromotions", mock.Anything, repository.PromotionQuery{ActiveAt: &now}).Return([]entity.Promotion{
+		{ID: 3, ProductID: ptr("MLB001"), Type: entity.PromotionPercentage, PercentOff: 1500},
+	}, nil)
+
+	useCase := NewGetProductUseCase(productRepositoryMock, suite.categoryRepositoryMock, suite.questionRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, promotionRepositoryMock)
+	useCase.now = func() time.Time { return now }
+	result, err := useCase.Execute(context.Background(), dto.ProductInputDTO{ID: "MLB001", Currency: "BRL", PriceFormat: dto.PriceFormatMoney})
+
+	assert.NoError(suite.T(), err)
+	// 15% off 999.99 USD is 849.99 USD, shown in BRL; the original price is
+	// the list price in its own currency.
+	assert.Equal(suite.T(), 4181.95, result.Price)
+	assert.Equal(suite.T(), "BRL", result.Currency)
+	assert.Equal(suite.T(), 999.99, *result.OriginalPrice)
+	assert.Equal(suite.T(), "USD", result.OriginalCurrency)
+	assert.Equal(suite.T(), &dto.MoneyDTO{Amount: 99999, Currency: "USD", Decimals: 2}, result.OriginalPriceMoney)
+	assert.Equal(suite.T(), 15.0, result.DiscountPercent)
+	assert.Equal(suite.T(), int64(3), *result.PromotionID)
+	assert.Equal(suite.T(), 4600.15, result.Variations[0].Price)
+	suite.categoryRepositoryMock.AssertNotCalled(suite.T(), "FindCategoryPaths", mock.Anything, mock.Anything)
+}
+
+func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_UnknownCurrency() {
+	suite.exchangeRateRepositoryMock.On("ListExchangeRates", mock.Anything).Return([]entity.ExchangeRate{
+		{Currency: "BRL", Rate: 4.92},
+		{Currency: "USD", Rate: 1},
+	}, nil)
+
+	useCase := NewGetProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.questionRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.ProductInputDTO{ID: "MLB001", Currency: "XYZ"})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrUnknownCurrency)
+	suite.repositoryMock.AssertNotCalled(suite.T(), "GetProduct", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_MoneyPriceFormat() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(&entity.Product{ID: "MLB001", Status: entity.ProductActive, Price: entity.Money{Amount: 129999, Currency: "USD"}}, nil)
+	suite.repositoryMock.On("FindImagesByProductID", mock.Anything, "MLB001").Return([]entity.ProductImage{}, nil)
+
+	useCase := NewGetProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.questionRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.ProductInputDTO{ID: "MLB001", PriceFormat: dto.PriceFormatMoney})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1299.99, result.Price)
+	assert.Equal(suite.T(), &dto.MoneyDTO{Amount: 129999, Currency: "USD", Decimals: 2}, result.PriceMoney)
+	assert.Nil(suite.T(), result.OriginalPriceMoney)
+	suite.exchangeRateRepositoryMock.AssertNotCalled(suite.T(), "ListExchangeRates", mock.Anything)
+}
+
+func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_MoneyPriceFormatConverted() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(&entity.Product{ID: "MLB001", Status: entity.ProductActive, Price: entity.Money{Amount: 129999, Currency: "USD"}}, nil)
+	suite.repositoryMock.On("FindImagesByProductID", mock.Anything, "MLB001").Return([]entity.ProductImage{}, nil)
+	suite.exchangeRateRepositoryMock.On("ListExchangeRates", mock.Anything).Return([]entity.ExchangeRate{
+		{Currency: "JPY", Rate: 148.2},
+		{Currency: "USD", Rate: 1},
+	}, nil)
+
+	useCase := NewGetProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.questionRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.ProductInputDTO{ID: "MLB001", Currency: "JPY", PriceFormat: dto.PriceFormatMoney})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 192659.0, result.Price)
+	assert.Equal(suite.T(), &dto.MoneyDTO{Amount: 192659, Currency: "JPY", Decimals: 0}, result.PriceMoney)
+	assert.Equal(suite.T(), &dto.MoneyDTO{Amount: 129999, Currency: "USD", Decimals: 2}, result.OriginalPriceMoney)
+}
+
+func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_InvalidPriceFormat() {
+	useCase := NewGetProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.questionRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.ProductInputDTO{ID: "MLB001", PriceFormat: "string"})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrInvalidInput)
+	suite.repositoryMock.AssertNotCalled(suite.T(), "GetProduct", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *GetProductUseCaseTestSuite) TestGetProductUseCase_Execute_Variations() {
+	suite.repositoryMock = new(repository.MockProductRepository)
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(&entity.Product{ID: "MLB001", Status: entity.ProductActive, Price: entity.Money{Amount: 129999, Currency: "USD"}, Stock: 35}, nil)
+	suite.repositoryMock.On("FindImagesByProductID", mock.Anything, "MLB001").Return([]entity.ProductImage{}, nil)
+	suite.repositoryMock.On("FindVariationsByProductID", mock.Anything, "MLB001").Return([]entity.Variation{
+		{ID: 1, ProductID: "MLB001", Attributes: entity.ProductAttributes{"storage": "256GB"}, Price: entity.Money{Amount: 129999, Currency: "USD"}, Stock: 20, Images: entity.ImageURLs{"http://example.com/256.jpg"}},
+		{ID: 2, ProductID: "MLB001", Attributes: entity.ProductAttributes{"storage": "512GB"}, Price: entity.Money{Amount: 149999, Currency: "USD"}, Stock: 15},
+	}, nil)
+	suite.exchangeRateRepositoryMock.On("ListExchangeRates", mock.Anything).Return([]entity.ExchangeRate{
+		{Currency: "BRL", Rate: 4.92},
+		{Currency: "USD", Rate: 1},
+	}, nil)
+
+	useCase := NewGetProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.questionRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.ProductInputDTO{ID: "MLB001", Currency: "BRL", PriceFormat: dto.PriceFormatMoney})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []dto.VariationDTO{
+		{
+			ID:         1,
+			Attributes: map[string]string{"storage": "256GB"},
+			Price:      6395.95,
+			PriceMoney: &dto.MoneyDTO{Amount: 639595, Currency: "BRL", Decimals: 2},
+			Stock:      20,
+			Images:     []string{"http://example.com/256.jpg"},
+		},
+		{
+			ID:         2,
+			Attributes: map[string]string{"storage": "512GB"},
+			Price:      7379.95,
+			PriceMoney: &dto.MoneyDTO{Amount: 737995, Currency: "BRL", Decimals: 2},
+			Stock:      15,
+		},
+	}, result.Variations)
+}
+
 func TestGetProductUseCaseTestSuite(t *testing.T) {
 	suite.Run(t, new(GetProductUseCaseTestSuite))
 }
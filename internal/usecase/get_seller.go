@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/errors"
+	"project/internal/repository"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type GetSellerUseCase struct {
+	sellerRepository repository.SellerRepositoryInterface
+	now              func() time.Time
+}
+
+func NewGetSellerUseCase(sellerRepo repository.SellerRepositoryInterface) *GetSellerUseCase {
+	return &GetSellerUseCase{
+		sellerRepository: sellerRepo,
+		now:              time.Now,
+	}
+}
+
+func (p *GetSellerUseCase) Execute(ctx context.Context, input dto.SellerInputDTO) (*dto.SellerDetailDTO, error) {
+	log.Debug().
+		Str("seller_id", input.ID).
+		Msg("Executing GetSeller use case")
+
+	if strings.TrimSpace(input.ID) == "" {
+		log.Warn().Msg("Invalid seller ID: empty or whitespace")
+		return nil, errors.NewInvalidInputError("seller id is required")
+	}
+
+	seller, err := p.sellerRepository.GetSeller(ctx, input.ID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("seller_id", input.ID).
+			Msg("Failed to get seller from repository")
+		return nil, fmt.Errorf("failed to get seller: %w", err)
+	}
+
+	productCount, err := p.sellerRepository.CountSellerProducts(ctx, input.ID, p.now())
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("seller_id", input.ID).
+			Msg("Failed to count seller products")
+		return nil, fmt.Errorf("failed to count seller products: %w", err)
+	}
+
+	return &dto.SellerDetailDTO{
+		ID:           seller.ID,
+		Name:         seller.Name,
+		ProductCount: productCount,
+		Reputation:   toSellerReputationDTO(seller.SellerReputation),
+		CreatedAt:    seller.CreatedAt,
+		UpdatedAt:    seller.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetSellerUseCaseTestSuite struct {
+	suite.Suite
+	sellerRepositoryMock *repository.MockSellerRepository
+}
+
+func (suite *GetSellerUseCaseTestSuite) BeforeTest(suiteName, testName string) {
+	suite.sellerRepositoryMock = new(repository.MockSellerRepository)
+}
+
+func (suite *GetSellerUseCaseTestSuite) TestGetSellerUseCase_Execute_Success() {
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.sellerRepositoryMock.On("GetSeller", context.Background(), "SELLER001").Return(&entity.Seller{
+		ID:   "SELLER001",
+		Name: "TechWorld Store",
+		SellerReputation: entity.SellerReputation{
+			CompletedSales: 60,
+			CancelledSales: 1,
+		},
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}, nil)
+	suite.sellerRepositoryMock.On("CountSellerProducts", context.Background(), "SELLER001", mock.Anything).Return(2, nil)
+
+	useCase := NewGetSellerUseCase(suite.sellerRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.SellerInputDTO{ID: "SELLER001"})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), &dto.SellerDetailDTO{
+		ID:           "SELLER001",
+		Name:         "TechWorld Store",
+		ProductCount: 2,
+		Reputation: dto.SellerReputationDTO{
+			Level:            entity.ReputationGreen,
+			PowerSeller:      entity.PowerSellerGold,
+			CompletedSales:   60,
+			CancelledSales:   1,
+			CancellationRate: 0.0164,
+		},
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}, result)
+}
+
+func (suite *GetSellerUseCaseTestSuite) TestGetSellerUseCase_Execute_NotFound() {
+	suite.sellerRepositoryMock.On("GetSeller", context.Background(), "SELLER999").Return(nil, errors.ErrSellerNotFound)
+
+	useCase := NewGetSellerUseCase(suite.sellerRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.SellerInputDTO{ID: "SELLER999"})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrSellerNotFound)
+	suite.sellerRepositoryMock.AssertNotCalled(suite.T(), "CountSellerProducts", context.Background(), "SELLER999", mock.Anything)
+}
+
+func (suite *GetSellerUseCaseTestSuite) TestGetSellerUseCase_Execute_EmptyID() {
+	useCase := NewGetSellerUseCase(suite.sellerRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.SellerInputDTO{ID: "  "})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrInvalidInput)
+}
+
+func (suite *GetSellerUseCaseTestSuite) TestGetSellerUseCase_Execute_CountError() {
+	suite.sellerRepositoryMock.On("GetSeller", context.Background(), "SELLER001").Return(&entity.Seller{ID: "SELLER001"}, nil)
+	suite.sellerRepositoryMock.On("CountSellerProducts", context.Background(), "SELLER001", mock.Anything).
+		Return(0, fmt.Errorf("%w: connection timeout", errors.ErrDatabaseError))
+
+	useCase := NewGetSellerUseCase(suite.sellerRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.SellerInputDTO{ID: "SELLER001"})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrDatabaseError)
+}
+
+func TestGetSellerUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(GetSellerUseCaseTestSuite))
+}
@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// GetShippingQuoteUseCase prices the shipping of a product to a zip code
+// from the rate of its route, and estimates the delivery in business days,
+// skipping weekends and holidays.
+type GetShippingQuoteUseCase struct {
+	productRepository      repository.ProductRepositoryInterface
+	shippingRateRepository repository.ShippingRateRepositoryInterface
+	holidays               []time.Time
+	now                    func() time.Time
+}
+
+func NewGetShippingQuoteUseCase(productRepo repository.ProductRepositoryInterface, shippingRateRepo repository.ShippingRateRepositoryInterface, holidays []time.Time) *GetShippingQuoteUseCase {
+	return &GetShippingQuoteUseCase{
+		productRepository:      productRepo,
+		shippingRateRepository: shippingRateRepo,
+		holidays:               holidays,
+		now:                    time.Now,
+	}
+}
+
+// Execute quotes the shipping of a product to input.Zip. A product without a
+// complete shipping profile, or a route without a rate, is reported as
+// ErrShippingUnavailable. A product with free shipping costs nothing to ship
+// but still has its delivery estimated. A listing the caller may not see, as
+// in GetProduct, is ErrProductNotFound.
+func (p *GetShippingQuoteUseCase) Execute(ctx context.Context, input dto.ShippingQuoteInputDTO) (*dto.ShippingQuoteDTO, error) {
+	log.Debug().
+		Str("product_id", input.ProductID).
+		Str("zip", input.Zip).
+		Msg("Executing GetShippingQuote use case")
+
+	if strings.TrimSpace(input.ProductID) == "" {
+		log.Warn().Msg("Invalid product ID: empty or whitespace")
+		return nil, errors.ErrInvalidProductID
+	}
+
+	zip := entity.NormalizeZip(input.Zip)
+	if !entity.IsValidZip(zip) {
+		log.Warn().Str("zip", input.Zip).Msg("Invalid destination zip code")
+		return nil, errors.NewInvalidInputError("zip must be a zip code of 8 digits, like 01310-100")
+	}
+
+	product, err := p.productRepository.GetProduct(ctx, input.ProductID, false)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ProductID).
+			Msg("Failed to get product from repository")
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	today := p.now()
+	product.Status = product.ListingStatus(today)
+
+	if !isListingVisible(*product, input.SellerID, input.IsAdmin) {
+		log.Warn().
+			Str("product_id", input.ProductID).
+			Str("status", product.Status).
+			Msg("Listing is not visible to the caller")
+		return nil, fmt.Errorf("failed to get product: %w", errors.ErrProductNotFound)
+	}
+
+	if !product.Shipping.IsComplete() {
+		log.Warn().
+			Str("product_id", input.ProductID).
+			Msg("Product has no shipping profile")
+		return nil, errors.ErrShippingUnavailable
+	}
+
+	rate, err := p.shippingRateRepository.FindShippingRate(ctx, product.Shipping.OriginZip, zip)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ProductID).
+			Str("origin_zip", product.Shipping.OriginZip).
+			Str("destination_zip", zip).
+			Msg("Failed to find shipping rate")
+		return nil, fmt.Errorf("failed to find shipping rate: %w", err)
+	}
+
+	weight := product.Shipping.BillableWeightGrams()
+	cost := rate.Cost(weight)
+	if product.Shipping.FreeShipping {
+		cost.Amount = 0
+	}
+
+	log.Info().
+		Str("product_id", input.ProductID).
+		Str("destination_zip", zip).
+		Int64("shipping_rate_id", rate.ID).
+		Msg("Shipping quoted successfully")
+
+	return &dto.ShippingQuoteDTO{
+		ProductID:             product.ID,
+		OriginZip:             product.Shipping.OriginZip,
+		DestinationZip:        zip,
+		FreeShipping:          product.Shipping.FreeShipping,
+		Cost:                  cost.Float64(),
+		Currency:              cost.Currency,
+		BillableWeightGrams:   weight,
+		MinDays:               rate.MinDays,
+		MaxDays:               rate.MaxDays,
+		EstimatedDeliveryFrom: entity.AddBusinessDays(today, rate.MinDays, p.holidays).Format(time.DateOnly),
+		EstimatedDeliveryTo:   entity.AddBusinessDays(today, rate.MaxDays, p.holidays).Format(time.DateOnly),
+	}, nil
+}
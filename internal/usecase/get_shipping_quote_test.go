@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetShippingQuoteUseCaseTestSuite struct {
+	suite.Suite
+	repositoryMock             *repository.MockProductRepository
+	shippingRateRepositoryMock *repository.MockShippingRateRepository
+	useCase                    *GetShippingQuoteUseCase
+}
+
+func (suite *GetShippingQuoteUseCaseTestSuite) BeforeTest(suiteName, testName string) {
+	suite.repositoryMock = new(repository.MockProductRepository)
+	suite.shippingRateRepositoryMock = new(repository.MockShippingRateRepository)
+
+	christmas := time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)
+	suite.useCase = NewGetShippingQuoteUseCase(suite.repositoryMock, suite.shippingRateRepositoryMock, []time.Time{christmas})
+	suite.useCase.now = func() time.Time { return time.Date(2024, 12, 20, 10, 0, 0, 0, time.UTC) }
+}
+
+func shippableProduct() *entity.Product {
+	return &entity.Product{
+		ID:       "MLB001",
+		Price:    entity.Money{Amount: 129999, Currency: "USD"},
+		Status:   entity.ProductActive,
+		SellerID: "SELLER001",
+		Shipping: entity.ShippingProfile{
+			WeightGrams: 2600,
+			LengthCm:    45,
+			WidthCm:     32,
+			HeightCm:    8,
+			OriginZip:   "01310100",
+		},
+	}
+}
+
+func sameStateRate() *entity.ShippingRate {
+	return &entity.ShippingRate{
+		ID:        2,
+		BaseCost:  entity.Money{Amount: 790, Currency: "USD"},
+		CostPerKg: entity.Money{Amount: 150, Currency: "USD"},
+		MinDays:   2,
+		MaxDays:   3,
+	}
+}
+
+func (suite *GetShippingQuoteUseCaseTestSuite) TestGetShippingQuoteUseCase_Execute_Success() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(shippableProduct(), nil)
+	suite.shippingRateRepositoryMock.On("FindShippingRate", mock.Anything, "01310100", "13010111").Return(sameStateRate(), nil)
+
+	result, err := suite.useCase.Execute(context.Background(), dto.ShippingQuoteInputDTO{ProductID: "MLB001", Zip: " 13010-111 "})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), &dto.ShippingQuoteDTO{
+		ProductID:             "MLB001",
+		OriginZip:             "01310100",
+		DestinationZip:        "13010111",
+		Cost:                  10.9,
+		Currency:              "USD",
+		BillableWeightGrams:   2600,
+		MinDays:               2,
+		MaxDays:               3,
+		EstimatedDeliveryFrom: "2024-12-24",
+		EstimatedDeliveryTo:   "2024-12-26",
+	}, result)
+}
+
+func (suite *GetShippingQuoteUseCaseTestSuite) TestGetShippingQuoteUseCase_Execute_FreeShipping() {
+	product := shippableProduct()
+	product.Shipping.FreeShipping = true
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(product, nil)
+	suite.shippingRateRepositoryMock.On("FindShippingRate", mock.Anything, "01310100", "13010111").Return(sameStateRate(), nil)
+
+	result, err := suite.useCase.Execute(context.Background(), dto.ShippingQuoteInputDTO{ProductID: "MLB001", Zip: "13010111"})
+
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), result.FreeShipping)
+	assert.Equal(suite.T(), 0.0, result.Cost)
+	assert.Equal(suite.T(), "USD", result.Currency)
+	assert.Equal(suite.T(), "2024-12-24", result.EstimatedDeliveryFrom)
+}
+
+func (suite *GetShippingQuoteUseCaseTestSuite) TestGetShippingQuoteUseCase_Execute_InvalidZip() {
+	for _, zip := range []string{"", "1301011", "13010-11a", "130101110"} {
+		result, err := suite.useCase.Execute(context.Background(), dto.ShippingQuoteInputDTO{ProductID: "MLB001", Zip: zip})
+
+		assert.Nil(suite.T(), result, zip)
+		assert.ErrorIs(suite.T(), err, errors.ErrInvalidInput, zip)
+	}
+	suite.repositoryMock.AssertNotCalled(suite.T(), "GetProduct", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *GetShippingQuoteUseCaseTestSuite) TestGetShippingQuoteUseCase_Execute_EmptyID() {
+	result, err := suite.useCase.Execute(context.Background(), dto.ShippingQuoteInputDTO{ProductID: " ", Zip: "13010111"})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrInvalidProductID)
+}
+
+func (suite *GetShippingQuoteUseCaseTestSuite) TestGetShippingQuoteUseCase_Execute_ProductNotFound() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB999", false).Return(nil, errors.ErrProductNotFound)
+
+	result, err := suite.useCase.Execute(context.Background(), dto.ShippingQuoteInputDTO{ProductID: "MLB999", Zip: "13010111"})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrProductNotFound)
+}
+
+func (suite *GetShippingQuoteUseCaseTestSuite) TestGetShippingQuoteUseCase_Execute_IncompleteProfile() {
+	product := shippableProduct()
+	product.Shipping.WeightGrams = 0
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(product, nil)
+
+	result, err := suite.useCase.Execute(context.Background(), dto.ShippingQuoteInputDTO{ProductID: "MLB001", Zip: "13010111"})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrShippingUnavailable)
+	suite.shippingRateRepositoryMock.AssertNotCalled(suite.T(), "FindShippingRate", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *GetShippingQuoteUseCaseTestSuite) TestGetShippingQuoteUseCase_Execute_NoRate() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(shippableProduct(), nil)
+	suite.shippingRateRepositoryMock.On("FindShippingRate", mock.Anything, "01310100", "13010111").Return(nil, errors.ErrShippingUnavailable)
+
+	result, err := suite.useCase.Execute(context.Background(), dto.ShippingQuoteInputDTO{ProductID: "MLB001", Zip: "13010111"})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrShippingUnavailable)
+}
+
+func (suite *GetShippingQuoteUseCaseTestSuite) TestGetShippingQuoteUseCase_Execute_ListingNotActive() {
+	product := shippableProduct()
+	product.Status = entity.ProductPaused
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(product, nil)
+	suite.shippingRateRepositoryMock.On("FindShippingRate", mock.Anything, "01310100", "13010111").Return(sameStateRate(), nil)
+
+	result, err := suite.useCase.Execute(context.Background(), dto.ShippingQuoteInputDTO{ProductID: "MLB001", Zip: "13010111"})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrProductNotFound)
+	suite.shippingRateRepositoryMock.AssertNotCalled(suite.T(), "FindShippingRate", mock.Anything, mock.Anything, mock.Anything)
+
+	// Its seller and administrators can still quote it.
+	for _, input := range []dto.ShippingQuoteInputDTO{
+		{ProductID: "MLB001", Zip: "13010111", SellerID: "SELLER001"},
+		{ProductID: "MLB001", Zip: "13010111", IsAdmin: true},
+	} {
+		result, err = suite.useCase.Execute(context.Background(), input)
+
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), "MLB001", result.ProductID)
+	}
+}
+
+func TestGetShippingQuoteUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(GetShippingQuoteUseCaseTestSuite))
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+type HideQuestionUseCase struct {
+	productRepository  repository.ProductRepositoryInterface
+	questionRepository repository.QuestionRepositoryInterface
+}
+
+func NewHideQuestionUseCase(productRepo repository.ProductRepositoryInterface, questionRepo repository.QuestionRepositoryInterface) *HideQuestionUseCase {
+	return &HideQuestionUseCase{
+		productRepository:  productRepo,
+		questionRepository: questionRepo,
+	}
+}
+
+// Execute hides a question from the public listings, keeping its answer if
+// any. The seller of the product and administrators may hide questions;
+// hiding a hidden question is a no-op.
+func (p *HideQuestionUseCase) Execute(ctx context.Context, input dto.HideQuestionInputDTO) (*dto.QuestionDTO, error) {
+	log.Debug().
+		Str("product_id", input.ProductID).
+		Int64("question_id", input.QuestionID).
+		Str("seller_id", input.SellerID).
+		Bool("is_admin", input.IsAdmin).
+		Msg("Executing HideQuestion use case")
+
+	if strings.TrimSpace(input.ProductID) == "" {
+		log.Warn().Msg("Invalid product ID: empty or whitespace")
+		return nil, errors.ErrInvalidProductID
+	}
+
+	if !input.IsAdmin {
+		product, err := p.productRepository.GetProduct(ctx, input.ProductID, false)
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("product_id", input.ProductID).
+				Msg("Failed to get product from repository")
+			return nil, fmt.Errorf("failed to get product: %w", err)
+		}
+
+		if input.SellerID == "" || input.SellerID != product.SellerID {
+			log.Warn().
+				Str("product_id", input.ProductID).
+				Str("seller_id", input.SellerID).
+				Msg("Question hidden by someone other than the seller")
+			return nil, errors.NewForbiddenError("Only the seller of the product can hide its questions")
+		}
+	}
+
+	question, err := p.questionRepository.GetQuestion(ctx, input.ProductID, input.QuestionID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Int64("question_id", input.QuestionID).
+			Msg("Failed to get question from repository")
+		return nil, fmt.Errorf("failed to get question: %w", err)
+	}
+
+	if question.Status != entity.QuestionHidden {
+		if err := p.questionRepository.HideQuestion(ctx, input.ProductID, input.QuestionID); err != nil {
+			log.Error().
+				Err(err).
+				Int64("question_id", input.QuestionID).
+				Msg("Failed to hide question in repository")
+			return nil, fmt.Errorf("failed to hide question: %w", err)
+		}
+		question.Status = entity.QuestionHidden
+	}
+
+	log.Info().
+		Str("product_id", question.ProductID).
+		Int64("question_id", question.ID).
+		Msg("Question hidden successfully")
+
+	questionDto := toQuestionDTO(*question)
+	return &questionDto, nil
+}
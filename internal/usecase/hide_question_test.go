@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type HideQuestionUseCaseTestSuite struct {
+	suite.Suite
+	repositoryMock         *repository.MockProductRepository
+	questionRepositoryMock *repository.MockQuestionRepository
+}
+
+func (suite *HideQuestionUseCaseTestSuite) BeforeTest(suiteName, testName string) {
+	suite.repositoryMock = new(repository.MockProductRepository)
+	suite.questionRepositoryMock = new(repository.MockQuestionRepository)
+}
+
+func (suite *HideQuestionUseCaseTestSuite) useCase() *HideQuestionUseCase {
+	return NewHideQuestionUseCase(suite.repositoryMock, suite.questionRepositoryMock)
+}
+
+func (suite *HideQuestionUseCaseTestSuite) TestHideQuestionUseCase_Execute_BySeller() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(&entity.Product{ID: "MLB001", SellerID: "SELLER001"}, nil)
+	suite.questionRepositoryMock.On("GetQuestion", mock.Anything, "MLB001", int64(3)).
+		Return(&entity.Question{ID: 3, ProductID: "MLB001", Status: entity.QuestionUnanswered}, nil)
+	suite.questionRepositoryMock.On("HideQuestion", mock.Anything, "MLB001", int64(3)).Return(nil)
+
+	result, err := suite.useCase().Execute(context.Background(), dto.HideQuestionInputDTO{ProductID: "MLB001", QuestionID: 3, SellerID: "SELLER001"})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), entity.QuestionHidden, result.Status)
+	suite.questionRepositoryMock.AssertExpectations(suite.T())
+}
+
+func (suite *HideQuestionUseCaseTestSuite) TestHideQuestionUseCase_Execute_ByAdmin() {
+	answer := "Yes."
+	suite.questionRepositoryMock.On("GetQuestion", mock.Anything, "MLB001", int64(3)).
+		Return(&entity.Question{ID: 3, ProductID: "MLB001", Status: entity.QuestionAnswered, Answer: &answer}, nil)
+	suite.questionRepositoryMock.On("HideQuestion", mock.Anything, "MLB001", int64(3)).Return(nil)
+
+	result, err := suite.useCase().Execute(context.Background(), dto.HideQuestionInputDTO{ProductID: "MLB001", QuestionID: 3, IsAdmin: true})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), entity.QuestionHidden, result.Status)
+	assert.Equal(suite.T(), "Yes.", result.Answer)
+	suite.repositoryMock.AssertNotCalled(suite.T(), "GetProduct", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *HideQuestionUseCaseTestSuite) TestHideQuestionUseCase_Execute_NotTheSeller() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(&entity.Product{ID: "MLB001", SellerID: "SELLER001"}, nil)
+
+	result, err := suite.useCase().Execute(context.Background(), dto.HideQuestionInputDTO{ProductID: "MLB001", QuestionID: 3, SellerID: "SELLER002"})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrForbidden)
+	suite.questionRepositoryMock.AssertNotCalled(suite.T(), "HideQuestion", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *HideQuestionUseCaseTestSuite) TestHideQuestionUseCase_Execute_QuestionNotFound() {
+	suite.questionRepositoryMock.On("GetQuestion", mock.Anything, "MLB001", int64(99)).Return(nil, errors.ErrQuestionNotFound)
+
+	result, err := suite.useCase().Execute(context.Background(), dto.HideQuestionInputDTO{ProductID: "MLB001", QuestionID: 99, IsAdmin: true})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrQuestionNotFound)
+}
+
+func TestHideQuestionUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(HideQuestionUseCaseTestSuite))
+}
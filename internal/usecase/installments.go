@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/repository"
+)
+
+// installmentCalculator splits prices by the installment plans of their
+// currency.
+type installmentCalculator struct {
+	plans map[string][]entity.InstallmentPlan
+}
+
+func newInstallmentCalculator(ctx context.Context, installmentPlanRepository repository.InstallmentPlanRepositoryInterface) (*installmentCalculator, error) {
+	plans, err := installmentPlanRepository.ListInstallmentPlans(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get installment plans: %w", err)
+	}
+
+	calculator := &installmentCalculator{plans: map[string][]entity.InstallmentPlan{}}
+	for _, plan := range plans {
+		currency := plan.MinInstallment.Currency
+		calculator.plans[currency] = append(calculator.plans[currency], plan)
+	}
+
+	return calculator, nil
+}
+
+// options splits price by every plan of its currency that allows it, in the
+// order of the plans.
+func (c *installmentCalculator) options(price entity.Money) []entity.Installment {
+	options := []entity.Installment{}
+	for _, plan := range c.plans[price.Currency] {
+		if installment, ok := plan.Split(price); ok {
+			options = append(options, installment)
+		}
+	}
+	return options
+}
+
+// best is the option with the most installments without interest or, when
+// every plan charges interest, the one with the most installments.
+func (c *installmentCalculator) best(price entity.Money) (entity.Installment, bool) {
+	var best entity.Installment
+	found := false
+
+	for _, option := range c.options(price) {
+		if found && !betterInstallment(option, best) {
+			continue
+		}
+		best, found = option, true
+	}
+
+	return best, found
+}
+
+func betterInstallment(a, b entity.Installment) bool {
+	if a.InterestFree() != b.InterestFree() {
+		return a.InterestFree()
+	}
+	return a.Quantity > b.Quantity
+}
+
+// apply sets the installments of productsDto, by the price they are rendered
+// with.
+func (c *installmentCalculator) apply(productsDto []dto.ProductDTO) {
+	for i := range productsDto {
+		c.applyProduct(&productsDto[i])
+	}
+}
+
+func (c *installmentCalculator) applyProduct(product *dto.ProductDTO) {
+	// The price of product came from entity.Money.Float64, converted or not,
+	// so it turns back into the exact amount.
+	price, err := entity.NewMoney(product.Price, product.Currency)
+	if err != nil {
+		return
+	}
+
+	if best, ok := c.best(price); ok {
+		product.Installments = toInstallmentDTO(best)
+	}
+}
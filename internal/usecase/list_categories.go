@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"project/internal/dto"
+	"project/internal/repository"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type ListCategoriesUseCase struct {
+	categoryRepository repository.CategoryRepositoryInterface
+	now                func() time.Time
+}
+
+func NewListCategoriesUseCase(categoryRepo repository.CategoryRepositoryInterface) *ListCategoriesUseCase {
+	return &ListCategoriesUseCase{
+		categoryRepository: categoryRepo,
+		now:                time.Now,
+	}
+}
+
+// Execute returns the whole category tree, top-level categories first, with
+// the number of products under each category.
+func (p *ListCategoriesUseCase) Execute(ctx context.Context) ([]dto.CategoryNodeDTO, error) {
+	log.Debug().Msg("Executing ListCategories use case")
+
+	tree, err := loadCategoryTree(ctx, p.categoryRepository, p.now())
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to load category tree")
+		return nil, err
+	}
+
+	nodes := make([]dto.CategoryNodeDTO, 0, len(tree.roots))
+	for _, root := range tree.roots {
+		nodes = append(nodes, tree.node(root))
+	}
+
+	log.Info().
+		Int("categories_count", len(tree.byID)).
+		Msg("Categories listed successfully")
+
+	return nodes, nil
+}
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// testCategories is a small tree ordered by path, as the repository returns it.
+func testCategories() []entity.Category {
+	return []entity.Category{
+		{ID: 1, Name: "Electronics", Path: "Electronics"},
+		{ID: 2, ParentID: ptr(int64(1)), Name: "Audio", Path: "Electronics > Audio"},
+		{ID: 3, ParentID: ptr(int64(2)), Name: "Headphones", Path: "Electronics > Audio > Headphones"},
+		{ID: 4, ParentID: ptr(int64(1)), Name: "Smartphones", Path: "Electronics > Smartphones"},
+		{ID: 5, Name: "Fashion", Path: "Fashion"},
+	}
+}
+
+type ListCategoriesUseCaseTestSuite struct {
+	suite.Suite
+	categoryRepositoryMock *repository.MockCategoryRepository
+}
+
+func (suite *ListCategoriesUseCaseTestSuite) BeforeTest(suiteName, testName string) {
+	suite.categoryRepositoryMock = new(repository.MockCategoryRepository)
+}
+
+func (suite *ListCategoriesUseCaseTestSuite) TestListCategoriesUseCase_Execute_Success() {
+	suite.categoryRepositoryMock.On("ListCategories", context.Background()).Return(testCategories(), nil)
+	suite.categoryRepositoryMock.On("CountProductsByCategory", context.Background(), mock.Anything).Return(map[int64]int{1: 1, 3: 2, 4: 3}, nil)
+
+	useCase := NewListCategoriesUseCase(suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background())
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []dto.CategoryNodeDTO{
+		{
+			ID: 1, Name: "Electronics", Path: "Electronics", ProductCount: 6,
+			Children: []dto.CategoryNodeDTO{
+				{
+					ID: 2, Name: "Audio", Path: "Electronics > Audio", ProductCount: 2,
+					Children: []dto.CategoryNodeDTO{
+						{ID: 3, Name: "Headphones", Path: "Electronics > Audio > Headphones", ProductCount: 2, Children: []dto.CategoryNodeDTO{}},
+					},
+				},
+				{ID: 4, Name: "Smartphones", Path: "Electronics > Smartphones", ProductCount: 3, Children: []dto.CategoryNodeDTO{}},
+			},
+		},
+		{ID: 5, Name: "Fashion", Path: "Fashion", ProductCount: 0, Children: []dto.CategoryNodeDTO{}},
+	}, result)
+}
+
+func (suite *ListCategoriesUseCaseTestSuite) TestListCategoriesUseCase_Execute_DatabaseError() {
+	suite.categoryRepositoryMock.On("ListCategories", context.Background()).Return(nil, fmt.Errorf("%w: connection timeout", errors.ErrDatabaseError))
+
+	useCase := NewListCategoriesUseCase(suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background())
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrDatabaseError)
+}
+
+func TestListCategoriesUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ListCategoriesUseCaseTestSuite))
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/repository"
+
+	"github.com/rs/zerolog/log"
+)
+
+type ListExchangeRatesUseCase struct {
+	exchangeRateRepository repository.ExchangeRateRepositoryInterface
+}
+
+func NewListExchangeRatesUseCase(exchangeRateRepo repository.ExchangeRateRepositoryInterface) *ListExchangeRatesUseCase {
+	return &ListExchangeRatesUseCase{
+		exchangeRateRepository: exchangeRateRepo,
+	}
+}
+
+func (p *ListExchangeRatesUseCase) Execute(ctx context.Context) (*dto.ExchangeRatesDTO, error) {
+	log.Debug().Msg("Executing ListExchangeRates use case")
+
+	rates, err := p.exchangeRateRepository.ListExchangeRates(ctx)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to list exchange rates from repository")
+		return nil, fmt.Errorf("failed to list exchange rates: %w", err)
+	}
+
+	log.Info().
+		Int("rates_count", len(rates)).
+		Msg("Exchange rates listed successfully")
+
+	return toExchangeRatesDTO(rates), nil
+}
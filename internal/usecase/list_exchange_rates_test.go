@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ListExchangeRatesUseCaseTestSuite struct {
+	suite.Suite
+	exchangeRateRepositoryMock *repository.MockExchangeRateRepository
+}
+
+func (suite *ListExchangeRatesUseCaseTestSuite) BeforeTest(suiteName, testName string) {
+	suite.exchangeRateRepositoryMock = new(repository.MockExchangeRateRepository)
+}
+
+func (suite *ListExchangeRatesUseCaseTestSuite) TestListExchangeRatesUseCase_Execute_Success() {
+	suite.exchangeRateRepositoryMock.On("ListExchangeRates", mock.Anything).Return([]entity.ExchangeRate{
+		{Currency: "BRL", Rate: 4.92},
+		{Currency: "USD", Rate: 1},
+	}, nil)
+
+	result, err := NewListExchangeRatesUseCase(suite.exchangeRateRepositoryMock).Execute(context.Background())
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), entity.BaseCurrency, result.Base)
+	assert.Len(suite.T(), result.Rates, 2)
+	assert.Equal(suite.T(), "BRL", result.Rates[0].Currency)
+	assert.Equal(suite.T(), 4.92, result.Rates[0].Rate)
+}
+
+func (suite *ListExchangeRatesUseCaseTestSuite) TestListExchangeRatesUseCase_Execute_DatabaseError() {
+	suite.exchangeRateRepositoryMock.On("ListExchangeRates", mock.Anything).Return(nil, fmt.Errorf("%w: boom", errors.ErrDatabaseError))
+
+	result, err := NewListExchangeRatesUseCase(suite.exchangeRateRepositoryMock).Execute(context.Background())
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrDatabaseError)
+}
+
+func TestListExchangeRatesUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ListExchangeRatesUseCaseTestSuite))
+}
@@ -2,27 +2,84 @@ package usecase
 
 import (
 	"context"
+	stdErrors "errors"
 	"fmt"
 	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
 	"project/internal/repository"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/rs/zerolog/log"
 )
 
 type ListProductUseCase struct {
-	productRepository repository.ProductRepositoryInterface
+	productRepository         repository.ProductRepositoryInterface
+	categoryRepository        repository.CategoryRepositoryInterface
+	exchangeRateRepository    repository.ExchangeRateRepositoryInterface
+	installmentPlanRepository repository.InstallmentPlanRepositoryInterface
+	promotionRepository       repository.PromotionRepositoryInterface
+	now                       func() time.Time
 }
 
-func NewListProductUseCase(productRepo repository.ProductRepositoryInterface) *ListProductUseCase {
+func NewListProductUseCase(productRepo repository.ProductRepositoryInterface, categoryRepo repository.CategoryRepositoryInterface, exchangeRateRepo repository.ExchangeRateRepositoryInterface, installmentPlanRepo repository.InstallmentPlanRepositoryInterface, promotionRepo repository.PromotionRepositoryInterface) *ListProductUseCase {
 	return &ListProductUseCase{
-		productRepository: productRepo,
+		productRepository:         productRepo,
+		categoryRepository:        categoryRepo,
+		exchangeRateRepository:    exchangeRateRepo,
+		installmentPlanRepository: installmentPlanRepo,
+		promotionRepository:       promotionRepo,
+		now:                       time.Now,
 	}
 }
 
-func (p *ListProductUseCase) Execute(ctx context.Context) ([]dto.ProductDTO, error) {
-	log.Debug().Msg("Executing ListProducts use case")
+// Execute returns one page of products ordered by ID. One extra row is read
+// to tell whether another page follows.
+func (p *ListProductUseCase) Execute(ctx context.Context, input dto.ListProductInputDTO) (*dto.ProductListDTO, error) {
+	log.Debug().
+		Bool("include_deleted", input.IncludeDeleted).
+		Int("limit", input.Limit).
+		Str("cursor", input.Cursor).
+		Interface("filters", input.ProductFiltersDTO).
+		Interface("expand", input.Expand).
+		Str("currency", input.Currency).
+		Str("price_format", input.PriceFormat).
+		Msg("Executing ListProducts use case")
 
-	products, err := p.productRepository.ListProducts(ctx)
+	limit, err := pageLimit(input.Limit)
+	if err != nil {
+		log.Warn().Err(err).Int("limit", input.Limit).Msg("Invalid page limit")
+		return nil, err
+	}
+
+	cursor, err := decodeCursor(input.Cursor)
+	if err != nil {
+		log.Warn().Err(err).Str("cursor", input.Cursor).Msg("Invalid page cursor")
+		return nil, err
+	}
+
+	now := p.now()
+	filter, err := newProductFilter(ctx, p.categoryRepository, input.ProductFiltersDTO, now)
+	if err != nil {
+		log.Warn().Err(err).Msg("Invalid product filters")
+		return nil, err
+	}
+	filter.IncludeDeleted = input.IncludeDeleted
+
+	converter, err := newPriceConverter(ctx, p.exchangeRateRepository, input.Currency, input.PriceFormat)
+	if err != nil {
+		log.Warn().Err(err).Str("currency", input.Currency).Msg("Failed to prepare price conversion")
+		return nil, err
+	}
+	filter.PriceCurrency = converter.priceCurrency()
+
+	products, err := p.productRepository.ListProducts(ctx, repository.ProductQuery{
+		ProductFilter: filter,
+		AfterID:       cursor.AfterID,
+		Limit:         limit + 1,
+	})
 	if err != nil {
 		log.Error().
 			Err(err).
@@ -30,9 +87,135 @@ func (p *ListProductUseCase) Execute(ctx context.Context) ([]dto.ProductDTO, err
 		return nil, fmt.Errorf("failed to list products: %w", err)
 	}
 
+	facets, err := countProductFacets(ctx, p.productRepository, filter, "")
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to count product facets")
+		return nil, err
+	}
+
+	pagination := dto.PaginationDTO{Limit: limit}
+	if len(products) > limit {
+		products = products[:limit]
+		pagination.HasMore = true
+		pagination.NextCursor = encodeCursor(pageCursor{AfterID: products[limit-1].ID})
+	}
+
+	showListingStatus(products, now)
+	productsDto := toListProductDTO(products)
+	if err := expandProducts(ctx, p.productRepository, products, productsDto, input.Expand); err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to expand listed products")
+		return nil, err
+	}
+
+	promotions, err := newPromotionApplier(ctx, p.promotionRepository, p.categoryRepository, products, now)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to prepare promotions")
+		return nil, err
+	}
+	promotions.apply(products, productsDto)
+
+	converter.convert(productsDto)
+
+	installments, err := newInstallmentCalculator(ctx, p.installmentPlanRepository)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to prepare installments")
+		return nil, err
+	}
+	installments.apply(productsDto)
+
 	log.Info().
 		Int("products_count", len(products)).
+		Bool("has_more", pagination.HasMore).
 		Msg("Products listed successfully")
 
-	return toListProductDTO(products), nil
+	return &dto.ProductListDTO{
+		Products:   productsDto,
+		Pagination: pagination,
+		Facets:     facets,
+	}, nil
+}
+
+// newProductFilter validates filters and turns them into a repository filter.
+// A category ID is resolved to its path, which matches its subcategories too,
+// and without a status only active listings match. Statuses are matched as
+// the publishing windows give them at now.
+// Attribute filters are not checked against the attributes of the category:
+// an attribute no product has simply matches nothing.
+func newProductFilter(ctx context.Context, categoryRepository repository.CategoryRepositoryInterface, filters dto.ProductFiltersDTO, now time.Time) (repository.ProductFilter, error) {
+	filter := repository.ProductFilter{
+		At:             now,
+		Status:         strings.TrimSpace(filters.Status),
+		CategoryPrefix: strings.TrimSpace(filters.Category),
+		Condition:      strings.TrimSpace(filters.Condition),
+		SellerID:       strings.TrimSpace(filters.SellerID),
+		MinPrice:       filters.MinPrice,
+		MaxPrice:       filters.MaxPrice,
+		InStock:        filters.InStock,
+	}
+
+	if filters.CategoryID != nil {
+		if filter.CategoryPrefix != "" {
+			return repository.ProductFilter{}, errors.NewInvalidInputError("category and category_id cannot be combined")
+		}
+
+		category, err := categoryRepository.GetCategory(ctx, *filters.CategoryID)
+		if stdErrors.Is(err, errors.ErrCategoryNotFound) {
+			return repository.ProductFilter{}, errors.NewInvalidInputError("category_id does not match any category")
+		}
+		if err != nil {
+			return repository.ProductFilter{}, fmt.Errorf("failed to get category: %w", err)
+		}
+		filter.CategoryPrefix = category.Path
+	}
+
+	if filter.Status == "" {
+		filter.Status = entity.ProductActive
+	}
+	if !entity.IsValidProductStatus(filter.Status) {
+		return repository.ProductFilter{}, errors.NewInvalidInputError("status must be 'active', 'paused', 'closed', 'under_review', or 'scheduled'")
+	}
+
+	if filter.Condition != "" && !entity.IsValidCondition(filter.Condition) {
+		return repository.ProductFilter{}, errors.NewInvalidInputError("condition must be 'new', 'used', or 'refurbished'")
+	}
+
+	if filter.MinPrice != nil && *filter.MinPrice < 0 {
+		return repository.ProductFilter{}, errors.NewInvalidInputError("min_price must be greater than or equal to 0")
+	}
+
+	if filter.MaxPrice != nil && *filter.MaxPrice < 0 {
+		return repository.ProductFilter{}, errors.NewInvalidInputError("max_price must be greater than or equal to 0")
+	}
+
+	if filter.MinPrice != nil && filter.MaxPrice != nil && *filter.MinPrice > *filter.MaxPrice {
+		return repository.ProductFilter{}, errors.NewInvalidInputError("min_price must be less than or equal to max_price")
+	}
+
+	names := make([]string, 0, len(filters.Attributes))
+	for name := range filters.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if !entity.IsValidAttributeName(name) {
+			return repository.ProductFilter{}, errors.NewInvalidInputError(fmt.Sprintf("attr.%s is not a valid attribute name", name))
+		}
+
+		value := strings.TrimSpace(filters.Attributes[name])
+		if value == "" {
+			return repository.ProductFilter{}, errors.NewInvalidInputError(fmt.Sprintf("attr.%s must not be empty", name))
+		}
+
+		if filter.Attributes == nil {
+			filter.Attributes = map[string]string{}
+		}
+		filter.Attributes[name] = value
+	}
+
+	return filter, nil
 }
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"project/internal/dto"
 	"project/internal/entity"
 	"project/internal/errors"
 	"project/internal/repository"
@@ -17,11 +18,21 @@ import (
 
 type ListProductUseCaseTestSuite struct {
 	suite.Suite
-	repositoryMock *repository.MockProductRepository
+	repositoryMock                *repository.MockProductRepository
+	categoryRepositoryMock        *repository.MockCategoryRepository
+	exchangeRateRepositoryMock    *repository.MockExchangeRateRepository
+	installmentPlanRepositoryMock *repository.MockInstallmentPlanRepository
+	promotionRepositoryMock       *repository.MockPromotionRepository
 }
 
 func (suite *ListProductUseCaseTestSuite) BeforeTest(suiteName, testName string) {
 	suite.repositoryMock = new(repository.MockProductRepository)
+	suite.categoryRepositoryMock = new(repository.MockCategoryRepository)
+	suite.exchangeRateRepositoryMock = new(repository.MockExchangeRateRepository)
+	suite.installmentPlanRepositoryMock = new(repository.MockInstallmentPlanRepository)
+	suite.installmentPlanRepositoryMock.On("ListInstallmentPlans", mock.Anything).Return([]entity.InstallmentPlan{}, nil)
+	suite.promotionRepositoryMock = new(repository.MockPromotionRepository)
+	suite.promotionRepositoryMock.On("ListPromotions", mock.Anything, mock.Anything).Return([]entity.Promotion{}, nil)
 }
 
 func (suite *ListProductUseCaseTestSuite) TestListProductUseCase_Execute_Success() {
@@ -31,8 +42,7 @@ func (suite *ListProductUseCaseTestSuite) TestListProductUseCase_Execute_Success
 			ID:          "PROD-1",
 			Title:       "Product 1",
 			Description: "Description 1",
-			Price:       100.0,
-			Currency:    "USD",
+			Price:       entity.Money{Amount: 10000, Currency: "USD"},
 			Condition:   "new",
 			Stock:       10,
 			SellerID:    "seller-1",
@@ -46,8 +56,7 @@ func (suite *ListProductUseCaseTestSuite) TestListProductUseCase_Execute_Success
 			ID:          "PROD-2",
 			Title:       "Product 2",
 			Description: "Description 2",
-			Price:       200.0,
-			Currency:    "USD",
+			Price:       entity.Money{Amount: 20000, Currency: "USD"},
 			Condition:   "used",
 			Stock:       5,
 			SellerID:    "seller-2",
@@ -59,40 +68,45 @@ func (suite *ListProductUseCaseTestSuite) TestListProductUseCase_Execute_Success
 		},
 	}
 
-	suite.repositoryMock.On("ListProducts", mock.Anything).Return(products, nil)
+	suite.repositoryMock.On("ListProducts", mock.Anything, repository.ProductQuery{ProductFilter: activeListings, Limit: DefaultPageLimit + 1}).Return(products, nil)
+	suite.repositoryMock.On("CountProductFacets", mock.Anything, mock.Anything).Return(&repository.ProductFacetCounts{}, nil)
 
-	useCase := NewListProductUseCase(suite.repositoryMock)
-	result, err := useCase.Execute(context.Background())
+	useCase := NewListProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	useCase.now = testClock
+	result, err := useCase.Execute(context.Background(), dto.ListProductInputDTO{})
 
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), result)
-	assert.Len(suite.T(), result, 2)
-	assert.Equal(suite.T(), "PROD-1", result[0].ID)
-	assert.Equal(suite.T(), "Product 1", result[0].Title)
-	assert.Equal(suite.T(), 100.0, result[0].Price)
-	assert.Equal(suite.T(), "https://example.com/thumbnails/prod1.jpg", result[0].Thumbnail)
-	assert.Equal(suite.T(), "PROD-2", result[1].ID)
-	assert.Equal(suite.T(), "https://example.com/thumbnails/prod2.jpg", result[1].Thumbnail)
+	assert.Len(suite.T(), result.Products, 2)
+	assert.Equal(suite.T(), "PROD-1", result.Products[0].ID)
+	assert.Equal(suite.T(), "Product 1", result.Products[0].Title)
+	assert.Equal(suite.T(), 100.0, result.Products[0].Price)
+	assert.Equal(suite.T(), "https://example.com/thumbnails/prod1.jpg", result.Products[0].Thumbnail)
+	assert.Equal(suite.T(), "PROD-2", result.Products[1].ID)
+	assert.Equal(suite.T(), "https://example.com/thumbnails/prod2.jpg", result.Products[1].Thumbnail)
 }
 
 func (suite *ListProductUseCaseTestSuite) TestListProductUseCase_Execute_EmptyList() {
 	products := []entity.Product{}
 
-	suite.repositoryMock.On("ListProducts", mock.Anything).Return(products, nil)
+	suite.repositoryMock.On("ListProducts", mock.Anything, repository.ProductQuery{ProductFilter: activeListings, Limit: DefaultPageLimit + 1}).Return(products, nil)
+	suite.repositoryMock.On("CountProductFacets", mock.Anything, mock.Anything).Return(&repository.ProductFacetCounts{}, nil)
 
-	useCase := NewListProductUseCase(suite.repositoryMock)
-	result, err := useCase.Execute(context.Background())
+	useCase := NewListProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	useCase.now = testClock
+	result, err := useCase.Execute(context.Background(), dto.ListProductInputDTO{})
 
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), result)
-	assert.Empty(suite.T(), result)
+	assert.Empty(suite.T(), result.Products)
 }
 
 func (suite *ListProductUseCaseTestSuite) TestListProductUseCase_Execute_DatabaseError() {
-	suite.repositoryMock.On("ListProducts", mock.Anything).Return(nil, fmt.Errorf("%w: connection timeout", errors.ErrDatabaseError))
+	suite.repositoryMock.On("ListProducts", mock.Anything, repository.ProductQuery{ProductFilter: activeListings, Limit: DefaultPageLimit + 1}).Return(nil, fmt.Errorf("%w: connection timeout", errors.ErrDatabaseError))
 
-	useCase := NewListProductUseCase(suite.repositoryMock)
-	result, err := useCase.Execute(context.Background())
+	useCase := NewListProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	useCase.now = testClock
+	result, err := useCase.Execute(context.Background(), dto.ListProductInputDTO{})
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), result)
@@ -106,8 +120,7 @@ func (suite *ListProductUseCaseTestSuite) TestListProductUseCase_Execute_MapsAll
 			ID:          "PROD-TEST",
 			Title:       "Test Title",
 			Description: "Test Description",
-			Price:       99.99,
-			Currency:    "EUR",
+			Price:       entity.Money{Amount: 9999, Currency: "EUR"},
 			Condition:   "refurbished",
 			Stock:       3,
 			SellerID:    "seller-test",
@@ -119,15 +132,17 @@ func (suite *ListProductUseCaseTestSuite) TestListProductUseCase_Execute_MapsAll
 		},
 	}
 
-	suite.repositoryMock.On("ListProducts", mock.Anything).Return(products, nil)
+	suite.repositoryMock.On("ListProducts", mock.Anything, repository.ProductQuery{ProductFilter: activeListings, Limit: DefaultPageLimit + 1}).Return(products, nil)
+	suite.repositoryMock.On("CountProductFacets", mock.Anything, mock.Anything).Return(&repository.ProductFacetCounts{}, nil)
 
-	useCase := NewListProductUseCase(suite.repositoryMock)
-	result, err := useCase.Execute(context.Background())
+	useCase := NewListProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	useCase.now = testClock
+	result, err := useCase.Execute(context.Background(), dto.ListProductInputDTO{})
 
 	assert.NoError(suite.T(), err)
-	assert.Len(suite.T(), result, 1)
+	assert.Len(suite.T(), result.Products, 1)
 
-	product := result[0]
+	product := result.Products[0]
 	assert.Equal(suite.T(), "PROD-TEST", product.ID)
 	assert.Equal(suite.T(), "Test Title", product.Title)
 	assert.Equal(suite.T(), "", product.Description)
@@ -147,8 +162,7 @@ func (suite *ListProductUseCaseTestSuite) TestListProductUseCase_Execute_DoesNot
 		{
 			ID:        "PROD-1",
 			Title:     "Product with Thumbnail",
-			Price:     50.0,
-			Currency:  "USD",
+			Price:     entity.Money{Amount: 5000, Currency: "USD"},
 			Condition: "new",
 			Stock:     5,
 			SellerID:  "seller-1",
@@ -158,15 +172,392 @@ func (suite *ListProductUseCaseTestSuite) TestListProductUseCase_Execute_DoesNot
 		},
 	}
 
-	suite.repositoryMock.On("ListProducts", mock.Anything).Return(products, nil)
+	suite.repositoryMock.On("ListProducts", mock.Anything, repository.ProductQuery{ProductFilter: activeListings, Limit: DefaultPageLimit + 1}).Return(products, nil)
+	suite.repositoryMock.On("CountProductFacets", mock.Anything, mock.Anything).Return(&repository.ProductFacetCounts{}, nil)
 
-	useCase := NewListProductUseCase(suite.repositoryMock)
-	result, err := useCase.Execute(context.Background())
+	useCase := NewListProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	useCase.now = testClock
+	result, err := useCase.Execute(context.Background(), dto.ListProductInputDTO{})
 
 	assert.NoError(suite.T(), err)
-	assert.Len(suite.T(), result, 1)
-	assert.Equal(suite.T(), "https://example.com/thumb.jpg", result[0].Thumbnail)
-	assert.Nil(suite.T(), result[0].Images)
+	assert.Len(suite.T(), result.Products, 1)
+	assert.Equal(suite.T(), "https://example.com/thumb.jpg", result.Products[0].Thumbnail)
+	assert.Nil(suite.T(), result.Products[0].Images)
+}
+
+func (suite *ListProductUseCaseTestSuite) TestListProductUseCase_Execute_FirstPageHasMore() {
+	products := []entity.Product{{ID: "MLB001"}, {ID: "MLB002"}, {ID: "MLB003"}}
+
+	suite.repositoryMock.On("ListProducts", mock.Anything, repository.ProductQuery{ProductFilter: activeListings, Limit: 3}).Return(products, nil)
+	suite.repositoryMock.On("CountProductFacets", mock.Anything, mock.Anything).Return(&repository.ProductFacetCounts{}, nil)
+
+	useCase := NewListProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	useCase.now = testClock
+	result, err := useCase.Execute(context.Background(), dto.ListProductInputDTO{Limit: 2})
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Products, 2)
+	assert.Equal(suite.T(), "MLB002", result.Products[1].ID)
+	assert.True(suite.T(), result.Pagination.HasMore)
+	assert.Equal(suite.T(), 2, result.Pagination.Limit)
+
+	cursor, err := decodeCursor(result.Pagination.NextCursor)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "MLB002", cursor.AfterID)
+}
+
+func (suite *ListProductUseCaseTestSuite) TestListProductUseCase_Execute_LastPage() {
+	products := []entity.Product{{ID: "MLB003"}}
+
+	suite.repositoryMock.On("ListProducts", mock.Anything, repository.ProductQuery{ProductFilter: activeListings, AfterID: "MLB002", Limit: 3}).Return(products, nil)
+	suite.repositoryMock.On("CountProductFacets", mock.Anything, mock.Anything).Return(&repository.ProductFacetCounts{}, nil)
+
+	useCase := NewListProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	useCase.now = testClock
+	result, err := useCase.Execute(context.Background(), dto.ListProductInputDTO{
+		Limit:  2,
+		Cursor: encodeCursor(pageCursor{AfterID: "MLB002"}),
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Products, 1)
+	assert.False(suite.T(), result.Pagination.HasMore)
+	assert.Empty(suite.T(), result.Pagination.NextCursor)
+}
+
+func (suite *ListProductUseCaseTestSuite) TestListProductUseCase_Execute_InvalidInput() {
+	tests := []struct {
+		name  string
+		input dto.ListProductInputDTO
+	}{
+		{name: "negative limit", input: dto.ListProductInputDTO{Limit: -1}},
+		{name: "limit above maximum", input: dto.ListProductInputDTO{Limit: MaxPageLimit + 1}},
+		{name: "cursor is not base64", input: dto.ListProductInputDTO{Cursor: "not a cursor!"}},
+		{name: "cursor is not JSON", input: dto.ListProductInputDTO{Cursor: "bm90LWpzb24"}},
+		{name: "unknown status", input: dto.ListProductInputDTO{ProductFiltersDTO: dto.ProductFiltersDTO{Status: "deleted"}}},
+		{name: "unknown condition", input: dto.ListProductInputDTO{ProductFiltersDTO: dto.ProductFiltersDTO{Condition: "broken"}}},
+		{name: "negative min price", input: dto.ListProductInputDTO{ProductFiltersDTO: dto.ProductFiltersDTO{MinPrice: ptr(-1.0)}}},
+		{name: "negative max price", input: dto.ListProductInputDTO{ProductFiltersDTO: dto.ProductFiltersDTO{MaxPrice: ptr(-1.0)}}},
+		{name: "min price above max price", input: dto.ListProductInputDTO{ProductFiltersDTO: dto.ProductFiltersDTO{MinPrice: ptr(100.0), MaxPrice: ptr(50.0)}}},
+		{name: "invalid attribute name", input: dto.ListProductInputDTO{ProductFiltersDTO: dto.ProductFiltersDTO{Attributes: map[string]string{"Brand": "Apple"}}}},
+		{name: "empty attribute value", input: dto.ListProductInputDTO{ProductFiltersDTO: dto.ProductFiltersDTO{Attributes: map[string]string{"brand": " "}}}},
+	}
+
+	useCase := NewListProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	useCase.now = testClock
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			result, err := useCase.Execute(context.Background(), tt.input)
+
+			assert.Nil(suite.T(), result)
+			assert.ErrorIs(suite.T(), err, errors.ErrInvalidInput)
+		})
+	}
+	suite.repositoryMock.AssertNotCalled(suite.T(), "ListProducts", mock.Anything, mock.Anything)
+}
+
+func (suite *ListProductUseCaseTestSuite) TestListProductUseCase_Execute_PassesFilters() {
+	inStock := true
+	expected := repository.ProductQuery{
+		ProductFilter: repository.ProductFilter{
+			At:             testNow,
+			Status:         entity.ProductPaused,
+			CategoryPrefix: "Electronics",
+			Condition:      entity.Used,
+			SellerID:       "SELLER001",
+			MinPrice:       ptr(10.0),
+			MaxPrice:       ptr(500.0),
+			InStock:        &inStock,
+			Attributes:     map[string]string{"brand": "Apple", "storage": "256GB"},
+			PriceCurrency:  entity.BaseCurrency,
+		},
+		Limit: DefaultPageLimit + 1,
+	}
+	suite.repositoryMock.On("ListProducts", mock.Anything, expected).Return([]entity.Product{}, nil)
+	suite.repositoryMock.On("CountProductFacets", mock.Anything, mock.Anything).Return(&repository.ProductFacetCounts{}, nil)
+
+	useCase := NewListProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	useCase.now = testClock
+	_, err := useCase.Execute(context.Background(), dto.ListProductInputDTO{
+		ProductFiltersDTO: dto.ProductFiltersDTO{
+			Status:     " paused ",
+			Category:   " Electronics ",
+			Condition:  "used",
+			SellerID:   "SELLER001",
+			MinPrice:   ptr(10.0),
+			MaxPrice:   ptr(500.0),
+			InStock:    &inStock,
+			Attributes: map[string]string{"brand": "Apple", "storage": " 256GB "},
+		},
+	})
+
+	assert.NoError(suite.T(), err)
+	suite.repositoryMock.AssertExpectations(suite.T())
+}
+
+func (suite *ListProductUseCaseTestSuite) TestListProductUseCase_Execute_CategoryIDIncludesSubcategories() {
+	expected := repository.ProductQuery{
+		ProductFilter: repository.ProductFilter{At: testNow, Status: entity.ProductActive, CategoryPrefix: "Electronics > Audio", PriceCurrency: entity.BaseCurrency},
+		Limit:         DefaultPageLimit + 1,
+	}
+	suite.categoryRepositoryMock.On("GetCategory", mock.Anything, int64(2)).Return(&entity.Category{ID: 2, Name: "Audio", Path: "Electronics > Audio"}, nil)
+	suite.repositoryMock.On("ListProducts", mock.Anything, expected).Return([]entity.Product{}, nil)
+	suite.repositoryMock.On("CountProductFacets", mock.Anything, mock.Anything).Return(&repository.ProductFacetCounts{}, nil)
+
+	useCase := NewListProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	useCase.now = testClock
+	result, err := useCase.Execute(context.Background(), dto.ListProductInputDTO{
+		ProductFiltersDTO: dto.ProductFiltersDTO{CategoryID: ptr(int64(2))},
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, result.Facets.Category.Level)
+	suite.repositoryMock.AssertExpectations(suite.T())
+}
+
+func (suite *ListProductUseCaseTestSuite) TestListProductUseCase_Execute_InvalidCategoryID() {
+	suite.categoryRepositoryMock.On("GetCategory", mock.Anything, int64(99)).Return(nil, errors.ErrCategoryNotFound)
+
+	useCase := NewListProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	useCase.now = testClock
+
+	for _, filters := range []dto.ProductFiltersDTO{
+		{CategoryID: ptr(int64(99))},
+		{CategoryID: ptr(int64(2)), Category: "Electronics"},
+	} {
+		result, err := useCase.Execute(context.Background(), dto.ListProductInputDTO{ProductFiltersDTO: filters})
+
+		assert.Nil(suite.T(), result)
+		assert.ErrorIs(suite.T(), err, errors.ErrInvalidInput)
+	}
+	suite.repositoryMock.AssertNotCalled(suite.T(), "ListProducts", mock.Anything, mock.Anything)
+}
+
+func (suite *ListProductUseCaseTestSuite) TestListProductUseCase_Execute_Facets() {
+	filter := repository.ProductFilter{At: testNow, Status: entity.ProductActive, CategoryPrefix: "Electronics", PriceCurrency: entity.BaseCurrency}
+	suite.repositoryMock.On("ListProducts", mock.Anything, repository.ProductQuery{ProductFilter: filter, Limit: DefaultPageLimit + 1}).Return([]entity.Product{}, nil)
+	suite.repositoryMock.On("CountProductFacets", mock.Anything, repository.ProductFacetQuery{ProductFilter: filter, PriceBreaks: priceBreaks}).Return(&repository.ProductFacetCounts{
+		Conditions: []repository.FacetCount{{Value: "new", Count: 2}, {Value: "used", Count: 1}},
+		Categories: []repository.FacetCount{
+			{Value: "Electronics", Count: 4},
+			{Value: "Electronics > Audio > Headphones", Count: 1},
+			{Value: "Electronics > Computers", Count: 1},
+			{Value: "Electronics > Audio > Speakers", Count: 2},
+		},
+		Sellers:     []repository.FacetCount{{Value: "SELLER001", Label: "Apple Store", Count: 2}},
+		PriceRanges: []int{0, 0, 0, 2, 0, 1},
+	}, nil)
+
+	useCase := NewListProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	useCase.now = testClock
+	result, err := useCase.Execute(context.Background(), dto.ListProductInputDTO{
+		ProductFiltersDTO: dto.ProductFiltersDTO{Category: "Electronics"},
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []dto.FacetBucketDTO{{Value: "new", Count: 2}, {Value: "used", Count: 1}}, result.Facets.Condition)
+	assert.Equal(suite.T(), dto.CategoryFacetDTO{
+		Level: 2,
+		Buckets: []dto.FacetBucketDTO{
+			{Value: "Electronics > Audio", Label: "Audio", Count: 3},
+			{Value: "Electronics > Computers", Label: "Computers", Count: 1},
+		},
+	}, result.Facets.Category)
+	assert.Equal(suite.T(), []dto.FacetBucketDTO{{Value: "SELLER001", Label: "Apple Store", Count: 2}}, result.Facets.Seller)
+	assert.Equal(suite.T(), []dto.PriceRangeBucketDTO{
+		{Min: 250, Max: ptr(500.0), Currency: "USD", Count: 2},
+		{Min: 1000, Currency: "USD", Count: 1},
+	}, result.Facets.PriceRange)
+}
+
+func (suite *ListProductUseCaseTestSuite) TestListProductUseCase_Execute_FacetsError() {
+	suite.repositoryMock.On("ListProducts", mock.Anything, mock.Anything).Return([]entity.Product{}, nil)
+	suite.repositoryMock.On("CountProductFacets", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("%w: connection timeout", errors.ErrDatabaseError))
+
+	useCase := NewListProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	useCase.now = testClock
+	result, err := useCase.Execute(context.Background(), dto.ListProductInputDTO{})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrDatabaseError)
+}
+
+func (suite *ListProductUseCaseTestSuite) TestListProductUseCase_Execute_ExpandsImagesAndSeller() {
+	products := []entity.Product{
+		{ID: "MLB001", SellerID: "SELLER001", SellerName: "TechWorld Store"},
+		{ID: "MLB002", SellerID: "SELLER002", SellerName: "Gamer Shop"},
+	}
+	images := map[string][]entity.ProductImage{
+		"MLB001": {
+			{ID: 1, ProductID: "MLB001", ImageURL: "https://example.com/1.jpg", DisplayOrder: 0},
+			{ID: 2, ProductID: "MLB001", ImageURL: "https://example.com/2.jpg", DisplayOrder: 1},
+		},
+	}
+
+	suite.repositoryMock.On("ListProducts", mock.Anything, mock.Anything).Return(products, nil)
+	suite.repositoryMock.On("CountProductFacets", mock.Anything, mock.Anything).Return(&repository.ProductFacetCounts{}, nil)
+	suite.repositoryMock.On("FindImagesByProductIDs", mock.Anything, []string{"MLB001", "MLB002"}).Return(images, nil).Once()
+
+	useCase := NewListProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	useCase.now = testClock
+	result, err := useCase.Execute(context.Background(), dto.ListProductInputDTO{
+		Expand: dto.ProductExpandDTO{Images: true, Seller: true},
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Products[0].Images, 2)
+	assert.Equal(suite.T(), "https://example.com/2.jpg", result.Products[0].Images[1].ImageURL)
+	assert.Empty(suite.T(), result.Products[1].Images)
+	assert.Equal(suite.T(), &dto.SellerDTO{ID: "SELLER001", Name: "TechWorld Store"}, result.Products[0].Seller)
+	assert.Equal(suite.T(), &dto.SellerDTO{ID: "SELLER002", Name: "Gamer Shop"}, result.Products[1].Seller)
+	suite.repositoryMock.AssertNotCalled(suite.T(), "FindImagesByProductID", mock.Anything, mock.Anything)
+}
+
+func (suite *ListProductUseCaseTestSuite) TestListProductUseCase_Execute_ExpandImagesError() {
+	suite.repositoryMock.On("ListProducts", mock.Anything, mock.Anything).Return([]entity.Product{{ID: "MLB001"}}, nil)
+	suite.repositoryMock.On("CountProductFacets", mock.Anything, mock.Anything).Return(&repository.ProductFacetCounts{}, nil)
+	suite.repositoryMock.On("FindImagesByProductIDs", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("%w: connection timeout", errors.ErrDatabaseError))
+
+	useCase := NewListProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	useCase.now = testClock
+	result, err := useCase.Execute(context.Background(), dto.ListProductInputDTO{
+		Expand: dto.ProductExpandDTO{Images: true},
+	})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrDatabaseError)
+}
+
+// testNow is the time the listing use cases under test run at, so that the
+// filters they build can be matched.
+var testNow = time.Date(2024, 11, 29, 12, 0, 0, 0, time.UTC)
+
+func testClock() time.Time {
+	return testNow
+}
+
+// activeListings is the filter of a listing without status, which only
+// lists active listings.
+var activeListings = repository.ProductFilter{At: testNow, Status: entity.ProductActive, PriceCurrency: entity.BaseCurrency}
+
+func ptr[T any](value T) *T {
+	return &value
+}
+
+func (suite *ListProductUseCaseTestSuite) TestListProductUseCase_Execute_ConvertsCurrency() {
+	products := []entity.Product{
+		{ID: "MLB001", Price: entity.Money{Amount: 10000, Currency: "USD"}},
+		{ID: "MLB002", Price: entity.Money{Amount: 49200, Currency: "BRL"}},
+		{ID: "MLB003", Price: entity.Money{Amount: 50, Currency: "JPY"}},
+	}
+	// Price filters and facets are in the currency prices are shown in.
+	inBRL := activeListings
+	inBRL.PriceCurrency = "BRL"
+	suite.repositoryMock.On("ListProducts", mock.Anything, repository.ProductQuery{ProductFilter: inBRL, Limit: DefaultPageLimit + 1}).Return(products, nil)
+	suite.repositoryMock.On("CountProductFacets", mock.Anything, mock.Anything).Return(&repository.ProductFacetCounts{}, nil)
+	suite.exchangeRateRepositoryMock.On("ListExchangeRates", mock.Anything).Return([]entity.ExchangeRate{
+		{Currency: "BRL", Rate: 4.92},
+		{Currency: "USD", Rate: 1},
+	}, nil)
+
+	useCase := NewListProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	useCase.now = testClock
+	result, err := useCase.Execute(context.Background(), dto.ListProductInputDTO{Currency: "BRL"})
+
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), result.Products[0].Converted)
+	assert.Equal(suite.T(), 492.0, result.Products[0].Price)
+	assert.Equal(suite.T(), "BRL", result.Products[0].Currency)
+	assert.Equal(suite.T(), 100.0, *result.Products[0].OriginalPrice)
+	assert.Equal(suite.T(), "USD", result.Products[0].OriginalCurrency)
+
+	assert.False(suite.T(), result.Products[0].NotConverted)
+
+	// Already in BRL, and a currency without a rate: both left as they are,
+	// only the latter marked as not converted.
+	for _, product := range result.Products[1:] {
+		assert.False(suite.T(), product.Converted)
+		assert.Nil(suite.T(), product.OriginalPrice)
+	}
+	assert.False(suite.T(), result.Products[1].NotConverted)
+	assert.Equal(suite.T(), "JPY", result.Products[2].Currency)
+	assert.True(suite.T(), result.Products[2].NotConverted)
+}
+
+func (suite *ListProductUseCaseTestSuite) TestListProductUseCase_Execute_UnknownCurrency() {
+	suite.exchangeRateRepositoryMock.On("ListExchangeRates", mock.Anything).Return([]entity.ExchangeRate{
+		{Currency: "BRL", Rate: 4.92},
+		{Currency: "USD", Rate: 1},
+	}, nil)
+
+	useCase := NewListProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	useCase.now = testClock
+	result, err := useCase.Execute(context.Background(), dto.ListProductInputDTO{Currency: "XYZ"})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrUnknownCurrency)
+	suite.repositoryMock.AssertNotCalled(suite.T(), "ListProducts", mock.Anything, mock.Anything)
+}
+
+func (suite *ListProductUseCaseTestSuite) TestListProductUseCase_Execute_Promotions() {
+	now := testNow
+	products := []entity.Product{
+		{ID: "MLB001", Price: entity.Money{Amount: 10000, Currency: "USD"}, CategoryID: ptr(int64(6))},
+		{ID: "MLB002", Price: entity.Money{Amount: 20000, Currency: "USD"}, CategoryID: ptr(int64(7))},
+		{ID: "MLB003", Price: entity.Money{Amount: 5000, Currency: "BRL"}},
+		{ID: "MLB004", Price: entity.Money{Amount: 5000, Currency: "BRL"}},
+	}
+	suite.repositoryMock.On("ListProducts", mock.Anything, repository.ProductQuery{ProductFilter: activeListings, Limit: DefaultPageLimit + 1}).Return(products, nil)
+	suite.repositoryMock.On("CountProductFacets", mock.Anything, mock.Anything).Return(&repository.ProductFacetCounts{}, nil)
+	suite.categoryRepositoryMock.On("FindCategoryPaths", mock.Anything, []int64{6, 7}).Return(map[int64][]entity.Category{
+		6: {{ID: 1}, {ID: 6}},
+		7: {{ID: 1}, {ID: 7}},
+	}, nil)
+	promotionRepositoryMock := new(repository.MockPromotionRepository)
+	promotionRepositoryMock.On("ListPromotions", mock.Anything, repository.PromotionQuery{ActiveAt: &now}).Return([]entity.Promotion{
+		{ID: 1, CategoryID: ptr(int64(1)), Type: entity.PromotionPercentage, PercentOff: 1000},
+		{ID: 2, CategoryID: ptr(int64(6)), Type: entity.PromotionPercentage, PercentOff: 2000},
+		{ID: 3, ProductID: ptr("MLB002"), Type: entity.PromotionFixed, AmountOff: entity.Money{Amount: 500, Currency: "BRL"}, Priority: 5},
+		{ID: 4, ProductID: ptr("MLB003"), Type: entity.PromotionPercentage, PercentOff: 5000},
+		{ID: 5, ProductID: ptr("MLB003"), Type: entity.PromotionFixed, AmountOff: entity.Money{Amount: 1000, Currency: "BRL"}, Priority: 1},
+	}, nil)
+
+	useCase := NewListProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, promotionRepositoryMock)
+	useCase.now = func() time.Time { return now }
+	result, err := useCase.Execute(context.Background(), dto.ListProductInputDTO{})
+
+	assert.NoError(suite.T(), err)
+	// The promotion of the subcategory wins over the one of its parent.
+	assert.Equal(suite.T(), 80.0, result.Products[0].Price)
+	assert.Equal(suite.T(), int64(2), *result.Products[0].PromotionID)
+	// A fixed amount in another currency does not apply, whatever its priority.
+	assert.Equal(suite.T(), 180.0, result.Products[1].Price)
+	assert.Equal(suite.T(), 200.0, *result.Products[1].OriginalPrice)
+	assert.Equal(suite.T(), 10.0, result.Products[1].DiscountPercent)
+	// The highest priority wins, even with the smaller discount.
+	assert.Equal(suite.T(), 40.0, result.Products[2].Price)
+	assert.Equal(suite.T(), int64(5), *result.Products[2].PromotionID)
+	assert.Equal(suite.T(), 20.0, result.Products[2].DiscountPercent)
+	// Without a promotion the price is left as it is.
+	assert.Equal(suite.T(), 50.0, result.Products[3].Price)
+	assert.Nil(suite.T(), result.Products[3].OriginalPrice)
+	assert.Nil(suite.T(), result.Products[3].PromotionID)
+	assert.Zero(suite.T(), result.Products[3].DiscountPercent)
+}
+
+func (suite *ListProductUseCaseTestSuite) TestListProductUseCase_Execute_PromotionsError() {
+	suite.repositoryMock.On("ListProducts", mock.Anything, mock.Anything).Return([]entity.Product{{ID: "MLB001"}}, nil)
+	suite.repositoryMock.On("CountProductFacets", mock.Anything, mock.Anything).Return(&repository.ProductFacetCounts{}, nil)
+	promotionRepositoryMock := new(repository.MockPromotionRepository)
+	promotionRepositoryMock.On("ListPromotions", mock.Anything, mock.Anything).Return(nil, errors.ErrDatabaseError)
+
+	useCase := NewListProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, promotionRepositoryMock)
+	useCase.now = testClock
+	result, err := useCase.Execute(context.Background(), dto.ListProductInputDTO{})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrDatabaseError)
 }
 
 func TestListProductUseCaseTestSuite(t *testing.T) {
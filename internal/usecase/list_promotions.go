@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/repository"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type ListPromotionsUseCase struct {
+	promotionRepository repository.PromotionRepositoryInterface
+	now                 func() time.Time
+}
+
+func NewListPromotionsUseCase(promotionRepo repository.PromotionRepositoryInterface) *ListPromotionsUseCase {
+	return &ListPromotionsUseCase{
+		promotionRepository: promotionRepo,
+		now:                 time.Now,
+	}
+}
+
+// Execute lists every promotion, past and scheduled ones included, unless
+// input.Active keeps only the ones active now.
+func (p *ListPromotionsUseCase) Execute(ctx context.Context, input dto.ListPromotionsInputDTO) ([]dto.PromotionDTO, error) {
+	log.Debug().
+		Bool("active", input.Active).
+		Msg("Executing ListPromotions use case")
+
+	now := p.now()
+	query := repository.PromotionQuery{}
+	if input.Active {
+		query.ActiveAt = &now
+	}
+
+	promotions, err := p.promotionRepository.ListPromotions(ctx, query)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to list promotions from repository")
+		return nil, fmt.Errorf("failed to list promotions: %w", err)
+	}
+
+	log.Info().
+		Int("promotions_count", len(promotions)).
+		Msg("Promotions listed successfully")
+
+	return toPromotionsDTO(promotions, now), nil
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ListPromotionsUseCaseTestSuite struct {
+	suite.Suite
+	promotionRepositoryMock *repository.MockPromotionRepository
+	useCase                 *ListPromotionsUseCase
+	now                     time.Time
+}
+
+func (suite *ListPromotionsUseCaseTestSuite) BeforeTest(suiteName, testName string) {
+	suite.promotionRepositoryMock = new(repository.MockPromotionRepository)
+	suite.now = time.Date(2024, 11, 29, 12, 0, 0, 0, time.UTC)
+
+	suite.useCase = NewListPromotionsUseCase(suite.promotionRepositoryMock)
+	suite.useCase.now = func() time.Time { return suite.now }
+}
+
+func (suite *ListPromotionsUseCaseTestSuite) TestListPromotionsUseCase_Execute_All() {
+	suite.promotionRepositoryMock.On("ListPromotions", mock.Anything, repository.PromotionQuery{}).Return([]entity.Promotion{
+		{ID: 1, ProductID: ptr("MLB001"), Type: entity.PromotionPercentage, PercentOff: 1500, StartsAt: suite.now.Add(-time.Hour), EndsAt: suite.now.Add(time.Hour)},
+		{ID: 2, CategoryID: ptr(int64(6)), Type: entity.PromotionFixed, AmountOff: entity.Money{Amount: 5000, Currency: "BRL"}, StartsAt: suite.now.Add(time.Hour), EndsAt: suite.now.Add(2 * time.Hour)},
+	}, nil)
+
+	result, err := suite.useCase.Execute(context.Background(), dto.ListPromotionsInputDTO{})
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result, 2)
+	assert.Equal(suite.T(), 15.0, result[0].Value)
+	assert.True(suite.T(), result[0].Active)
+	assert.Equal(suite.T(), 50.0, result[1].Value)
+	assert.Equal(suite.T(), "BRL", result[1].Currency)
+	assert.False(suite.T(), result[1].Active)
+}
+
+func (suite *ListPromotionsUseCaseTestSuite) TestListPromotionsUseCase_Execute_Active() {
+	suite.promotionRepositoryMock.On("ListPromotions", mock.Anything, repository.PromotionQuery{ActiveAt: &suite.now}).Return([]entity.Promotion{}, nil)
+
+	result, err := suite.useCase.Execute(context.Background(), dto.ListPromotionsInputDTO{Active: true})
+
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), result)
+	suite.promotionRepositoryMock.AssertExpectations(suite.T())
+}
+
+func (suite *ListPromotionsUseCaseTestSuite) TestListPromotionsUseCase_Execute_DatabaseError() {
+	suite.promotionRepositoryMock.On("ListPromotions", mock.Anything, mock.Anything).Return(nil, errors.ErrDatabaseError)
+
+	result, err := suite.useCase.Execute(context.Background(), dto.ListPromotionsInputDTO{})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrDatabaseError)
+}
+
+func TestListPromotionsUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ListPromotionsUseCaseTestSuite))
+}
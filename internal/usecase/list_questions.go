@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// publicQuestionStatuses are the questions listed when no status is asked
+// for: hidden questions are only listed on request.
+var publicQuestionStatuses = []string{entity.QuestionUnanswered, entity.QuestionAnswered}
+
+type ListQuestionsUseCase struct {
+	productRepository  repository.ProductRepositoryInterface
+	questionRepository repository.QuestionRepositoryInterface
+	now                func() time.Time
+}
+
+func NewListQuestionsUseCase(productRepo repository.ProductRepositoryInterface, questionRepo repository.QuestionRepositoryInterface) *ListQuestionsUseCase {
+	return &ListQuestionsUseCase{
+		productRepository:  productRepo,
+		questionRepository: questionRepo,
+		now:                time.Now,
+	}
+}
+
+// Execute returns one page of the questions of a product, newest first. An
+// unknown or deleted product, or a listing the caller may not see, is
+// reported as ErrProductNotFound rather than as a product without questions.
+func (p *ListQuestionsUseCase) Execute(ctx context.Context, input dto.ListQuestionsInputDTO) (*dto.QuestionListDTO, error) {
+	log.Debug().
+		Str("product_id", input.ProductID).
+		Str("status", input.Status).
+		Int("limit", input.Limit).
+		Str("cursor", input.Cursor).
+		Msg("Executing ListQuestions use case")
+
+	if strings.TrimSpace(input.ProductID) == "" {
+		log.Warn().Msg("Invalid product ID: empty or whitespace")
+		return nil, errors.ErrInvalidProductID
+	}
+
+	statuses := publicQuestionStatuses
+	if input.Status != "" {
+		if !entity.IsValidQuestionStatus(input.Status) {
+			log.Warn().Str("status", input.Status).Msg("Invalid question status")
+			return nil, errors.NewInvalidInputError("status must be 'unanswered', 'answered', or 'hidden'")
+		}
+		statuses = []string{input.Status}
+	}
+
+	limit, err := pageLimit(input.Limit)
+	if err != nil {
+		log.Warn().Err(err).Int("limit", input.Limit).Msg("Invalid page limit")
+		return nil, err
+	}
+
+	beforeID, err := decodeIDCursor(input.Cursor)
+	if err != nil {
+		log.Warn().Err(err).Str("cursor", input.Cursor).Msg("Invalid page cursor")
+		return nil, err
+	}
+
+	product, err := p.productRepository.GetProduct(ctx, input.ProductID, false)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ProductID).
+			Msg("Failed to get product from repository")
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	product.Status = product.ListingStatus(p.now())
+
+	if !isListingVisible(*product, input.SellerID, input.IsAdmin) {
+		log.Warn().
+			Str("product_id", input.ProductID).
+			Str("status", product.Status).
+			Msg("Listing is not visible to the caller")
+		return nil, fmt.Errorf("failed to get product: %w", errors.ErrProductNotFound)
+	}
+
+	questions, err := p.questionRepository.ListQuestions(ctx, repository.QuestionQuery{
+		ProductID: input.ProductID,
+		Statuses:  statuses,
+		BeforeID:  beforeID,
+		Limit:     limit + 1,
+	})
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ProductID).
+			Msg("Failed to list questions from repository")
+		return nil, fmt.Errorf("failed to list questions: %w", err)
+	}
+
+	pagination := dto.PaginationDTO{Limit: limit}
+	if len(questions) > limit {
+		questions = questions[:limit]
+		pagination.HasMore = true
+		pagination.NextCursor = encodeIDCursor(questions[limit-1].ID)
+	}
+
+	log.Info().
+		Str("product_id", input.ProductID).
+		Int("questions_count", len(questions)).
+		Bool("has_more", pagination.HasMore).
+		Msg("Questions listed successfully")
+
+	return &dto.QuestionListDTO{
+		Questions:  toQuestionsDTO(questions),
+		Pagination: pagination,
+	}, nil
+}
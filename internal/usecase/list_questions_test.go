@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ListQuestionsUseCaseTestSuite struct {
+	suite.Suite
+	repositoryMock         *repository.MockProductRepository
+	questionRepositoryMock *repository.MockQuestionRepository
+}
+
+func (suite *ListQuestionsUseCaseTestSuite) BeforeTest(suiteName, testName string) {
+	suite.repositoryMock = new(repository.MockProductRepository)
+	suite.questionRepositoryMock = new(repository.MockQuestionRepository)
+}
+
+func (suite *ListQuestionsUseCaseTestSuite) useCase() *ListQuestionsUseCase {
+	return NewListQuestionsUseCase(suite.repositoryMock, suite.questionRepositoryMock)
+}
+
+func (suite *ListQuestionsUseCaseTestSuite) TestListQuestionsUseCase_Execute_Pages() {
+	questions := []entity.Question{
+		{ID: 3, ProductID: "MLB001", Status: entity.QuestionUnanswered},
+		{ID: 2, ProductID: "MLB001", Status: entity.QuestionAnswered},
+		{ID: 1, ProductID: "MLB001", Status: entity.QuestionAnswered},
+	}
+	public := []string{entity.QuestionUnanswered, entity.QuestionAnswered}
+
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(&entity.Product{ID: "MLB001", Status: entity.ProductActive}, nil)
+	suite.questionRepositoryMock.On("ListQuestions", mock.Anything, repository.QuestionQuery{ProductID: "MLB001", Statuses: public, Limit: 3}).Return(questions, nil)
+	suite.questionRepositoryMock.On("ListQuestions", mock.Anything, repository.QuestionQuery{ProductID: "MLB001", Statuses: public, BeforeID: 2, Limit: 3}).Return(questions[2:], nil)
+
+	result, err := suite.useCase().Execute(context.Background(), dto.ListQuestionsInputDTO{ProductID: "MLB001", Limit: 2})
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Questions, 2)
+	assert.Equal(suite.T(), int64(3), result.Questions[0].ID)
+	assert.True(suite.T(), result.Pagination.HasMore)
+
+	result, err = suite.useCase().Execute(context.Background(), dto.ListQuestionsInputDTO{ProductID: "MLB001", Limit: 2, Cursor: result.Pagination.NextCursor})
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Questions, 1)
+	assert.Equal(suite.T(), int64(1), result.Questions[0].ID)
+	assert.False(suite.T(), result.Pagination.HasMore)
+}
+
+func (suite *ListQuestionsUseCaseTestSuite) TestListQuestionsUseCase_Execute_FiltersStatus() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(&entity.Product{ID: "MLB001", Status: entity.ProductActive}, nil)
+	suite.questionRepositoryMock.On("ListQuestions", mock.Anything, repository.QuestionQuery{
+		ProductID: "MLB001",
+		Statuses:  []string{entity.QuestionHidden},
+		Limit:     DefaultPageLimit + 1,
+	}).Return([]entity.Question{{ID: 4, ProductID: "MLB001", Status: entity.QuestionHidden}}, nil)
+
+	result, err := suite.useCase().Execute(context.Background(), dto.ListQuestionsInputDTO{ProductID: "MLB001", Status: entity.QuestionHidden})
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Questions, 1)
+	assert.Equal(suite.T(), entity.QuestionHidden, result.Questions[0].Status)
+}
+
+func (suite *ListQuestionsUseCaseTestSuite) TestListQuestionsUseCase_Execute_InvalidStatus() {
+	result, err := suite.useCase().Execute(context.Background(), dto.ListQuestionsInputDTO{ProductID: "MLB001", Status: "deleted"})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrInvalidInput)
+	suite.questionRepositoryMock.AssertNotCalled(suite.T(), "ListQuestions", mock.Anything, mock.Anything)
+}
+
+func (suite *ListQuestionsUseCaseTestSuite) TestListQuestionsUseCase_Execute_ProductNotFound() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB999", false).Return(nil, errors.ErrProductNotFound)
+
+	result, err := suite.useCase().Execute(context.Background(), dto.ListQuestionsInputDTO{ProductID: "MLB999"})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrProductNotFound)
+	suite.questionRepositoryMock.AssertNotCalled(suite.T(), "ListQuestions", mock.Anything, mock.Anything)
+}
+
+func (suite *ListQuestionsUseCaseTestSuite) TestListQuestionsUseCase_Execute_ListingNotActive() {
+	publishAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	product := &entity.Product{ID: "MLB001", SellerID: "SELLER001", Status: entity.ProductScheduled, PublishAt: &publishAt, Stock: 5}
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(product, nil)
+	suite.questionRepositoryMock.On("ListQuestions", mock.Anything, mock.Anything).Return([]entity.Question{}, nil)
+
+	useCase := suite.useCase()
+	useCase.now = func() time.Time { return publishAt.Add(-time.Hour) }
+
+	result, err := useCase.Execute(context.Background(), dto.ListQuestionsInputDTO{ProductID: "MLB001"})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrProductNotFound)
+	suite.questionRepositoryMock.AssertNotCalled(suite.T(), "ListQuestions", mock.Anything, mock.Anything)
+
+	for _, input := range []dto.ListQuestionsInputDTO{
+		{ProductID: "MLB001", SellerID: "SELLER001"},
+		{ProductID: "MLB001", IsAdmin: true},
+	} {
+		result, err = useCase.Execute(context.Background(), input)
+
+		assert.NoError(suite.T(), err)
+		assert.NotNil(suite.T(), result)
+	}
+
+	// Once published, the listing is shown to everyone.
+	useCase.now = func() time.Time { return publishAt }
+	result, err = useCase.Execute(context.Background(), dto.ListQuestionsInputDTO{ProductID: "MLB001"})
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+}
+
+func TestListQuestionsUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ListQuestionsUseCaseTestSuite))
+}
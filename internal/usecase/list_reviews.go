@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/errors"
+	"project/internal/repository"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type ListReviewsUseCase struct {
+	productRepository repository.ProductRepositoryInterface
+	reviewRepository  repository.ReviewRepositoryInterface
+	now               func() time.Time
+}
+
+func NewListReviewsUseCase(productRepo repository.ProductRepositoryInterface, reviewRepo repository.ReviewRepositoryInterface) *ListReviewsUseCase {
+	return &ListReviewsUseCase{
+		productRepository: productRepo,
+		reviewRepository:  reviewRepo,
+		now:               time.Now,
+	}
+}
+
+// Execute returns one page of the reviews of a product, newest first. An
+// unknown or deleted product, or a listing the caller may not see, is
+// reported as ErrProductNotFound rather than as a product without reviews.
+func (p *ListReviewsUseCase) Execute(ctx context.Context, input dto.ListReviewsInputDTO) (*dto.ReviewListDTO, error) {
+	log.Debug().
+		Str("product_id", input.ProductID).
+		Int("limit", input.Limit).
+		Str("cursor", input.Cursor).
+		Msg("Executing ListReviews use case")
+
+	if strings.TrimSpace(input.ProductID) == "" {
+		log.Warn().Msg("Invalid product ID: empty or whitespace")
+		return nil, errors.ErrInvalidProductID
+	}
+
+	limit, err := pageLimit(input.Limit)
+	if err != nil {
+		log.Warn().Err(err).Int("limit", input.Limit).Msg("Invalid page limit")
+		return nil, err
+	}
+
+	beforeID, err := decodeIDCursor(input.Cursor)
+	if err != nil {
+		log.Warn().Err(err).Str("cursor", input.Cursor).Msg("Invalid page cursor")
+		return nil, err
+	}
+
+	product, err := p.productRepository.GetProduct(ctx, input.ProductID, false)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ProductID).
+			Msg("Failed to get product from repository")
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	product.Status = product.ListingStatus(p.now())
+
+	if !isListingVisible(*product, input.SellerID, input.IsAdmin) {
+		log.Warn().
+			Str("product_id", input.ProductID).
+			Str("status", product.Status).
+			Msg("Listing is not visible to the caller")
+		return nil, fmt.Errorf("failed to get product: %w", errors.ErrProductNotFound)
+	}
+
+	reviews, err := p.reviewRepository.ListReviews(ctx, repository.ReviewQuery{
+		ProductID: input.ProductID,
+		BeforeID:  beforeID,
+		Limit:     limit + 1,
+	})
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ProductID).
+			Msg("Failed to list reviews from repository")
+		return nil, fmt.Errorf("failed to list reviews: %w", err)
+	}
+
+	pagination := dto.PaginationDTO{Limit: limit}
+	if len(reviews) > limit {
+		reviews = reviews[:limit]
+		pagination.HasMore = true
+		pagination.NextCursor = encodeIDCursor(reviews[limit-1].ID)
+	}
+
+	reviewsDto := make([]dto.ReviewDTO, 0, len(reviews))
+	for _, review := range reviews {
+		reviewsDto = append(reviewsDto, toReviewDTO(review))
+	}
+
+	log.Info().
+		Str("product_id", input.ProductID).
+		Int("reviews_count", len(reviews)).
+		Bool("has_more", pagination.HasMore).
+		Msg("Reviews listed successfully")
+
+	return &dto.ReviewListDTO{
+		Reviews:    reviewsDto,
+		Pagination: pagination,
+	}, nil
+}
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ListReviewsUseCaseTestSuite struct {
+	suite.Suite
+	repositoryMock       *repository.MockProductRepository
+	reviewRepositoryMock *repository.MockReviewRepository
+}
+
+func (suite *ListReviewsUseCaseTestSuite) BeforeTest(suiteName, testName string) {
+	suite.repositoryMock = new(repository.MockProductRepository)
+	suite.reviewRepositoryMock = new(repository.MockReviewRepository)
+}
+
+func (suite *ListReviewsUseCaseTestSuite) useCase() *ListReviewsUseCase {
+	return NewListReviewsUseCase(suite.repositoryMock, suite.reviewRepositoryMock)
+}
+
+func (suite *ListReviewsUseCaseTestSuite) TestListReviewsUseCase_Execute_Pages() {
+	reviews := []entity.Review{
+		{ID: 3, ProductID: "MLB001", Rating: 5},
+		{ID: 2, ProductID: "MLB001", Rating: 4},
+		{ID: 1, ProductID: "MLB001", Rating: 5},
+	}
+
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(&entity.Product{ID: "MLB001", Status: entity.ProductActive}, nil)
+	suite.reviewRepositoryMock.On("ListReviews", mock.Anything, repository.ReviewQuery{ProductID: "MLB001", Limit: 3}).Return(reviews, nil)
+	suite.reviewRepositoryMock.On("ListReviews", mock.Anything, repository.ReviewQuery{ProductID: "MLB001", BeforeID: 2, Limit: 3}).Return(reviews[2:], nil)
+
+	result, err := suite.useCase().Execute(context.Background(), dto.ListReviewsInputDTO{ProductID: "MLB001", Limit: 2})
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Reviews, 2)
+	assert.Equal(suite.T(), int64(3), result.Reviews[0].ID)
+	assert.True(suite.T(), result.Pagination.HasMore)
+	assert.NotEmpty(suite.T(), result.Pagination.NextCursor)
+
+	result, err = suite.useCase().Execute(context.Background(), dto.ListReviewsInputDTO{ProductID: "MLB001", Limit: 2, Cursor: result.Pagination.NextCursor})
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Reviews, 1)
+	assert.Equal(suite.T(), int64(1), result.Reviews[0].ID)
+	assert.False(suite.T(), result.Pagination.HasMore)
+	assert.Empty(suite.T(), result.Pagination.NextCursor)
+}
+
+func (suite *ListReviewsUseCaseTestSuite) TestListReviewsUseCase_Execute_ProductNotFound() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB999", false).Return(nil, errors.ErrProductNotFound)
+
+	result, err := suite.useCase().Execute(context.Background(), dto.ListReviewsInputDTO{ProductID: "MLB999"})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrProductNotFound)
+	suite.reviewRepositoryMock.AssertNotCalled(suite.T(), "ListReviews", mock.Anything, mock.Anything)
+}
+
+func (suite *ListReviewsUseCaseTestSuite) TestListReviewsUseCase_Execute_InvalidCursor() {
+	for _, cursor := range []string{"not-a-cursor", encodeCursor(pageCursor{AfterID: "MLB001"}), encodeCursor(pageCursor{Offset: 20})} {
+		result, err := suite.useCase().Execute(context.Background(), dto.ListReviewsInputDTO{ProductID: "MLB001", Cursor: cursor})
+
+		assert.Nil(suite.T(), result)
+		assert.ErrorIs(suite.T(), err, errors.ErrInvalidInput)
+	}
+}
+
+func (suite *ListReviewsUseCaseTestSuite) TestListReviewsUseCase_Execute_ListingNotActive() {
+	publishAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	product := &entity.Product{ID: "MLB001", SellerID: "SELLER001", Status: entity.ProductScheduled, PublishAt: &publishAt, Stock: 5}
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(product, nil)
+	suite.reviewRepositoryMock.On("ListReviews", mock.Anything, mock.Anything).Return([]entity.Review{}, nil)
+
+	useCase := suite.useCase()
+	useCase.now = func() time.Time { return publishAt.Add(-time.Hour) }
+
+	result, err := useCase.Execute(context.Background(), dto.ListReviewsInputDTO{ProductID: "MLB001"})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrProductNotFound)
+	suite.reviewRepositoryMock.AssertNotCalled(suite.T(), "ListReviews", mock.Anything, mock.Anything)
+
+	for _, input := range []dto.ListReviewsInputDTO{
+		{ProductID: "MLB001", SellerID: "SELLER001"},
+		{ProductID: "MLB001", IsAdmin: true},
+	} {
+		result, err = useCase.Execute(context.Background(), input)
+
+		assert.NoError(suite.T(), err)
+		assert.NotNil(suite.T(), result)
+	}
+
+	// Once published, the listing is shown to everyone.
+	useCase.now = func() time.Time { return publishAt }
+	result, err = useCase.Execute(context.Background(), dto.ListReviewsInputDTO{ProductID: "MLB001"})
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+}
+
+func TestListReviewsUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ListReviewsUseCaseTestSuite))
+}
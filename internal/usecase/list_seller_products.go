@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/errors"
+	"project/internal/repository"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ListSellerProductsUseCase lists the products of one seller with the same
+// pagination, filters, facets, expansion, promotions, price conversion and
+// installments as ListProductUseCase.
+type ListSellerProductsUseCase struct {
+	sellerRepository repository.SellerRepositoryInterface
+	listProducts     *ListProductUseCase
+}
+
+func NewListSellerProductsUseCase(productRepo repository.ProductRepositoryInterface, categoryRepo repository.CategoryRepositoryInterface, sellerRepo repository.SellerRepositoryInterface, exchangeRateRepo repository.ExchangeRateRepositoryInterface, installmentPlanRepo repository.InstallmentPlanRepositoryInterface, promotionRepo repository.PromotionRepositoryInterface) *ListSellerProductsUseCase {
+	return &ListSellerProductsUseCase{
+		sellerRepository: sellerRepo,
+		listProducts:     NewListProductUseCase(productRepo, categoryRepo, exchangeRateRepo, installmentPlanRepo, promotionRepo),
+	}
+}
+
+// Execute returns ErrSellerNotFound for an unknown seller rather than an
+// empty page, so a typo in the seller ID is not mistaken for a seller
+// without products.
+func (p *ListSellerProductsUseCase) Execute(ctx context.Context, input dto.ListSellerProductsInputDTO) (*dto.ProductListDTO, error) {
+	log.Debug().
+		Str("seller_id", input.SellerID).
+		Msg("Executing ListSellerProducts use case")
+
+	if strings.TrimSpace(input.SellerID) == "" {
+		log.Warn().Msg("Invalid seller ID: empty or whitespace")
+		return nil, errors.NewInvalidInputError("seller id is required")
+	}
+
+	if _, err := p.sellerRepository.GetSeller(ctx, input.SellerID); err != nil {
+		log.Error().
+			Err(err).
+			Str("seller_id", input.SellerID).
+			Msg("Failed to get seller from repository")
+		return nil, fmt.Errorf("failed to get seller: %w", err)
+	}
+
+	listInput := input.ListProductInputDTO
+	listInput.SellerID = input.SellerID
+
+	return p.listProducts.Execute(ctx, listInput)
+}
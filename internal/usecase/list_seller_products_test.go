@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ListSellerProductsUseCaseTestSuite struct {
+	suite.Suite
+	repositoryMock                *repository.MockProductRepository
+	categoryRepositoryMock        *repository.MockCategoryRepository
+	sellerRepositoryMock          *repository.MockSellerRepository
+	exchangeRateRepositoryMock    *repository.MockExchangeRateRepository
+	installmentPlanRepositoryMock *repository.MockInstallmentPlanRepository
+	promotionRepositoryMock       *repository.MockPromotionRepository
+}
+
+func (suite *ListSellerProductsUseCaseTestSuite) BeforeTest(suiteName, testName string) {
+	suite.repositoryMock = new(repository.MockProductRepository)
+	suite.categoryRepositoryMock = new(repository.MockCategoryRepository)
+	suite.sellerRepositoryMock = new(repository.MockSellerRepository)
+	suite.exchangeRateRepositoryMock = new(repository.MockExchangeRateRepository)
+	suite.installmentPlanRepositoryMock = new(repository.MockInstallmentPlanRepository)
+	suite.installmentPlanRepositoryMock.On("ListInstallmentPlans", mock.Anything).Return([]entity.InstallmentPlan{}, nil)
+	suite.promotionRepositoryMock = new(repository.MockPromotionRepository)
+	suite.promotionRepositoryMock.On("ListPromotions", mock.Anything, mock.Anything).Return([]entity.Promotion{}, nil)
+}
+
+func (suite *ListSellerProductsUseCaseTestSuite) useCase() *ListSellerProductsUseCase {
+	useCase := NewListSellerProductsUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.sellerRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	useCase.listProducts.now = testClock
+	return useCase
+}
+
+func (suite *ListSellerProductsUseCaseTestSuite) TestListSellerProductsUseCase_Execute_FiltersBySeller() {
+	products := []entity.Product{{ID: "MLB001", SellerID: "SELLER001"}, {ID: "MLB005", SellerID: "SELLER001"}}
+	query := repository.ProductQuery{
+		ProductFilter: repository.ProductFilter{At: testNow, Status: entity.ProductActive, SellerID: "SELLER001", Condition: entity.Used, PriceCurrency: entity.BaseCurrency},
+		Limit:         DefaultPageLimit + 1,
+	}
+
+	suite.sellerRepositoryMock.On("GetSeller", mock.Anything, "SELLER001").Return(&entity.Seller{ID: "SELLER001"}, nil)
+	suite.repositoryMock.On("ListProducts", mock.Anything, query).Return(products, nil)
+	suite.repositoryMock.On("CountProductFacets", mock.Anything, mock.Anything).Return(&repository.ProductFacetCounts{}, nil)
+
+	result, err := suite.useCase().Execute(context.Background(), dto.ListSellerProductsInputDTO{
+		SellerID: "SELLER001",
+		ListProductInputDTO: dto.ListProductInputDTO{
+			ProductFiltersDTO: dto.ProductFiltersDTO{Condition: entity.Used},
+		},
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Products, 2)
+	suite.repositoryMock.AssertExpectations(suite.T())
+}
+
+func (suite *ListSellerProductsUseCaseTestSuite) TestListSellerProductsUseCase_Execute_UnknownSeller() {
+	suite.sellerRepositoryMock.On("GetSeller", mock.Anything, "SELLER999").Return(nil, errors.ErrSellerNotFound)
+
+	result, err := suite.useCase().Execute(context.Background(), dto.ListSellerProductsInputDTO{SellerID: "SELLER999"})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrSellerNotFound)
+	suite.repositoryMock.AssertNotCalled(suite.T(), "ListProducts", mock.Anything, mock.Anything)
+}
+
+func TestListSellerProductsUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ListSellerProductsUseCaseTestSuite))
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/errors"
+	"project/internal/repository"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+type ListStatusChangesUseCase struct {
+	productRepository repository.ProductRepositoryInterface
+}
+
+func NewListStatusChangesUseCase(productRepo repository.ProductRepositoryInterface) *ListStatusChangesUseCase {
+	return &ListStatusChangesUseCase{
+		productRepository: productRepo,
+	}
+}
+
+// Execute returns the status history of a product, oldest first, to its
+// seller and to administrators.
+func (p *ListStatusChangesUseCase) Execute(ctx context.Context, input dto.ListStatusChangesInputDTO) ([]dto.StatusChangeDTO, error) {
+	log.Debug().
+		Str("product_id", input.ProductID).
+		Str("seller_id", input.SellerID).
+		Bool("is_admin", input.IsAdmin).
+		Msg("Executing ListStatusChanges use case")
+
+	if strings.TrimSpace(input.ProductID) == "" {
+		log.Warn().Msg("Invalid product ID: empty or whitespace")
+		return nil, errors.ErrInvalidProductID
+	}
+
+	product, err := p.productRepository.GetProduct(ctx, input.ProductID, false)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ProductID).
+			Msg("Failed to get product from repository")
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	if !input.IsAdmin && (input.SellerID == "" || input.SellerID != product.SellerID) {
+		log.Warn().
+			Str("product_id", input.ProductID).
+			Str("seller_id", input.SellerID).
+			Msg("Status history read by someone other than the seller")
+		return nil, errors.NewForbiddenError("Only the seller of the product can read its status history")
+	}
+
+	changes, err := p.productRepository.ListStatusChanges(ctx, input.ProductID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ProductID).
+			Msg("Failed to list status changes from repository")
+		return nil, fmt.Errorf("failed to list status changes: %w", err)
+	}
+
+	log.Info().
+		Str("product_id", input.ProductID).
+		Int("changes_count", len(changes)).
+		Msg("Status changes listed successfully")
+
+	return toStatusChangesDTO(changes), nil
+}
@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ListStatusChangesUseCaseTestSuite struct {
+	suite.Suite
+	repositoryMock *repository.MockProductRepository
+}
+
+func (suite *ListStatusChangesUseCaseTestSuite) BeforeTest(suiteName, testName string) {
+	suite.repositoryMock = new(repository.MockProductRepository)
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(&entity.Product{ID: "MLB001", SellerID: "SELLER001", Status: entity.ProductActive}, nil)
+}
+
+func (suite *ListStatusChangesUseCaseTestSuite) TestListStatusChangesUseCase_Execute_BySeller() {
+	at := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	suite.repositoryMock.On("ListStatusChanges", mock.Anything, "MLB001").Return([]entity.StatusChange{
+		{ID: 1, ProductID: "MLB001", From: entity.ProductActive, To: entity.ProductPaused, Actor: entity.ActorSystem, Reason: entity.ReasonOutOfStock, CreatedAt: at},
+		{ID: 2, ProductID: "MLB001", From: entity.ProductPaused, To: entity.ProductActive, Actor: entity.ActorSeller, ActorID: "SELLER001", CreatedAt: at.Add(time.Hour)},
+	}, nil)
+
+	useCase := NewListStatusChangesUseCase(suite.repositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.ListStatusChangesInputDTO{ProductID: "MLB001", SellerID: "SELLER001"})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []dto.StatusChangeDTO{
+		{ID: 1, ProductID: "MLB001", From: "active", To: "paused", Actor: "system", Reason: "out of stock", CreatedAt: at},
+		{ID: 2, ProductID: "MLB001", From: "paused", To: "active", Actor: "seller", ActorID: "SELLER001", CreatedAt: at.Add(time.Hour)},
+	}, result)
+}
+
+func (suite *ListStatusChangesUseCaseTestSuite) TestListStatusChangesUseCase_Execute_ByAdmin() {
+	suite.repositoryMock.On("ListStatusChanges", mock.Anything, "MLB001").Return([]entity.StatusChange{}, nil)
+
+	useCase := NewListStatusChangesUseCase(suite.repositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.ListStatusChangesInputDTO{ProductID: "MLB001", IsAdmin: true})
+
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), result)
+}
+
+func (suite *ListStatusChangesUseCaseTestSuite) TestListStatusChangesUseCase_Execute_NotTheSeller() {
+	useCase := NewListStatusChangesUseCase(suite.repositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.ListStatusChangesInputDTO{ProductID: "MLB001", SellerID: "SELLER002"})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrForbidden)
+	suite.repositoryMock.AssertNotCalled(suite.T(), "ListStatusChanges", mock.Anything, mock.Anything)
+}
+
+func (suite *ListStatusChangesUseCaseTestSuite) TestListStatusChangesUseCase_Execute_EmptyID() {
+	useCase := NewListStatusChangesUseCase(suite.repositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.ListStatusChangesInputDTO{ProductID: " ", IsAdmin: true})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrInvalidProductID)
+}
+
+func TestListStatusChangesUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ListStatusChangesUseCaseTestSuite))
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+type LoadExchangeRatesUseCase struct {
+	exchangeRateRepository repository.ExchangeRateRepositoryInterface
+}
+
+func NewLoadExchangeRatesUseCase(exchangeRateRepo repository.ExchangeRateRepositoryInterface) *LoadExchangeRatesUseCase {
+	return &LoadExchangeRatesUseCase{
+		exchangeRateRepository: exchangeRateRepo,
+	}
+}
+
+// Execute saves the given rates, adding new currencies and replacing the rate
+// of known ones, and returns every rate. Nothing is saved when any rate is
+// invalid.
+func (p *LoadExchangeRatesUseCase) Execute(ctx context.Context, input dto.LoadExchangeRatesInputDTO) (*dto.ExchangeRatesDTO, error) {
+	log.Debug().
+		Int("rates_count", len(input.Rates)).
+		Msg("Executing LoadExchangeRates use case")
+
+	if len(input.Rates) == 0 {
+		log.Warn().Msg("Invalid exchange rates: none given")
+		return nil, errors.NewInvalidInputError("rates must contain at least one currency")
+	}
+
+	currencies := make([]string, 0, len(input.Rates))
+	for currency := range input.Rates {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+
+	rates := make([]entity.ExchangeRate, 0, len(currencies))
+	for _, currency := range currencies {
+		rate, err := entity.NewExchangeRate(strings.ToUpper(strings.TrimSpace(currency)), input.Rates[currency])
+		if err != nil {
+			log.Warn().
+				Err(err).
+				Str("currency", currency).
+				Msg("Invalid exchange rate")
+			return nil, errors.NewInvalidInputError(err.Error())
+		}
+		rates = append(rates, *rate)
+	}
+
+	if err := p.exchangeRateRepository.SaveExchangeRates(ctx, rates); err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to save exchange rates in repository")
+		return nil, fmt.Errorf("failed to save exchange rates: %w", err)
+	}
+
+	saved, err := p.exchangeRateRepository.ListExchangeRates(ctx)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to list exchange rates from repository")
+		return nil, fmt.Errorf("failed to list exchange rates: %w", err)
+	}
+
+	log.Info().
+		Strs("currencies", currencies).
+		Msg("Exchange rates loaded successfully")
+
+	return toExchangeRatesDTO(saved), nil
+}
@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type LoadExchangeRatesUseCaseTestSuite struct {
+	suite.Suite
+	exchangeRateRepositoryMock *repository.MockExchangeRateRepository
+}
+
+func (suite *LoadExchangeRatesUseCaseTestSuite) BeforeTest(suiteName, testName string) {
+	suite.exchangeRateRepositoryMock = new(repository.MockExchangeRateRepository)
+}
+
+func (suite *LoadExchangeRatesUseCaseTestSuite) TestLoadExchangeRatesUseCase_Execute_Success() {
+	suite.exchangeRateRepositoryMock.On("SaveExchangeRates", mock.Anything, mock.MatchedBy(func(rates []entity.ExchangeRate) bool {
+		return len(rates) == 2 && rates[0].Currency == "BRL" && rates[0].Rate == 5.1 && rates[1].Currency == "CLP"
+	})).Return(nil)
+	suite.exchangeRateRepositoryMock.On("ListExchangeRates", mock.Anything).Return([]entity.ExchangeRate{
+		{Currency: "BRL", Rate: 5.1},
+		{Currency: "CLP", Rate: 880},
+		{Currency: "USD", Rate: 1},
+	}, nil)
+
+	useCase := NewLoadExchangeRatesUseCase(suite.exchangeRateRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.LoadExchangeRatesInputDTO{
+		Rates: map[string]float64{"clp": 880, "BRL": 5.1},
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Rates, 3)
+	suite.exchangeRateRepositoryMock.AssertExpectations(suite.T())
+}
+
+func (suite *LoadExchangeRatesUseCaseTestSuite) TestLoadExchangeRatesUseCase_Execute_Invalid() {
+	tests := []struct {
+		name     string
+		rates    map[string]float64
+		expected string
+	}{
+		{"No rates", map[string]float64{}, "rates must contain at least one currency"},
+		{"Bad code", map[string]float64{"REAL": 4.92}, "currency must be an ISO 4217 code"},
+		{"Zero rate", map[string]float64{"BRL": 0}, "rate of BRL must be greater than 0"},
+		{"Base rate changed", map[string]float64{"BRL": 4.92, "USD": 2}, "rate of USD must be 1"},
+	}
+
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			useCase := NewLoadExchangeRatesUseCase(suite.exchangeRateRepositoryMock)
+			result, err := useCase.Execute(context.Background(), dto.LoadExchangeRatesInputDTO{Rates: tt.rates})
+
+			assert.Nil(t, result)
+			var appErr *errors.AppError
+			assert.ErrorAs(t, err, &appErr)
+			assert.ErrorIs(t, err, errors.ErrInvalidInput)
+			assert.Equal(t, tt.expected, appErr.Message)
+		})
+	}
+
+	suite.exchangeRateRepositoryMock.AssertNotCalled(suite.T(), "SaveExchangeRates", mock.Anything, mock.Anything)
+}
+
+func TestLoadExchangeRatesUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(LoadExchangeRatesUseCaseTestSuite))
+}
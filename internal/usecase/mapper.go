@@ -3,6 +3,8 @@ package usecase
 import (
 	"project/internal/dto"
 	"project/internal/entity"
+	"project/internal/errors"
+	"time"
 )
 
 func toListProductDTO(products []entity.Product) []dto.ProductDTO {
@@ -10,21 +12,36 @@ func toListProductDTO(products []entity.Product) []dto.ProductDTO {
 
 	for _, product := range products {
 		result = append(result, dto.ProductDTO{
-			ID:        product.ID,
-			Title:     product.Title,
-			Price:     product.Price,
-			Currency:  product.Currency,
-			Condition: product.Condition,
-			Stock:     product.Stock,
-			Category:  product.Category,
-			Thumbnail: product.Thumbnail,
+			ID:         product.ID,
+			Title:      product.Title,
+			Price:      product.Price.Float64(),
+			Currency:   product.Price.Currency,
+			Condition:  product.Condition,
+			Stock:      product.Stock,
+			Status:     product.Status,
+			PublishAt:  product.PublishAt,
+			ExpiresAt:  product.ExpiresAt,
+			Category:   product.Category,
+			Attributes: product.Attributes,
+			Shipping:   toShippingDTO(product.Shipping),
+			Thumbnail:  product.Thumbnail,
+			Rating:     toProductRatingDTO(product.ProductRating),
+			DeletedAt:  product.DeletedAt,
 		})
 	}
 
 	return result
 }
 
-func toGetProductDTO(product entity.Product, images []entity.ProductImage) *dto.ProductDTO {
+func toMoneyDTO(money entity.Money) *dto.MoneyDTO {
+	return &dto.MoneyDTO{
+		Amount:   money.Amount,
+		Currency: money.Currency,
+		Decimals: money.Decimals(),
+	}
+}
+
+func toProductImagesDTO(images []entity.ProductImage) []dto.ProductImageDTO {
 	imagesDto := make([]dto.ProductImageDTO, 0, len(images))
 	for _, image := range images {
 		imagesDto = append(imagesDto, dto.ProductImageDTO{
@@ -35,19 +52,283 @@ func toGetProductDTO(product entity.Product, images []entity.ProductImage) *dto.
 		})
 	}
 
+	return imagesDto
+}
+
+func toSellerDTO(product entity.Product) *dto.SellerDTO {
+	return &dto.SellerDTO{
+		ID:   product.SellerID,
+		Name: product.SellerName,
+	}
+}
+
+func toSellerReputationDTO(reputation entity.SellerReputation) dto.SellerReputationDTO {
+	return dto.SellerReputationDTO{
+		Level:            reputation.Level(),
+		PowerSeller:      reputation.PowerSellerTier(),
+		CompletedSales:   reputation.CompletedSales,
+		CancelledSales:   reputation.CancelledSales,
+		CancellationRate: reputation.CancellationRate(),
+		ReviewCount:      reputation.ReviewCount,
+		RatingAverage:    reputation.RatingAverage(),
+	}
+}
+
+func toSellerReputationSummaryDTO(reputation entity.SellerReputation) *dto.SellerReputationSummaryDTO {
+	return &dto.SellerReputationSummaryDTO{
+		Level:          reputation.Level(),
+		PowerSeller:    reputation.PowerSellerTier(),
+		CompletedSales: reputation.CompletedSales,
+	}
+}
+
+func toProductRatingDTO(rating entity.ProductRating) *dto.ProductRatingDTO {
+	return &dto.ProductRatingDTO{
+		Average: rating.RatingAverage(),
+		Count:   rating.RatingCount(),
+		Stars: dto.ProductRatingStarsDTO{
+			One:   rating.OneStar,
+			Two:   rating.TwoStars,
+			Three: rating.ThreeStars,
+			Four:  rating.FourStars,
+			Five:  rating.FiveStars,
+		},
+	}
+}
+
+func toReviewDTO(review entity.Review) dto.ReviewDTO {
+	return dto.ReviewDTO{
+		ID:        review.ID,
+		ProductID: review.ProductID,
+		Rating:    review.Rating,
+		Title:     review.Title,
+		Comment:   review.Comment,
+		CreatedAt: review.CreatedAt,
+	}
+}
+
+func toQuestionDTO(question entity.Question) dto.QuestionDTO {
+	questionDto := dto.QuestionDTO{
+		ID:         question.ID,
+		ProductID:  question.ProductID,
+		Text:       question.Text,
+		Status:     question.Status,
+		AnsweredAt: question.AnsweredAt,
+		CreatedAt:  question.CreatedAt,
+	}
+	if question.Answer != nil {
+		questionDto.Answer = *question.Answer
+	}
+	return questionDto
+}
+
+func toQuestionsDTO(questions []entity.Question) []dto.QuestionDTO {
+	questionsDto := make([]dto.QuestionDTO, 0, len(questions))
+	for _, question := range questions {
+		questionsDto = append(questionsDto, toQuestionDTO(question))
+	}
+	return questionsDto
+}
+
+func toGetProductDTO(product entity.Product, images []entity.ProductImage) *dto.ProductDTO {
 	return &dto.ProductDTO{
-		ID:          product.ID,
-		Title:       product.Title,
-		Description: product.Description,
-		Price:       product.Price,
-		Currency:    product.Currency,
-		Condition:   product.Condition,
-		Stock:       product.Stock,
-		SellerID:    product.SellerID,
-		SellerName:  product.SellerName,
-		Category:    product.Category,
-		CreatedAt:   product.CreatedAt,
-		UpdatedAt:   product.UpdatedAt,
-		Images:      imagesDto,
+		ID:               product.ID,
+		Title:            product.Title,
+		Description:      product.Description,
+		Price:            product.Price.Float64(),
+		Currency:         product.Price.Currency,
+		Condition:        product.Condition,
+		Stock:            product.Stock,
+		Status:           product.Status,
+		PublishAt:        product.PublishAt,
+		ExpiresAt:        product.ExpiresAt,
+		SellerID:         product.SellerID,
+		SellerName:       product.SellerName,
+		Category:         product.Category,
+		CategoryID:       product.CategoryID,
+		Attributes:       product.Attributes,
+		Seller:           toSellerDTO(product),
+		SellerReputation: toSellerReputationSummaryDTO(product.SellerReputation),
+		Rating:           toProductRatingDTO(product.ProductRating),
+		CreatedAt:        product.CreatedAt,
+		UpdatedAt:        product.UpdatedAt,
+		DeletedAt:        product.DeletedAt,
+		Images:           toProductImagesDTO(images),
+		Variations:       toVariationsDTO(product.Variations),
+		Shipping:         toShippingDTO(product.Shipping),
+	}
+}
+
+// toShippingDTO renders the shipping profile of a product, or nil when it
+// has none.
+func toShippingDTO(shipping entity.ShippingProfile) *dto.ShippingDTO {
+	if shipping == (entity.ShippingProfile{}) {
+		return nil
+	}
+
+	return &dto.ShippingDTO{
+		WeightGrams:  shipping.WeightGrams,
+		LengthCm:     shipping.LengthCm,
+		WidthCm:      shipping.WidthCm,
+		HeightCm:     shipping.HeightCm,
+		FreeShipping: shipping.FreeShipping,
+		OriginZip:    shipping.OriginZip,
+	}
+}
+
+func toVariationsDTO(variations []entity.Variation) []dto.VariationDTO {
+	if len(variations) == 0 {
+		return nil
+	}
+
+	variationsDto := make([]dto.VariationDTO, 0, len(variations))
+	for _, variation := range variations {
+		variationsDto = append(variationsDto, dto.VariationDTO{
+			ID:         variation.ID,
+			Attributes: variation.Attributes,
+			Price:      variation.Price.Float64(),
+			Stock:      variation.Stock,
+			Images:     variation.Images,
+		})
+	}
+
+	return variationsDto
+}
+
+func searchResultProducts(results []entity.ProductSearchResult) []entity.Product {
+	products := make([]entity.Product, 0, len(results))
+	for _, result := range results {
+		products = append(products, result.Product)
+	}
+
+	return products
+}
+
+func toSearchProductDTO(results []entity.ProductSearchResult) []dto.ProductDTO {
+	productsDto := toListProductDTO(searchResultProducts(results))
+	for i, result := range results {
+		productsDto[i].Highlight = &dto.ProductHighlightDTO{
+			Title:   result.HighlightedTitle,
+			Snippet: result.Snippet,
+		}
+	}
+
+	return productsDto
+}
+
+func toProductBatchErrorDTO(id string, err error) dto.ProductBatchErrorDTO {
+	return dto.ProductBatchErrorDTO{
+		ID:    id,
+		Code:  errors.GetErrorCode(err),
+		Error: errors.GetUserFriendlyMessage(err, errors.GetStatusCode(err)),
+	}
+}
+
+func toCategoryRefsDTO(categories []entity.Category) []dto.CategoryRefDTO {
+	refs := make([]dto.CategoryRefDTO, 0, len(categories))
+	for _, category := range categories {
+		refs = append(refs, dto.CategoryRefDTO{
+			ID:   category.ID,
+			Name: category.Name,
+		})
+	}
+
+	return refs
+}
+
+func toCategoryAttributesDTO(attributes []entity.CategoryAttribute) []dto.CategoryAttributeDTO {
+	attributesDto := make([]dto.CategoryAttributeDTO, 0, len(attributes))
+	for _, attribute := range attributes {
+		attributesDto = append(attributesDto, dto.CategoryAttributeDTO{
+			Name:     attribute.Name,
+			Type:     attribute.Type,
+			Required: attribute.Required,
+		})
+	}
+
+	return attributesDto
+}
+
+func toExchangeRatesDTO(rates []entity.ExchangeRate) *dto.ExchangeRatesDTO {
+	ratesDto := make([]dto.ExchangeRateDTO, 0, len(rates))
+	for _, rate := range rates {
+		ratesDto = append(ratesDto, dto.ExchangeRateDTO{
+			Currency:  rate.Currency,
+			Rate:      rate.Rate,
+			UpdatedAt: rate.UpdatedAt,
+		})
+	}
+
+	return &dto.ExchangeRatesDTO{
+		Base:  entity.BaseCurrency,
+		Rates: ratesDto,
+	}
+}
+
+func toInstallmentDTO(installment entity.Installment) *dto.InstallmentDTO {
+	return &dto.InstallmentDTO{
+		Quantity:            installment.Quantity,
+		Amount:              installment.Amount.Float64(),
+		FirstAmount:         installment.FirstAmount.Float64(),
+		Total:               installment.Total.Float64(),
+		Currency:            installment.Total.Currency,
+		InterestFree:        installment.InterestFree(),
+		MonthlyInterestRate: float64(installment.InterestRate) / 100,
+	}
+}
+
+func toInstallmentsDTO(installments []entity.Installment) []dto.InstallmentDTO {
+	installmentsDto := make([]dto.InstallmentDTO, 0, len(installments))
+	for _, installment := range installments {
+		installmentsDto = append(installmentsDto, *toInstallmentDTO(installment))
+	}
+	return installmentsDto
+}
+
+// toPromotionDTO renders promotion as it stands at now. Value is the
+// percentage or the amount the promotion takes off.
+func toPromotionDTO(promotion entity.Promotion, now time.Time) dto.PromotionDTO {
+	promotionDto := dto.PromotionDTO{
+		ID:         promotion.ID,
+		ProductID:  promotion.ProductID,
+		CategoryID: promotion.CategoryID,
+		Type:       promotion.Type,
+		Priority:   promotion.Priority,
+		StartsAt:   promotion.StartsAt,
+		EndsAt:     promotion.EndsAt,
+		Active:     promotion.IsActive(now),
+		CreatedAt:  promotion.CreatedAt,
+	}
+	if promotion.Type == entity.PromotionFixed {
+		promotionDto.Value = promotion.AmountOff.Float64()
+		promotionDto.Currency = promotion.AmountOff.Currency
+	} else {
+		promotionDto.Value = float64(promotion.PercentOff) / 100
+	}
+	return promotionDto
+}
+
+func toPromotionsDTO(promotions []entity.Promotion, now time.Time) []dto.PromotionDTO {
+	promotionsDto := make([]dto.PromotionDTO, 0, len(promotions))
+	for _, promotion := range promotions {
+		promotionsDto = append(promotionsDto, toPromotionDTO(promotion, now))
+	}
+	return promotionsDto
+}
+
+func toStatusChangesDTO(changes []entity.StatusChange) []dto.StatusChangeDTO {
+	changesDto := make([]dto.StatusChangeDTO, 0, len(changes))
+	for _, change := range changes {
+		changesDto = append(changesDto, dto.StatusChangeDTO{
+			ID:        change.ID,
+			ProductID: change.ProductID,
+			From:      change.From,
+			To:        change.To,
+			Actor:     change.Actor,
+			ActorID:   change.ActorID,
+			Reason:    change.Reason,
+			CreatedAt: change.CreatedAt,
+		})
 	}
+	return changesDto
 }
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"project/internal/errors"
+	"strconv"
+)
+
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+// pageCursor is the position a page ends at: the last ID for lists sorted by
+// ID, or an offset for lists ranked by relevance. Clients get it as an opaque
+// string and must not rely on its contents.
+type pageCursor struct {
+	AfterID string `json:"after_id,omitempty"`
+	Offset  int    `json:"offset,omitempty"`
+}
+
+func encodeCursor(cursor pageCursor) string {
+	raw, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeCursor(value string) (pageCursor, error) {
+	var cursor pageCursor
+	if value == "" {
+		return cursor, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return cursor, errors.NewInvalidInputError("cursor is invalid")
+	}
+
+	if err := json.Unmarshal(raw, &cursor); err != nil || cursor.Offset < 0 || (cursor.AfterID == "" && cursor.Offset == 0) {
+		return pageCursor{}, errors.NewInvalidInputError("cursor is invalid")
+	}
+
+	return cursor, nil
+}
+
+// encodeIDCursor ends a page of a list sorted by integer ID, newest first, at
+// the row with id.
+func encodeIDCursor(id int64) string {
+	return encodeCursor(pageCursor{AfterID: strconv.FormatInt(id, 10)})
+}
+
+// decodeIDCursor reads the ID of the last row of the previous page, zero for
+// the first page.
+func decodeIDCursor(value string) (int64, error) {
+	cursor, err := decodeCursor(value)
+	if err != nil || value == "" {
+		return 0, err
+	}
+
+	beforeID, err := strconv.ParseInt(cursor.AfterID, 10, 64)
+	if err != nil || beforeID <= 0 {
+		return 0, errors.NewInvalidInputError("cursor is invalid")
+	}
+
+	return beforeID, nil
+}
+
+// pageLimit applies the default page size and rejects sizes out of range.
+func pageLimit(limit int) (int, error) {
+	if limit == 0 {
+		return DefaultPageLimit, nil
+	}
+
+	if limit < 0 || limit > MaxPageLimit {
+		return 0, errors.NewInvalidInputError(fmt.Sprintf("limit must be between 1 and %d", MaxPageLimit))
+	}
+
+	return limit, nil
+}
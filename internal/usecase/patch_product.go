@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+type PatchProductUseCase struct {
+	productRepository  repository.ProductRepositoryInterface
+	categoryRepository repository.CategoryRepositoryInterface
+}
+
+func NewPatchProductUseCase(productRepo repository.ProductRepositoryInterface, categoryRepo repository.CategoryRepositoryInterface) *PatchProductUseCase {
+	return &PatchProductUseCase{
+		productRepository:  productRepo,
+		categoryRepository: categoryRepo,
+	}
+}
+
+func (p *PatchProductUseCase) Execute(ctx context.Context, input dto.PatchProductInputDTO) (*dto.ProductDTO, error) {
+	log.Debug().
+		Str("product_id", input.ID).
+		Msg("Executing PatchProduct use case")
+
+	if strings.TrimSpace(input.ID) == "" {
+		log.Warn().Msg("Invalid product ID: empty or whitespace")
+		return nil, errors.ErrInvalidProductID
+	}
+
+	var patch map[string]any
+	if err := json.Unmarshal(input.Patch, &patch); err != nil || patch == nil {
+		log.Warn().
+			Str("product_id", input.ID).
+			Msg("Invalid merge patch document")
+		return nil, errors.NewInvalidInputError("The request body must be a JSON merge patch object")
+	}
+
+	product, err := p.productRepository.GetProduct(ctx, input.ID, false)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ID).
+			Msg("Failed to get product from repository")
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	images, err := p.productRepository.FindImagesByProductID(ctx, input.ID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ID).
+			Msg("Failed to get product images")
+		return nil, fmt.Errorf("failed to get product images: %w", err)
+	}
+
+	variations, err := p.productRepository.FindVariationsByProductID(ctx, input.ID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ID).
+			Msg("Failed to get product variations")
+		return nil, fmt.Errorf("failed to get product variations: %w", err)
+	}
+	product.Variations = variations
+
+	fields, err := mergeProductFields(toProductFields(*product, images), patch)
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("product_id", input.ID).
+			Msg("Merge patch does not produce a valid product")
+		return nil, errors.NewInvalidInputError(err.Error())
+	}
+
+	// The name of the current seller must not register the new one when the
+	// patch moves the product to another seller.
+	if _, ok := patch["seller_name"]; !ok && fields.SellerID != product.SellerID {
+		fields.SellerName = ""
+	}
+
+	validation := &errors.ValidationError{}
+	validation.Merge("", applyProductFields(product, fields))
+
+	// Images are only rewritten when the patch touches them, so untouched
+	// images keep their IDs.
+	var newImages []entity.ProductImage
+	if _, ok := patch["images"]; ok {
+		newImages, err = newProductImages(product.ID, fields.Images)
+		validation.Merge("", err)
+		images = newImages
+	}
+
+	// Variations are rebuilt from fields even when the patch does not touch
+	// them, so that a new currency applies to their prices, but then keep
+	// their IDs.
+	newVariations := newProductVariations(product.ID, fields, validation)
+	if _, ok := patch["variations"]; !ok {
+		for i := range newVariations {
+			newVariations[i].ID = variations[i].ID
+		}
+	}
+	product.SetVariations(newVariations)
+
+	if err := validateCategoryAttributes(ctx, p.categoryRepository, fields, product.Variations, validation); err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ID).
+			Msg("Failed to get category attributes")
+		return nil, err
+	}
+
+	if err := validation.Err(); err != nil {
+		log.Warn().
+			Err(err).
+			Str("product_id", input.ID).
+			Msg("Invalid product data")
+		return nil, err
+	}
+
+	product.ApplySchedule(product.UpdatedAt)
+	product.PauseIfOutOfStock(product.UpdatedAt)
+
+	if err := p.productRepository.UpdateProduct(ctx, product, newImages, input.Version); err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ID).
+			Msg("Failed to update product in repository")
+		return nil, fmt.Errorf("failed to update product: %w", sellerInputError(err))
+	}
+
+	log.Info().
+		Str("product_id", product.ID).
+		Int("patched_fields", len(patch)).
+		Msg("Product patched successfully")
+
+	return toGetProductDTO(*product, images), nil
+}
+
+// mergeProductFields applies patch to the JSON form of fields following
+// RFC 7396 and decodes the result back, rejecting unknown members.
+func mergeProductFields(fields dto.ProductFieldsDTO, patch map[string]any) (dto.ProductFieldsDTO, error) {
+	raw, err := json.Marshal(fields)
+	if err != nil {
+		return dto.ProductFieldsDTO{}, err
+	}
+
+	var document map[string]any
+	if err := json.Unmarshal(raw, &document); err != nil {
+		return dto.ProductFieldsDTO{}, err
+	}
+
+	merged, err := json.Marshal(mergePatch(document, patch))
+	if err != nil {
+		return dto.ProductFieldsDTO{}, err
+	}
+
+	var result dto.ProductFieldsDTO
+	decoder := json.NewDecoder(bytes.NewReader(merged))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&result); err != nil {
+		return dto.ProductFieldsDTO{}, fmt.Errorf("invalid patch: %w", err)
+	}
+
+	return result, nil
+}
+
+// mergePatch implements the JSON merge patch algorithm from RFC 7396.
+func mergePatch(target any, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		targetObject = map[string]any{}
+	}
+
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+			continue
+		}
+		targetObject[key] = mergePatch(targetObject[key], value)
+	}
+
+	return targetObject
+}
@@ -0,0 +1,190 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type PatchProductUseCaseTestSuite struct {
+	suite.Suite
+	repositoryMock         *repository.MockProductRepository
+	categoryRepositoryMock *repository.MockCategoryRepository
+	version                time.Time
+}
+
+func (suite *PatchProductUseCaseTestSuite) BeforeTest(suiteName, testName string) {
+	suite.repositoryMock = new(repository.MockProductRepository)
+	suite.categoryRepositoryMock = new(repository.MockCategoryRepository)
+	suite.categoryRepositoryMock.On("FindCategoryAttributes", mock.Anything, mock.Anything).Return(smartphoneAttributes(), nil)
+	suite.version = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	images := []entity.ProductImage{
+		{ID: 7, ProductID: "MLB001", ImageURL: "http://example.com/image1.jpg", DisplayOrder: 0},
+	}
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(storedProduct(suite.version), nil)
+	suite.repositoryMock.On("FindImagesByProductID", mock.Anything, "MLB001").Return(images, nil)
+	suite.repositoryMock.On("FindVariationsByProductID", mock.Anything, "MLB001").Return([]entity.Variation{}, nil)
+}
+
+func (suite *PatchProductUseCaseTestSuite) execute(patch string) (*dto.ProductDTO, error) {
+	useCase := NewPatchProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+	return useCase.Execute(context.Background(), dto.PatchProductInputDTO{
+		ID:      "MLB001",
+		Version: suite.version,
+		Patch:   []byte(patch),
+	})
+}
+
+func (suite *PatchProductUseCaseTestSuite) TestPatchProductUseCase_Execute_UpdatesOnlyPatchedFields() {
+	suite.repositoryMock.On("UpdateProduct", mock.Anything, mock.Anything, mock.Anything, suite.version).Return(nil)
+
+	result, err := suite.execute(`{"price": 899.9, "stock": 3, "description": null}`)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 899.9, result.Price)
+	assert.Equal(suite.T(), 3, result.Stock)
+	assert.Equal(suite.T(), "", result.Description)
+	assert.Equal(suite.T(), "iPhone 15", result.Title)
+	assert.Equal(suite.T(), "TechWorld Store", result.SellerName)
+	assert.Len(suite.T(), result.Images, 1)
+	assert.Equal(suite.T(), 7, result.Images[0].ID)
+
+	images := suite.repositoryMock.Calls[3].Arguments.Get(2).([]entity.ProductImage)
+	assert.Nil(suite.T(), images, "images must be left untouched when the patch does not mention them")
+}
+
+func (suite *PatchProductUseCaseTestSuite) TestPatchProductUseCase_Execute_ReplacesImages() {
+	suite.repositoryMock.On("UpdateProduct", mock.Anything, mock.Anything, mock.Anything, suite.version).Return(nil)
+
+	result, err := suite.execute(`{"images": ["http://example.com/a.jpg", "http://example.com/b.jpg"]}`)
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Images, 2)
+	assert.Equal(suite.T(), 1, result.Images[1].DisplayOrder)
+
+	images := suite.repositoryMock.Calls[3].Arguments.Get(2).([]entity.ProductImage)
+	assert.Len(suite.T(), images, 2)
+}
+
+func (suite *PatchProductUseCaseTestSuite) TestPatchProductUseCase_Execute_MovingToAnotherSellerDropsCurrentName() {
+	suite.repositoryMock.On("UpdateProduct", mock.Anything, mock.Anything, mock.Anything, suite.version).Return(nil)
+
+	_, err := suite.execute(`{"seller_id": "SELLER002"}`)
+
+	assert.NoError(suite.T(), err)
+	product := suite.repositoryMock.Calls[3].Arguments.Get(1).(*entity.Product)
+	assert.Equal(suite.T(), "SELLER002", product.SellerID)
+	assert.Equal(suite.T(), "", product.SellerName, "the current seller name must not register the new seller")
+}
+
+func (suite *PatchProductUseCaseTestSuite) TestPatchProductUseCase_Execute_MergesAttributes() {
+	suite.repositoryMock.On("UpdateProduct", mock.Anything, mock.Anything, mock.Anything, suite.version).Return(nil)
+
+	result, err := suite.execute(`{"attributes": {"ram_gb": "8", "brand": null}}`)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[string]string{"ram_gb": "8", "storage": "256GB"}, result.Attributes)
+}
+
+func (suite *PatchProductUseCaseTestSuite) TestPatchProductUseCase_Execute_RemovingRequiredAttributeFails() {
+	result, err := suite.execute(`{"attributes": {"storage": null}}`)
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), []errors.FieldError{
+		{Field: "attributes.storage", Rule: errors.RuleRequired, Message: "attributes.storage is required"},
+	}, errors.GetErrorDetails(err))
+	suite.repositoryMock.AssertNotCalled(suite.T(), "UpdateProduct", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *PatchProductUseCaseTestSuite) TestPatchProductUseCase_Execute_RemovingRequiredFieldFails() {
+	result, err := suite.execute(`{"title": null}`)
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrValidation)
+	assert.Equal(suite.T(), []errors.FieldError{{Field: "title", Rule: errors.RuleRequired, Message: "title is required"}}, errors.GetErrorDetails(err))
+	suite.repositoryMock.AssertNotCalled(suite.T(), "UpdateProduct", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *PatchProductUseCaseTestSuite) TestPatchProductUseCase_Execute_RejectsUnknownFields() {
+	result, err := suite.execute(`{"id": "MLB999"}`)
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrInvalidInput)
+	suite.repositoryMock.AssertNotCalled(suite.T(), "UpdateProduct", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *PatchProductUseCaseTestSuite) TestPatchProductUseCase_Execute_RejectsNonObjectPatch() {
+	for _, patch := range []string{`[]`, `"title"`, `null`, `{`} {
+		result, err := suite.execute(patch)
+
+		assert.Nil(suite.T(), result, patch)
+		assert.ErrorIs(suite.T(), err, errors.ErrInvalidInput, patch)
+	}
+	suite.repositoryMock.AssertNotCalled(suite.T(), "GetProduct", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *PatchProductUseCaseTestSuite) TestPatchProductUseCase_Execute_StaleVersion() {
+	suite.repositoryMock.On("UpdateProduct", mock.Anything, mock.Anything, mock.Anything, suite.version).Return(errors.ErrVersionConflict)
+
+	result, err := suite.execute(`{"stock": 1}`)
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrVersionConflict)
+}
+
+func (suite *PatchProductUseCaseTestSuite) TestPatchProductUseCase_Execute_KeepsVariations() {
+	product := storedProduct(suite.version)
+	product.Attributes = entity.ProductAttributes{"brand": "Apple"}
+
+	suite.repositoryMock = new(repository.MockProductRepository)
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(product, nil)
+	suite.repositoryMock.On("FindImagesByProductID", mock.Anything, "MLB001").Return([]entity.ProductImage{}, nil)
+	suite.repositoryMock.On("FindVariationsByProductID", mock.Anything, "MLB001").Return([]entity.Variation{
+		{ID: 4, ProductID: "MLB001", Attributes: entity.ProductAttributes{"storage": "256GB"}, Price: entity.Money{Amount: 99999, Currency: "USD"}, Stock: 6},
+		{ID: 5, ProductID: "MLB001", Attributes: entity.ProductAttributes{"storage": "512GB"}, Price: entity.Money{Amount: 119999, Currency: "USD"}, Stock: 4},
+	}, nil)
+	suite.repositoryMock.On("UpdateProduct", mock.Anything, mock.Anything, mock.Anything, suite.version).Return(nil)
+
+	result, err := suite.execute(`{"title": "iPhone 15 - Renewed", "price": 1, "stock": 1}`)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "iPhone 15 - Renewed", result.Title)
+	assert.Equal(suite.T(), 999.99, result.Price, "the price of a product with variations is the cheapest one")
+	assert.Equal(suite.T(), 10, result.Stock, "the stock of a product with variations is the sum of theirs")
+
+	updated := suite.repositoryMock.Calls[3].Arguments.Get(1).(*entity.Product)
+	assert.Equal(suite.T(), int64(4), updated.Variations[0].ID)
+	assert.Equal(suite.T(), int64(5), updated.Variations[1].ID)
+}
+
+func TestMergePatch(t *testing.T) {
+	target := map[string]any{
+		"a": "b",
+		"c": map[string]any{"d": "e", "f": "g"},
+	}
+	patch := map[string]any{
+		"a": "z",
+		"c": map[string]any{"f": nil},
+	}
+
+	result := mergePatch(target, patch)
+
+	assert.Equal(t, map[string]any{
+		"a": "z",
+		"c": map[string]any{"d": "e"},
+	}, result)
+}
+
+func TestPatchProductUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(PatchProductUseCaseTestSuite))
+}
@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/repository"
+	"time"
+)
+
+// promotionApplier discounts product prices by the promotion active for each
+// of them at a given time.
+type promotionApplier struct {
+	promotions []entity.Promotion
+	// paths holds the category of each product and its ancestors, root
+	// first, as category promotions also apply to subcategories.
+	paths map[int64][]entity.Category
+}
+
+// newPromotionApplier loads the promotions active at now. The categories of
+// products are only looked up when a category promotion is active.
+func newPromotionApplier(ctx context.Context, promotionRepository repository.PromotionRepositoryInterface, categoryRepository repository.CategoryRepositoryInterface, products []entity.Product, now time.Time) (*promotionApplier, error) {
+	promotions, err := promotionRepository.ListPromotions(ctx, repository.PromotionQuery{ActiveAt: &now})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active promotions: %w", err)
+	}
+
+	applier := &promotionApplier{promotions: promotions, paths: map[int64][]entity.Category{}}
+
+	byCategory := false
+	for _, promotion := range promotions {
+		byCategory = byCategory || promotion.CategoryID != nil
+	}
+	if !byCategory {
+		return applier, nil
+	}
+
+	seen := map[int64]bool{}
+	var categoryIDs []int64
+	for _, product := range products {
+		if product.CategoryID != nil && !seen[*product.CategoryID] {
+			seen[*product.CategoryID] = true
+			categoryIDs = append(categoryIDs, *product.CategoryID)
+		}
+	}
+	if len(categoryIDs) == 0 {
+		return applier, nil
+	}
+
+	if applier.paths, err = categoryRepository.FindCategoryPaths(ctx, categoryIDs); err != nil {
+		return nil, fmt.Errorf("failed to get category paths: %w", err)
+	}
+
+	return applier, nil
+}
+
+// specificity ranks how closely promotion targets product: a product
+// promotion outranks the promotion of its category, which outranks the ones
+// of its ancestors. It reports false when promotion does not target product.
+func (a *promotionApplier) specificity(promotion entity.Promotion, product entity.Product) (int, bool) {
+	if promotion.ProductID != nil {
+		return math.MaxInt, *promotion.ProductID == product.ID
+	}
+	if product.CategoryID == nil {
+		return 0, false
+	}
+
+	for depth, category := range a.paths[*product.CategoryID] {
+		if category.ID == *promotion.CategoryID {
+			return depth, true
+		}
+	}
+	return 0, false
+}
+
+// best is the promotion that discounts product: the one with the highest
+// priority, then the most specific one, then the oldest one. Promotions that
+// leave the price unchanged, as a fixed amount in another currency, are
+// skipped.
+func (a *promotionApplier) best(product entity.Product) (entity.Promotion, bool) {
+	var best entity.Promotion
+	bestSpecificity, found := 0, false
+
+	for _, promotion := range a.promotions {
+		specificity, ok := a.specificity(promotion, product)
+		if !ok {
+			continue
+		}
+		if _, discounts := promotion.Apply(product.Price); !discounts {
+			continue
+		}
+
+		if found {
+			if promotion.Priority != best.Priority {
+				if promotion.Priority < best.Priority {
+					continue
+				}
+			} else if specificity <= bestSpecificity {
+				// Promotions are ordered by ID, so the oldest one wins a tie.
+				continue
+			}
+		}
+		best, bestSpecificity, found = promotion, specificity, true
+	}
+
+	return best, found
+}
+
+// price is the price of product with the discount of its best promotion.
+func (a *promotionApplier) price(product entity.Product) entity.Money {
+	promotion, ok := a.best(product)
+	if !ok {
+		return product.Price
+	}
+	discounted, _ := promotion.Apply(product.Price)
+	return discounted
+}
+
+// apply discounts productsDto, the mapping of products in the same order.
+func (a *promotionApplier) apply(products []entity.Product, productsDto []dto.ProductDTO) {
+	for i := range products {
+		a.applyProduct(products[i], &productsDto[i])
+	}
+}
+
+// applyProduct discounts the price of productDto, and of its variations, by
+// the best promotion of product, keeping the stored price as the original
+// one. It must run before the price is converted.
+func (a *promotionApplier) applyProduct(product entity.Product, productDto *dto.ProductDTO) {
+	promotion, ok := a.best(product)
+	if !ok {
+		return
+	}
+
+	discounted, _ := promotion.Apply(product.Price)
+
+	originalPrice := productDto.Price
+	productDto.OriginalPrice = &originalPrice
+	productDto.OriginalCurrency = product.Price.Currency
+	productDto.Price = discounted.Float64()
+	productDto.DiscountPercent = entity.DiscountPercent(product.Price, discounted)
+	productDto.PromotionID = &promotion.ID
+
+	for i := range productDto.Variations {
+		variation := &productDto.Variations[i]
+		price, err := entity.NewMoney(variation.Price, product.Price.Currency)
+		if err != nil {
+			continue
+		}
+		if discountedVariation, ok := promotion.Apply(price); ok {
+			variation.Price = discountedVariation.Float64()
+		}
+	}
+}
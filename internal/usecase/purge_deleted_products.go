@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/repository"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// PurgeDeletedProductsUseCase permanently removes products that have been
+// soft deleted for longer than the retention window.
+type PurgeDeletedProductsUseCase struct {
+	productRepository repository.ProductRepositoryInterface
+	retention         time.Duration
+}
+
+func NewPurgeDeletedProductsUseCase(productRepo repository.ProductRepositoryInterface, retention time.Duration) *PurgeDeletedProductsUseCase {
+	return &PurgeDeletedProductsUseCase{
+		productRepository: productRepo,
+		retention:         retention,
+	}
+}
+
+func (p *PurgeDeletedProductsUseCase) Execute(ctx context.Context) (int64, error) {
+	deletedBefore := time.Now().UTC().Add(-p.retention)
+
+	log.Debug().
+		Time("deleted_before", deletedBefore).
+		Msg("Executing PurgeDeletedProducts use case")
+
+	purged, err := p.productRepository.PurgeDeletedProducts(ctx, deletedBefore)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to purge deleted products")
+		return 0, fmt.Errorf("failed to purge deleted products: %w", err)
+	}
+
+	log.Info().
+		Int64("purged_count", purged).
+		Dur("retention", p.retention).
+		Msg("Deleted products purged")
+
+	return purged, nil
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPurgeDeletedProductsUseCase_Execute_UsesRetention(t *testing.T) {
+	repositoryMock := new(repository.MockProductRepository)
+	retention := 48 * time.Hour
+	expectedCutoff := time.Now().UTC().Add(-retention)
+
+	repositoryMock.On("PurgeDeletedProducts", mock.Anything, mock.MatchedBy(func(deletedBefore time.Time) bool {
+		return deletedBefore.Sub(expectedCutoff).Abs() < time.Minute
+	})).Return(int64(3), nil)
+
+	useCase := NewPurgeDeletedProductsUseCase(repositoryMock, retention)
+	purged, err := useCase.Execute(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), purged)
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestPurgeDeletedProductsUseCase_Execute_RepositoryError(t *testing.T) {
+	repositoryMock := new(repository.MockProductRepository)
+	repositoryMock.On("PurgeDeletedProducts", mock.Anything, mock.Anything).
+		Return(int64(0), fmt.Errorf("%w: disk I/O error", errors.ErrDatabaseError))
+
+	useCase := NewPurgeDeletedProductsUseCase(repositoryMock, time.Hour)
+	purged, err := useCase.Execute(context.Background())
+
+	assert.Zero(t, purged)
+	assert.ErrorIs(t, err, errors.ErrDatabaseError)
+}
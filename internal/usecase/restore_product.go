@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/errors"
+	"project/internal/repository"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type RestoreProductUseCase struct {
+	productRepository repository.ProductRepositoryInterface
+}
+
+func NewRestoreProductUseCase(productRepo repository.ProductRepositoryInterface) *RestoreProductUseCase {
+	return &RestoreProductUseCase{
+		productRepository: productRepo,
+	}
+}
+
+// Execute brings a soft deleted product back. Restoring a product that is not
+// deleted returns ErrProductNotFound.
+func (p *RestoreProductUseCase) Execute(ctx context.Context, input dto.ProductInputDTO) (*dto.ProductDTO, error) {
+	log.Debug().
+		Str("product_id", input.ID).
+		Msg("Executing RestoreProduct use case")
+
+	if strings.TrimSpace(input.ID) == "" {
+		log.Warn().Msg("Invalid product ID: empty or whitespace")
+		return nil, errors.ErrInvalidProductID
+	}
+
+	if err := p.productRepository.RestoreProduct(ctx, input.ID, time.Now().UTC()); err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ID).
+			Msg("Failed to restore product in repository")
+		return nil, fmt.Errorf("failed to restore product: %w", err)
+	}
+
+	product, err := p.productRepository.GetProduct(ctx, input.ID, false)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ID).
+			Msg("Failed to get restored product from repository")
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	images, err := p.productRepository.FindImagesByProductID(ctx, input.ID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ID).
+			Msg("Failed to get product images")
+		return nil, fmt.Errorf("failed to get product images: %w", err)
+	}
+
+	log.Info().
+		Str("product_id", input.ID).
+		Msg("Product restored successfully")
+
+	return toGetProductDTO(*product, images), nil
+}
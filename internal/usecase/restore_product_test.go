@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RestoreProductUseCaseTestSuite struct {
+	suite.Suite
+	repositoryMock *repository.MockProductRepository
+}
+
+func (suite *RestoreProductUseCaseTestSuite) BeforeTest(suiteName, testName string) {
+	suite.repositoryMock = new(repository.MockProductRepository)
+}
+
+func (suite *RestoreProductUseCaseTestSuite) TestRestoreProductUseCase_Execute_Success() {
+	version := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	images := []entity.ProductImage{
+		{ID: 1, ProductID: "MLB001", ImageURL: "http://example.com/image1.jpg", DisplayOrder: 0},
+	}
+
+	suite.repositoryMock.On("RestoreProduct", mock.Anything, "MLB001", mock.Anything).Return(nil)
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(storedProduct(version), nil)
+	suite.repositoryMock.On("FindImagesByProductID", mock.Anything, "MLB001").Return(images, nil)
+
+	useCase := NewRestoreProductUseCase(suite.repositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.ProductInputDTO{ID: "MLB001"})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "MLB001", result.ID)
+	assert.Nil(suite.T(), result.DeletedAt)
+	assert.Len(suite.T(), result.Images, 1)
+}
+
+func (suite *RestoreProductUseCaseTestSuite) TestRestoreProductUseCase_Execute_NotDeleted() {
+	suite.repositoryMock.On("RestoreProduct", mock.Anything, "MLB001", mock.Anything).Return(errors.ErrProductNotFound)
+
+	useCase := NewRestoreProductUseCase(suite.repositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.ProductInputDTO{ID: "MLB001"})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrProductNotFound)
+	suite.repositoryMock.AssertNotCalled(suite.T(), "GetProduct", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *RestoreProductUseCaseTestSuite) TestRestoreProductUseCase_Execute_EmptyID() {
+	useCase := NewRestoreProductUseCase(suite.repositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.ProductInputDTO{ID: " "})
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), errors.ErrInvalidProductID, err)
+}
+
+func TestRestoreProductUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(RestoreProductUseCaseTestSuite))
+}
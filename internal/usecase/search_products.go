@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/errors"
+	"project/internal/repository"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/rs/zerolog/log"
+)
+
+const maxSearchQueryLength = 200
+
+type SearchProductsUseCase struct {
+	productRepository         repository.ProductRepositoryInterface
+	categoryRepository        repository.CategoryRepositoryInterface
+	exchangeRateRepository    repository.ExchangeRateRepositoryInterface
+	installmentPlanRepository repository.InstallmentPlanRepositoryInterface
+	promotionRepository       repository.PromotionRepositoryInterface
+	now                       func() time.Time
+}
+
+func NewSearchProductsUseCase(productRepo repository.ProductRepositoryInterface, categoryRepo repository.CategoryRepositoryInterface, exchangeRateRepo repository.ExchangeRateRepositoryInterface, installmentPlanRepo repository.InstallmentPlanRepositoryInterface, promotionRepo repository.PromotionRepositoryInterface) *SearchProductsUseCase {
+	return &SearchProductsUseCase{
+		productRepository:         productRepo,
+		categoryRepository:        categoryRepo,
+		exchangeRateRepository:    exchangeRateRepo,
+		installmentPlanRepository: installmentPlanRepo,
+		promotionRepository:       promotionRepo,
+		now:                       time.Now,
+	}
+}
+
+// Execute returns one page of products matching the search text, best
+// matches first.
+func (p *SearchProductsUseCase) Execute(ctx context.Context, input dto.ProductSearchInputDTO) (*dto.ProductListDTO, error) {
+	log.Debug().
+		Str("query", input.Query).
+		Int("limit", input.Limit).
+		Str("cursor", input.Cursor).
+		Interface("filters", input.ProductFiltersDTO).
+		Interface("expand", input.Expand).
+		Str("currency", input.Currency).
+		Str("price_format", input.PriceFormat).
+		Msg("Executing SearchProducts use case")
+
+	text := strings.TrimSpace(input.Query)
+	if text == "" {
+		log.Warn().Msg("Empty search query")
+		return nil, errors.NewInvalidInputError("q is required")
+	}
+	if utf8.RuneCountInString(text) > maxSearchQueryLength {
+		log.Warn().Int("length", utf8.RuneCountInString(text)).Msg("Search query too long")
+		return nil, errors.NewInvalidInputError(fmt.Sprintf("q must be at most %d characters", maxSearchQueryLength))
+	}
+
+	limit, err := pageLimit(input.Limit)
+	if err != nil {
+		log.Warn().Err(err).Int("limit", input.Limit).Msg("Invalid page limit")
+		return nil, err
+	}
+
+	cursor, err := decodeCursor(input.Cursor)
+	if err != nil {
+		log.Warn().Err(err).Str("cursor", input.Cursor).Msg("Invalid page cursor")
+		return nil, err
+	}
+
+	now := p.now()
+	filter, err := newProductFilter(ctx, p.categoryRepository, input.ProductFiltersDTO, now)
+	if err != nil {
+		log.Warn().Err(err).Msg("Invalid product filters")
+		return nil, err
+	}
+
+	converter, err := newPriceConverter(ctx, p.exchangeRateRepository, input.Currency, input.PriceFormat)
+	if err != nil {
+		log.Warn().Err(err).Str("currency", input.Currency).Msg("Failed to prepare price conversion")
+		return nil, err
+	}
+	filter.PriceCurrency = converter.priceCurrency()
+
+	results, err := p.productRepository.SearchProducts(ctx, repository.ProductSearchQuery{
+		ProductFilter: filter,
+		Text:          text,
+		Offset:        cursor.Offset,
+		Limit:         limit + 1,
+	})
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("query", text).
+			Msg("Failed to search products in repository")
+		return nil, fmt.Errorf("failed to search products: %w", err)
+	}
+
+	facets, err := countProductFacets(ctx, p.productRepository, filter, text)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to count product facets")
+		return nil, err
+	}
+
+	pagination := dto.PaginationDTO{Limit: limit}
+	if len(results) > limit {
+		results = results[:limit]
+		pagination.HasMore = true
+		pagination.NextCursor = encodeCursor(pageCursor{Offset: cursor.Offset + limit})
+	}
+
+	for i := range results {
+		results[i].Status = results[i].ListingStatus(now)
+	}
+	productsDto := toSearchProductDTO(results)
+	foundProducts := searchResultProducts(results)
+	if err := expandProducts(ctx, p.productRepository, foundProducts, productsDto, input.Expand); err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to expand found products")
+		return nil, err
+	}
+
+	promotions, err := newPromotionApplier(ctx, p.promotionRepository, p.categoryRepository, foundProducts, now)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to prepare promotions")
+		return nil, err
+	}
+	promotions.apply(foundProducts, productsDto)
+
+	converter.convert(productsDto)
+
+	installments, err := newInstallmentCalculator(ctx, p.installmentPlanRepository)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to prepare installments")
+		return nil, err
+	}
+	installments.apply(productsDto)
+
+	log.Info().
+		Str("query", text).
+		Int("products_count", len(results)).
+		Bool("has_more", pagination.HasMore).
+		Msg("Products searched successfully")
+
+	return &dto.ProductListDTO{
+		Products:   productsDto,
+		Pagination: pagination,
+		Facets:     facets,
+	}, nil
+}
@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type SearchProductsUseCaseTestSuite struct {
+	suite.Suite
+	repositoryMock                *repository.MockProductRepository
+	categoryRepositoryMock        *repository.MockCategoryRepository
+	exchangeRateRepositoryMock    *repository.MockExchangeRateRepository
+	installmentPlanRepositoryMock *repository.MockInstallmentPlanRepository
+	promotionRepositoryMock       *repository.MockPromotionRepository
+}
+
+func (suite *SearchProductsUseCaseTestSuite) BeforeTest(suiteName, testName string) {
+	suite.repositoryMock = new(repository.MockProductRepository)
+	suite.categoryRepositoryMock = new(repository.MockCategoryRepository)
+	suite.exchangeRateRepositoryMock = new(repository.MockExchangeRateRepository)
+	suite.installmentPlanRepositoryMock = new(repository.MockInstallmentPlanRepository)
+	suite.installmentPlanRepositoryMock.On("ListInstallmentPlans", mock.Anything).Return([]entity.InstallmentPlan{}, nil)
+	suite.promotionRepositoryMock = new(repository.MockPromotionRepository)
+	suite.promotionRepositoryMock.On("ListPromotions", mock.Anything, mock.Anything).Return([]entity.Promotion{}, nil)
+}
+
+func (suite *SearchProductsUseCaseTestSuite) TestSearchProductsUseCase_Execute_Success() {
+	results := []entity.ProductSearchResult{
+		{Product: entity.Product{ID: "MLB001", Title: "iPhone 15"}, HighlightedTitle: "<em>iPhone</em> 15", Snippet: "Latest <em>iPhone</em>"},
+		{Product: entity.Product{ID: "MLB009", Title: "iPhone 14"}, HighlightedTitle: "<em>iPhone</em> 14", Snippet: "Previous <em>iPhone</em>"},
+		{Product: entity.Product{ID: "MLB010", Title: "iPhone 13"}},
+	}
+
+	suite.repositoryMock.On("SearchProducts", mock.Anything, repository.ProductSearchQuery{
+		ProductFilter: activeListings,
+		Text:          "iphone",
+		Offset:        0,
+		Limit:         3,
+	}).Return(results, nil)
+	suite.repositoryMock.On("CountProductFacets", mock.Anything, mock.Anything).Return(&repository.ProductFacetCounts{}, nil)
+
+	useCase := NewSearchProductsUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	useCase.now = testClock
+	result, err := useCase.Execute(context.Background(), dto.ProductSearchInputDTO{Query: " iphone ", Limit: 2})
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Products, 2)
+	assert.Equal(suite.T(), "MLB001", result.Products[0].ID)
+	assert.Equal(suite.T(), "<em>iPhone</em> 15", result.Products[0].Highlight.Title)
+	assert.Equal(suite.T(), "Latest <em>iPhone</em>", result.Products[0].Highlight.Snippet)
+	assert.True(suite.T(), result.Pagination.HasMore)
+
+	cursor, err := decodeCursor(result.Pagination.NextCursor)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, cursor.Offset)
+}
+
+func (suite *SearchProductsUseCaseTestSuite) TestSearchProductsUseCase_Execute_NextPage() {
+	suite.repositoryMock.On("SearchProducts", mock.Anything, repository.ProductSearchQuery{
+		ProductFilter: repository.ProductFilter{At: testNow, Status: entity.ProductActive, Condition: entity.New, PriceCurrency: entity.BaseCurrency},
+		Text:          "iphone",
+		Offset:        2,
+		Limit:         3,
+	}).Return([]entity.ProductSearchResult{{Product: entity.Product{ID: "MLB010"}}}, nil)
+	suite.repositoryMock.On("CountProductFacets", mock.Anything, mock.Anything).Return(&repository.ProductFacetCounts{}, nil)
+
+	useCase := NewSearchProductsUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	useCase.now = testClock
+	result, err := useCase.Execute(context.Background(), dto.ProductSearchInputDTO{
+		Query:             "iphone",
+		Limit:             2,
+		Cursor:            encodeCursor(pageCursor{Offset: 2}),
+		ProductFiltersDTO: dto.ProductFiltersDTO{Condition: "new"},
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Products, 1)
+	assert.False(suite.T(), result.Pagination.HasMore)
+	assert.Empty(suite.T(), result.Pagination.NextCursor)
+}
+
+func (suite *SearchProductsUseCaseTestSuite) TestSearchProductsUseCase_Execute_InvalidInput() {
+	tests := []struct {
+		name  string
+		input dto.ProductSearchInputDTO
+	}{
+		{name: "missing query", input: dto.ProductSearchInputDTO{Query: "  "}},
+		{name: "query too long", input: dto.ProductSearchInputDTO{Query: strings.Repeat("a", maxSearchQueryLength+1)}},
+		{name: "invalid limit", input: dto.ProductSearchInputDTO{Query: "iphone", Limit: -5}},
+		{name: "negative offset", input: dto.ProductSearchInputDTO{Query: "iphone", Cursor: encodeCursor(pageCursor{Offset: -1})}},
+		{name: "invalid filter", input: dto.ProductSearchInputDTO{Query: "iphone", ProductFiltersDTO: dto.ProductFiltersDTO{Condition: "mint"}}},
+	}
+
+	useCase := NewSearchProductsUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	useCase.now = testClock
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			result, err := useCase.Execute(context.Background(), tt.input)
+
+			assert.Nil(suite.T(), result)
+			assert.ErrorIs(suite.T(), err, errors.ErrInvalidInput)
+		})
+	}
+	suite.repositoryMock.AssertNotCalled(suite.T(), "SearchProducts", mock.Anything, mock.Anything)
+}
+
+func (suite *SearchProductsUseCaseTestSuite) TestSearchProductsUseCase_Execute_SearchUnavailable() {
+	suite.repositoryMock.On("SearchProducts", mock.Anything, mock.Anything).Return(nil, errors.ErrSearchUnavailable)
+
+	useCase := NewSearchProductsUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	useCase.now = testClock
+	result, err := useCase.Execute(context.Background(), dto.ProductSearchInputDTO{Query: "iphone"})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrSearchUnavailable)
+}
+
+func (suite *SearchProductsUseCaseTestSuite) TestSearchProductsUseCase_Execute_FacetsFollowQuery() {
+	suite.repositoryMock.On("SearchProducts", mock.Anything, mock.Anything).Return([]entity.ProductSearchResult{}, nil)
+	suite.repositoryMock.On("CountProductFacets", mock.Anything, repository.ProductFacetQuery{
+		ProductFilter: repository.ProductFilter{At: testNow, Status: entity.ProductActive, Condition: entity.New, PriceCurrency: entity.BaseCurrency},
+		Text:          "iphone",
+		PriceBreaks:   priceBreaks,
+	}).Return(&repository.ProductFacetCounts{
+		Categories:  []repository.FacetCount{{Value: "Electronics > Smartphones", Count: 1}},
+		PriceRanges: []int{0, 0, 0, 0, 0, 1},
+	}, nil)
+
+	useCase := NewSearchProductsUseCase(suite.repositoryMock, suite.categoryRepositoryMock, suite.exchangeRateRepositoryMock, suite.installmentPlanRepositoryMock, suite.promotionRepositoryMock)
+	useCase.now = testClock
+	result, err := useCase.Execute(context.Background(), dto.ProductSearchInputDTO{
+		Query:             "iphone",
+		ProductFiltersDTO: dto.ProductFiltersDTO{Condition: "new"},
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []dto.FacetBucketDTO{{Value: "Electronics", Label: "Electronics", Count: 1}}, result.Facets.Category.Buckets)
+	assert.Equal(suite.T(), []dto.PriceRangeBucketDTO{{Min: 1000, Currency: "USD", Count: 1}}, result.Facets.PriceRange)
+	suite.repositoryMock.AssertExpectations(suite.T())
+}
+
+func TestSearchProductsUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchProductsUseCaseTestSuite))
+}
@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type UpdateProductUseCase struct {
+	productRepository  repository.ProductRepositoryInterface
+	categoryRepository repository.CategoryRepositoryInterface
+}
+
+func NewUpdateProductUseCase(productRepo repository.ProductRepositoryInterface, categoryRepo repository.CategoryRepositoryInterface) *UpdateProductUseCase {
+	return &UpdateProductUseCase{
+		productRepository:  productRepo,
+		categoryRepository: categoryRepo,
+	}
+}
+
+func (p *UpdateProductUseCase) Execute(ctx context.Context, input dto.UpdateProductInputDTO) (*dto.ProductDTO, error) {
+	log.Debug().
+		Str("product_id", input.ID).
+		Msg("Executing UpdateProduct use case")
+
+	if strings.TrimSpace(input.ID) == "" {
+		log.Warn().Msg("Invalid product ID: empty or whitespace")
+		return nil, errors.ErrInvalidProductID
+	}
+
+	product, err := p.productRepository.GetProduct(ctx, input.ID, false)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ID).
+			Msg("Failed to get product from repository")
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	validation := &errors.ValidationError{}
+	validation.Merge("", applyProductFields(product, input.ProductFieldsDTO))
+
+	images, err := newProductImages(product.ID, input.Images)
+	validation.Merge("", err)
+
+	product.SetVariations(newProductVariations(product.ID, input.ProductFieldsDTO, validation))
+
+	if err := validateCategoryAttributes(ctx, p.categoryRepository, input.ProductFieldsDTO, product.Variations, validation); err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ID).
+			Msg("Failed to get category attributes")
+		return nil, err
+	}
+
+	if err := validation.Err(); err != nil {
+		log.Warn().
+			Err(err).
+			Str("product_id", input.ID).
+			Msg("Invalid product data")
+		return nil, err
+	}
+
+	product.ApplySchedule(product.UpdatedAt)
+	product.PauseIfOutOfStock(product.UpdatedAt)
+
+	if err := p.productRepository.UpdateProduct(ctx, product, images, input.Version); err != nil {
+		log.Error().
+			Err(err).
+			Str("product_id", input.ID).
+			Msg("Failed to update product in repository")
+		return nil, fmt.Errorf("failed to update product: %w", sellerInputError(err))
+	}
+
+	log.Info().
+		Str("product_id", product.ID).
+		Int("images_count", len(images)).
+		Int("variations_count", len(product.Variations)).
+		Msg("Product updated successfully")
+
+	return toGetProductDTO(*product, images), nil
+}
+
+// applyProductFields overwrites the writable fields of product, bumps its
+// UpdatedAt and validates the result, reporting every invalid field. A new
+// publishing window may schedule the listing; ApplySchedule settles it. The
+// variations are set apart, as they need the product to be priced.
+func applyProductFields(product *entity.Product, fields dto.ProductFieldsDTO) error {
+	validation := &errors.ValidationError{}
+
+	product.Title = fields.Title
+	product.Description = fields.Description
+	product.Price = newProductPrice(fields, validation)
+	product.Condition = fields.Condition
+	product.Stock = fields.Stock
+	product.SellerID = fields.SellerID
+	product.SellerName = fields.SellerName
+	product.Category = fields.Category
+	product.Attributes = entity.ProductAttributes(fields.Attributes)
+	product.Shipping = newProductShipping(fields)
+	product.UpdatedAt = time.Now()
+
+	validation.Merge("", product.Validate())
+	validation.Merge("", product.SetSchedule(fields.PublishAt, fields.ExpiresAt, product.UpdatedAt))
+
+	return validation.Err()
+}
+
+// toProductFields is the inverse of applyProductFields, used as the document a
+// merge patch is applied to.
+func toProductFields(product entity.Product, images []entity.ProductImage) dto.ProductFieldsDTO {
+	urls := make([]string, 0, len(images))
+	for _, image := range images {
+		urls = append(urls, image.ImageURL)
+	}
+
+	var variations []dto.VariationFieldsDTO
+	for _, variation := range product.Variations {
+		variations = append(variations, dto.VariationFieldsDTO{
+			Attributes: variation.Attributes,
+			Price:      variation.Price.Float64(),
+			Stock:      variation.Stock,
+			Images:     variation.Images,
+		})
+	}
+
+	return dto.ProductFieldsDTO{
+		Title:       product.Title,
+		Description: product.Description,
+		Price:       product.Price.Float64(),
+		Currency:    product.Price.Currency,
+		Condition:   product.Condition,
+		Stock:       product.Stock,
+		SellerID:    product.SellerID,
+		SellerName:  product.SellerName,
+		Category:    product.Category,
+		Attributes:  product.Attributes,
+		Images:      urls,
+		Variations:  variations,
+		Shipping:    toShippingDTO(product.Shipping),
+		PublishAt:   product.PublishAt,
+		ExpiresAt:   product.ExpiresAt,
+	}
+}
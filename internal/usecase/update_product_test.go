@@ -0,0 +1,239 @@
+package usecase
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/errors"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UpdateProductUseCaseTestSuite struct {
+	suite.Suite
+	repositoryMock         *repository.MockProductRepository
+	categoryRepositoryMock *repository.MockCategoryRepository
+}
+
+func (suite *UpdateProductUseCaseTestSuite) BeforeTest(suiteName, testName string) {
+	suite.repositoryMock = new(repository.MockProductRepository)
+	suite.categoryRepositoryMock = new(repository.MockCategoryRepository)
+	suite.categoryRepositoryMock.On("FindCategoryAttributes", mock.Anything, mock.Anything).Return(smartphoneAttributes(), nil)
+}
+
+func storedProduct(version time.Time) *entity.Product {
+	return &entity.Product{
+		ID:          "MLB001",
+		Title:       "iPhone 15",
+		Description: "Latest iPhone",
+		Price:       entity.Money{Amount: 99999, Currency: "USD"},
+		Condition:   entity.New,
+		Stock:       10,
+		Status:      entity.ProductActive,
+		SellerID:    "SELLER001",
+		SellerName:  "TechWorld Store",
+		Category:    "Electronics > Smartphones",
+		Attributes:  entity.ProductAttributes{"brand": "Apple", "storage": "256GB"},
+		CreatedAt:   version.Add(-time.Hour),
+		UpdatedAt:   version,
+	}
+}
+
+func (suite *UpdateProductUseCaseTestSuite) TestUpdateProductUseCase_Execute_Success() {
+	version := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	product := storedProduct(version)
+
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(product, nil)
+	suite.repositoryMock.On("UpdateProduct", mock.Anything, mock.Anything, mock.Anything, version).Return(nil)
+
+	fields := validProductFields()
+	fields.Title = "iPhone 15 - Renewed"
+	fields.Price = 799.0
+	fields.Images = []string{"http://example.com/new.jpg"}
+
+	useCase := NewUpdateProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.UpdateProductInputDTO{
+		ID:               "MLB001",
+		Version:          version,
+		ProductFieldsDTO: fields,
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "MLB001", result.ID)
+	assert.Equal(suite.T(), "iPhone 15 - Renewed", result.Title)
+	assert.Equal(suite.T(), 799.0, result.Price)
+	assert.Equal(suite.T(), version.Add(-time.Hour), result.CreatedAt)
+	assert.True(suite.T(), result.UpdatedAt.After(version))
+	assert.Len(suite.T(), result.Images, 1)
+
+	images := suite.repositoryMock.Calls[1].Arguments.Get(2).([]entity.ProductImage)
+	assert.Equal(suite.T(), "http://example.com/new.jpg", images[0].ImageURL)
+}
+
+func (suite *UpdateProductUseCaseTestSuite) TestUpdateProductUseCase_Execute_OutOfStockPauses() {
+	version := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(storedProduct(version), nil)
+	suite.repositoryMock.On("UpdateProduct", mock.Anything, mock.Anything, mock.Anything, version).Return(nil)
+
+	fields := validProductFields()
+	fields.Stock = 0
+
+	useCase := NewUpdateProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.UpdateProductInputDTO{
+		ID:               "MLB001",
+		Version:          version,
+		ProductFieldsDTO: fields,
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), entity.ProductPaused, result.Status)
+
+	product := suite.repositoryMock.Calls[1].Arguments.Get(1).(*entity.Product)
+	assert.Len(suite.T(), product.StatusChanges, 1)
+	assert.Equal(suite.T(), entity.ActorSystem, product.StatusChanges[0].Actor)
+	assert.Equal(suite.T(), entity.ReasonOutOfStock, product.StatusChanges[0].Reason)
+}
+
+func (suite *UpdateProductUseCaseTestSuite) TestUpdateProductUseCase_Execute_ClearingScheduleActivates() {
+	version := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	publishAt := time.Now().Add(24 * time.Hour)
+	product := storedProduct(version)
+	product.Status = entity.ProductScheduled
+	product.PublishAt = &publishAt
+
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(product, nil)
+	suite.repositoryMock.On("UpdateProduct", mock.Anything, mock.Anything, mock.Anything, version).Return(nil)
+
+	useCase := NewUpdateProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.UpdateProductInputDTO{
+		ID:               "MLB001",
+		Version:          version,
+		ProductFieldsDTO: validProductFields(),
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), entity.ProductActive, result.Status)
+	assert.Nil(suite.T(), result.PublishAt)
+
+	updated := suite.repositoryMock.Calls[1].Arguments.Get(1).(*entity.Product)
+	assert.Len(suite.T(), updated.StatusChanges, 1)
+	assert.Equal(suite.T(), entity.ReasonPublished, updated.StatusChanges[0].Reason)
+}
+
+func (suite *UpdateProductUseCaseTestSuite) TestUpdateProductUseCase_Execute_ExpiresAtInThePast() {
+	version := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	expiresAt := time.Now().Add(-time.Hour)
+
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(storedProduct(version), nil)
+
+	fields := validProductFields()
+	fields.ExpiresAt = &expiresAt
+
+	useCase := NewUpdateProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.UpdateProductInputDTO{
+		ID:               "MLB001",
+		Version:          version,
+		ProductFieldsDTO: fields,
+	})
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), []errors.FieldError{
+		{Field: "expires_at", Rule: errors.RuleMin, Message: "expires_at must be in the future"},
+	}, errors.GetErrorDetails(err))
+	suite.repositoryMock.AssertNotCalled(suite.T(), "UpdateProduct", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *UpdateProductUseCaseTestSuite) TestUpdateProductUseCase_Execute_WithoutImagesClearsThem() {
+	version := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(storedProduct(version), nil)
+	suite.repositoryMock.On("UpdateProduct", mock.Anything, mock.Anything, mock.Anything, version).Return(nil)
+
+	fields := validProductFields()
+	fields.Images = nil
+
+	useCase := NewUpdateProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+	_, err := useCase.Execute(context.Background(), dto.UpdateProductInputDTO{
+		ID:               "MLB001",
+		Version:          version,
+		ProductFieldsDTO: fields,
+	})
+
+	assert.NoError(suite.T(), err)
+
+	images := suite.repositoryMock.Calls[1].Arguments.Get(2).([]entity.ProductImage)
+	assert.NotNil(suite.T(), images)
+	assert.Empty(suite.T(), images)
+}
+
+func (suite *UpdateProductUseCaseTestSuite) TestUpdateProductUseCase_Execute_InvalidProduct() {
+	version := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(storedProduct(version), nil)
+
+	fields := validProductFields()
+	fields.Condition = "broken"
+
+	useCase := NewUpdateProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.UpdateProductInputDTO{
+		ID:               "MLB001",
+		Version:          version,
+		ProductFieldsDTO: fields,
+	})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrValidation)
+	assert.Equal(suite.T(), "condition must be 'new', 'used', or 'refurbished'", errors.GetUserFriendlyMessage(err, http.StatusUnprocessableEntity))
+	suite.repositoryMock.AssertNotCalled(suite.T(), "UpdateProduct", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *UpdateProductUseCaseTestSuite) TestUpdateProductUseCase_Execute_NotFound() {
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB999", false).Return(nil, errors.ErrProductNotFound)
+
+	useCase := NewUpdateProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.UpdateProductInputDTO{
+		ID:               "MLB999",
+		Version:          time.Now(),
+		ProductFieldsDTO: validProductFields(),
+	})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrProductNotFound)
+}
+
+func (suite *UpdateProductUseCaseTestSuite) TestUpdateProductUseCase_Execute_StaleVersion() {
+	version := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	suite.repositoryMock.On("GetProduct", mock.Anything, "MLB001", false).Return(storedProduct(version), nil)
+	suite.repositoryMock.On("UpdateProduct", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.ErrVersionConflict)
+
+	useCase := NewUpdateProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.UpdateProductInputDTO{
+		ID:               "MLB001",
+		Version:          version.Add(-time.Minute),
+		ProductFieldsDTO: validProductFields(),
+	})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errors.ErrVersionConflict)
+}
+
+func (suite *UpdateProductUseCaseTestSuite) TestUpdateProductUseCase_Execute_EmptyID() {
+	useCase := NewUpdateProductUseCase(suite.repositoryMock, suite.categoryRepositoryMock)
+	result, err := useCase.Execute(context.Background(), dto.UpdateProductInputDTO{ID: " "})
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), errors.ErrInvalidProductID, err)
+}
+
+func TestUpdateProductUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(UpdateProductUseCaseTestSuite))
+}
@@ -1,33 +1,110 @@
 package integration
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"project/internal/dto"
+	"project/internal/errors"
 	"project/internal/handler"
 	"project/internal/infra/database"
 	httpInfra "project/internal/infra/http"
 	"project/internal/usecase"
 
 	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/assert"
 )
 
+const testAdminToken = "integration-admin-token"
+
+// testDBOptions opens a seeded in-memory database that enforces foreign keys,
+// as the service does by default.
+var testDBOptions = database.Options{ForeignKeys: true, Seed: true}
+
 func setupTestRouter(t *testing.T) *gin.Engine {
-	db, err := database.InitDB()
+	db, err := database.InitDB(testDBOptions)
 	if err != nil {
 		t.Fatalf("Failed to initialize test database: %v", err)
 	}
 
+	return newTestRouter(db)
+}
+
+// newTestRouter serves the API over db, for tests that also reach the
+// database directly.
+func newTestRouter(db *sqlx.DB) *gin.Engine {
 	productRepo := database.NewProductRepository(db)
-	listProductUseCase := usecase.NewListProductUseCase(productRepo)
-	getProductUseCase := usecase.NewGetProductUseCase(productRepo)
+	categoryRepo := database.NewCategoryRepository(db)
+	sellerRepo := database.NewSellerRepository(db)
+	reviewRepo := database.NewReviewRepository(db)
+	questionRepo := database.NewQuestionRepository(db)
+	exchangeRateRepo := database.NewExchangeRateRepository(db)
+	shippingRateRepo := database.NewShippingRateRepository(db)
+	installmentPlanRepo := database.NewInstallmentPlanRepository(db)
+	promotionRepo := database.NewPromotionRepository(db)
+	listProductUseCase := usecase.NewListProductUseCase(productRepo, categoryRepo, exchangeRateRepo, installmentPlanRepo, promotionRepo)
+	getProductUseCase := usecase.NewGetProductUseCase(productRepo, categoryRepo, questionRepo, exchangeRateRepo, installmentPlanRepo, promotionRepo)
+	createProductUseCase := usecase.NewCreateProductUseCase(productRepo, categoryRepo)
+	updateProductUseCase := usecase.NewUpdateProductUseCase(productRepo, categoryRepo)
+	patchProductUseCase := usecase.NewPatchProductUseCase(productRepo, categoryRepo)
+	deleteProductUseCase := usecase.NewDeleteProductUseCase(productRepo)
+	restoreProductUseCase := usecase.NewRestoreProductUseCase(productRepo)
+	searchProductsUseCase := usecase.NewSearchProductsUseCase(productRepo, categoryRepo, exchangeRateRepo, installmentPlanRepo, promotionRepo)
+	batchGetProductsUseCase := usecase.NewBatchGetProductsUseCase(productRepo, categoryRepo, exchangeRateRepo, installmentPlanRepo, promotionRepo)
+
+	listCategoriesUseCase := usecase.NewListCategoriesUseCase(categoryRepo)
+	getCategoryUseCase := usecase.NewGetCategoryUseCase(categoryRepo)
+
+	getSellerUseCase := usecase.NewGetSellerUseCase(sellerRepo)
+	listSellerProductsUseCase := usecase.NewListSellerProductsUseCase(productRepo, categoryRepo, sellerRepo, exchangeRateRepo, installmentPlanRepo, promotionRepo)
 
-	productHandler := handler.NewProductHandler(listProductUseCase, getProductUseCase)
+	createReviewUseCase := usecase.NewCreateReviewUseCase(productRepo, reviewRepo)
+	listReviewsUseCase := usecase.NewListReviewsUseCase(productRepo, reviewRepo)
+
+	createQuestionUseCase := usecase.NewCreateQuestionUseCase(productRepo, questionRepo)
+	listQuestionsUseCase := usecase.NewListQuestionsUseCase(productRepo, questionRepo)
+	answerQuestionUseCase := usecase.NewAnswerQuestionUseCase(productRepo, questionRepo)
+	hideQuestionUseCase := usecase.NewHideQuestionUseCase(productRepo, questionRepo)
+
+	listExchangeRatesUseCase := usecase.NewListExchangeRatesUseCase(exchangeRateRepo)
+	loadExchangeRatesUseCase := usecase.NewLoadExchangeRatesUseCase(exchangeRateRepo)
+	getShippingQuoteUseCase := usecase.NewGetShippingQuoteUseCase(productRepo, shippingRateRepo, nil)
+	getProductInstallmentsUseCase := usecase.NewGetProductInstallmentsUseCase(productRepo, categoryRepo, exchangeRateRepo, installmentPlanRepo, promotionRepo)
+	createPromotionUseCase := usecase.NewCreatePromotionUseCase(promotionRepo, productRepo, categoryRepo)
+	listPromotionsUseCase := usecase.NewListPromotionsUseCase(promotionRepo)
+	deletePromotionUseCase := usecase.NewDeletePromotionUseCase(promotionRepo)
+	changeProductStatusUseCase := usecase.NewChangeProductStatusUseCase(productRepo, categoryRepo, installmentPlanRepo, promotionRepo)
+	listStatusChangesUseCase := usecase.NewListStatusChangesUseCase(productRepo)
+
+	productHandler := handler.NewProductHandler(
+		listProductUseCase,
+		getProductUseCase,
+		createProductUseCase,
+		updateProductUseCase,
+		patchProductUseCase,
+		deleteProductUseCase,
+		restoreProductUseCase,
+		searchProductsUseCase,
+		batchGetProductsUseCase,
+	)
+	categoryHandler := handler.NewCategoryHandler(listCategoriesUseCase, getCategoryUseCase)
+	sellerHandler := handler.NewSellerHandler(getSellerUseCase, listSellerProductsUseCase)
+	reviewHandler := handler.NewReviewHandler(createReviewUseCase, listReviewsUseCase)
+	questionHandler := handler.NewQuestionHandler(createQuestionUseCase, listQuestionsUseCase, answerQuestionUseCase, hideQuestionUseCase)
+	exchangeRateHandler := handler.NewExchangeRateHandler(listExchangeRatesUseCase, loadExchangeRatesUseCase)
+	shippingHandler := handler.NewShippingHandler(getShippingQuoteUseCase)
+	installmentHandler := handler.NewInstallmentHandler(getProductInstallmentsUseCase)
+	promotionHandler := handler.NewPromotionHandler(createPromotionUseCase, listPromotionsUseCase, deletePromotionUseCase)
+	productStatusHandler := handler.NewProductStatusHandler(changeProductStatusUseCase, listStatusChangesUseCase)
 	healthHandler := handler.NewHealthHandler()
 
-	return httpInfra.SetupRouter(productHandler, healthHandler)
+	return httpInfra.SetupRouter(productHandler, categoryHandler, sellerHandler, reviewHandler, questionHandler, exchangeRateHandler, shippingHandler, installmentHandler, promotionHandler, productStatusHandler, healthHandler, testAdminToken)
 }
 
 func TestIntegration_ListProducts(t *testing.T) {
@@ -45,6 +122,320 @@ func TestIntegration_ListProducts(t *testing.T) {
 	assert.Contains(t, w.Body.String(), "data")
 }
 
+func TestIntegration_ListProducts_CursorPagination(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	var ids []string
+	url := "/api/v1/products?limit=2"
+	for pages := 0; pages < 10; pages++ {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", url, nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response struct {
+			Data []struct {
+				ID string `json:"id"`
+			} `json:"data"`
+			Pagination struct {
+				Limit      int    `json:"limit"`
+				NextCursor string `json:"next_cursor"`
+				HasMore    bool   `json:"has_more"`
+			} `json:"pagination"`
+		}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.LessOrEqual(t, len(response.Data), 2)
+		assert.Equal(t, 2, response.Pagination.Limit)
+
+		for _, product := range response.Data {
+			ids = append(ids, product.ID)
+		}
+
+		if !response.Pagination.HasMore {
+			assert.Empty(t, response.Pagination.NextCursor)
+			break
+		}
+		url = "/api/v1/products?limit=2&cursor=" + response.Pagination.NextCursor
+	}
+
+	assert.Equal(t, []string{"MLB001", "MLB002", "MLB003", "MLB004", "MLB005"}, ids)
+}
+
+func TestIntegration_ListProducts_ProductWithoutImages(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	body := `{"id": "MLB100", "title": "Logitech MX Master 3S Mouse", "price": 99.99, "currency": "USD", "condition": "new", "stock": 5, "seller_id": "SELLER001", "seller_name": "TechWorld Store"}`
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/products", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/products", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "MLB100")
+}
+
+func TestIntegration_ListProducts_InvalidCursor(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/products?cursor=garbage!", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "cursor is invalid")
+}
+
+func TestIntegration_ListProducts_Filters(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	tests := []struct {
+		name        string
+		query       string
+		expectedIDs []string
+	}{
+		{name: "category matches subcategories", query: "category=Electronics", expectedIDs: []string{"MLB001", "MLB002", "MLB003", "MLB005"}},
+		{name: "category matches whole path segments", query: "category=Electronics+%3E+Computers", expectedIDs: []string{"MLB002"}},
+		{name: "condition", query: "condition=used", expectedIDs: []string{"MLB002", "MLB005"}},
+		{name: "seller", query: "seller_id=SELLER001", expectedIDs: []string{"MLB001", "MLB005"}},
+		{name: "price range", query: "min_price=300&max_price=450", expectedIDs: []string{"MLB004", "MLB005"}},
+		{name: "combined", query: "category=Electronics&condition=new&max_price=1000", expectedIDs: []string{"MLB003"}},
+		{name: "in stock", query: "in_stock=false", expectedIDs: []string{}},
+		{name: "attribute ignores case", query: "attr.brand=apple", expectedIDs: []string{"MLB001"}},
+		{name: "attributes", query: "attr.wireless=true", expectedIDs: []string{"MLB003", "MLB005"}},
+		{name: "attributes combined", query: "attr.wireless=true&attr.noise_cancelling=true", expectedIDs: []string{"MLB005"}},
+		{name: "unknown attribute", query: "attr.flavour=mint", expectedIDs: []string{}},
+		{name: "attribute of a variation", query: "attr.storage=512GB", expectedIDs: []string{"MLB001"}},
+		{name: "attributes of one variation", query: "attr.color=natural+titanium&attr.storage=256GB&attr.brand=Apple", expectedIDs: []string{"MLB001"}},
+		{name: "attributes of different variations", query: "attr.color=Natural+Titanium&attr.storage=512GB", expectedIDs: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/api/v1/products?"+tt.query, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			var response struct {
+				Data []struct {
+					ID string `json:"id"`
+				} `json:"data"`
+			}
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+			ids := []string{}
+			for _, product := range response.Data {
+				ids = append(ids, product.ID)
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+		})
+	}
+}
+
+func TestIntegration_ListProducts_Facets(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	type bucket struct {
+		Value string `json:"value"`
+		Label string `json:"label"`
+		Count int    `json:"count"`
+	}
+	var response struct {
+		Facets struct {
+			Condition []bucket `json:"condition"`
+			Category  struct {
+				Level   int      `json:"level"`
+				Buckets []bucket `json:"buckets"`
+			} `json:"category"`
+			Seller     []bucket `json:"seller"`
+			PriceRange []struct {
+				Min   float64  `json:"min"`
+				Max   *float64 `json:"max"`
+				Count int      `json:"count"`
+			} `json:"price_range"`
+		} `json:"facets"`
+	}
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/products?limit=1", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, []bucket{{Value: "new", Count: 3}, {Value: "used", Count: 2}}, response.Facets.Condition)
+	assert.Equal(t, 1, response.Facets.Category.Level)
+	assert.Equal(t, []bucket{
+		{Value: "Electronics", Label: "Electronics", Count: 4},
+		{Value: "Fashion", Label: "Fashion", Count: 1},
+	}, response.Facets.Category.Buckets)
+	assert.Len(t, response.Facets.Seller, 4)
+	assert.Equal(t, "SELLER001", response.Facets.Seller[0].Value)
+	assert.Equal(t, 2, response.Facets.Seller[0].Count)
+	assert.Len(t, response.Facets.PriceRange, 3)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/products?category=Electronics&condition=new", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, []bucket{{Value: "new", Count: 2}}, response.Facets.Condition)
+	assert.Equal(t, 2, response.Facets.Category.Level)
+	assert.Equal(t, []bucket{
+		{Value: "Electronics > Computer Accessories", Label: "Computer Accessories", Count: 1},
+		{Value: "Electronics > Smartphones", Label: "Smartphones", Count: 1},
+	}, response.Facets.Category.Buckets)
+}
+
+func TestIntegration_ListProducts_SparseFieldsAndExpand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/products?limit=2&fields=id,images,seller&expand=images,seller", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Data []map[string]json.RawMessage `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Len(t, response.Data, 2)
+
+	for _, product := range response.Data {
+		assert.ElementsMatch(t, []string{"id", "images", "seller"}, keysOf(product))
+	}
+	assert.JSONEq(t, `{"id":"SELLER001","name":"TechWorld Store"}`, string(response.Data[0]["seller"]))
+
+	var images []struct {
+		ProductID    string `json:"product_id"`
+		DisplayOrder int    `json:"display_order"`
+	}
+	assert.NoError(t, json.Unmarshal(response.Data[0]["images"], &images))
+	assert.Greater(t, len(images), 1)
+	for i, image := range images {
+		assert.Equal(t, "MLB001", image.ProductID)
+		assert.Equal(t, i, image.DisplayOrder)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/products/MLB001?fields=id,price", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"data":{"id":"MLB001","price":1299.99}}`, w.Body.String())
+}
+
+func TestIntegration_ListProducts_BatchGetByIDs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/products?ids=MLB003,MLB999,MLB001", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Data []struct {
+			ID     string `json:"id"`
+			Images []struct {
+				ProductID string `json:"product_id"`
+			} `json:"images"`
+		} `json:"data"`
+		Errors []struct {
+			ID   string `json:"id"`
+			Code string `json:"code"`
+		} `json:"errors"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+	assert.Len(t, response.Data, 2)
+	assert.Equal(t, "MLB003", response.Data[0].ID)
+	assert.Equal(t, "MLB001", response.Data[1].ID)
+	for _, product := range response.Data {
+		assert.NotEmpty(t, product.Images)
+		for _, image := range product.Images {
+			assert.Equal(t, product.ID, image.ProductID)
+		}
+	}
+	assert.Len(t, response.Errors, 1)
+	assert.Equal(t, "MLB999", response.Errors[0].ID)
+	assert.Equal(t, "PRODUCT_NOT_FOUND", response.Errors[0].Code)
+}
+
+func keysOf(values map[string]json.RawMessage) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func TestIntegration_ListProducts_InvalidFilter(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	tests := []struct {
+		query           string
+		expectedMessage string
+	}{
+		{query: "condition=broken", expectedMessage: "condition must be 'new', 'used', or 'refurbished'"},
+		{query: "min_price=cheap", expectedMessage: "min_price must be a number"},
+		{query: "min_price=10&max_price=5", expectedMessage: "min_price must be less than or equal to max_price"},
+		{query: "in_stock=yes", expectedMessage: "in_stock must be a boolean"},
+		{query: "attr.Brand=Apple", expectedMessage: "attr.Brand is not a valid attribute name"},
+		{query: "attr.brand=", expectedMessage: "attr.brand must not be empty"},
+	}
+
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/products?"+tt.query, nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, tt.query)
+		assert.Contains(t, w.Body.String(), tt.expectedMessage, tt.query)
+		assert.Contains(t, w.Body.String(), "INVALID_INPUT", tt.query)
+	}
+}
+
 func TestIntegration_GetProduct_NotFound(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration test")
@@ -74,6 +465,47 @@ func TestIntegration_GetProduct_EmptyID(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, w.Code)
 }
 
+func TestIntegration_GetProduct_MoneyPriceFormat(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	var product dto.ProductResponse
+	getJSON(t, router, "/api/v1/products/MLB001?price_format=money", &product)
+	assert.Equal(t, 1299.99, product.Data.Price)
+	assert.Equal(t, &dto.MoneyDTO{Amount: 129999, Currency: "USD", Decimals: 2}, product.Data.PriceMoney)
+	assert.Nil(t, product.Data.OriginalPriceMoney)
+
+	var plain dto.ProductResponse
+	getJSON(t, router, "/api/v1/products/MLB001", &plain)
+	assert.Nil(t, plain.Data.PriceMoney)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/products/MLB001?price_format=cents", nil)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestIntegration_CreateProduct_TooManyDecimals(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	body := `{"title": "Rice cooker", "price": 4999.5, "currency": "JPY", "condition": "new", "seller_id": "SELLER001", "seller_name": "TechWorld Store"}`
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/products", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(t, w.Body.String(), "JPY amounts cannot have more than 0 decimal places")
+}
+
 func TestIntegration_HealthCheck(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration test")
@@ -89,3 +521,416 @@ func TestIntegration_HealthCheck(t *testing.T) {
 	assert.Contains(t, w.Body.String(), "healthy")
 	assert.Contains(t, w.Body.String(), "product-api")
 }
+
+func TestIntegration_CreateProduct(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	body := `{
+		"title": "Apple Watch Series 9 45mm",
+		"price": 429.00,
+		"currency": "USD",
+		"condition": "new",
+		"stock": 12,
+		"seller_id": "SELLER001",
+		"seller_name": "TechWorld Store",
+		"category": "Electronics > Wearables",
+		"images": ["https://example.com/watch-1.jpg", "https://example.com/watch-2.jpg"]
+	}`
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/products", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var created struct {
+		Data struct {
+			ID     string `json:"id"`
+			Images []struct {
+				ID int `json:"id"`
+			} `json:"images"`
+		} `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	assert.NotEmpty(t, created.Data.ID)
+	assert.Len(t, created.Data.Images, 2)
+	location := w.Header().Get("Location")
+	assert.Equal(t, "/api/v1/products/"+created.Data.ID, location)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", location, nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "Apple Watch Series 9 45mm")
+	assert.Contains(t, w.Body.String(), "https://example.com/watch-2.jpg")
+}
+
+func TestIntegration_CreateProduct_DuplicateID(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	body := `{"id": "MLB001", "title": "Duplicate", "price": 1, "currency": "USD", "condition": "new", "seller_id": "SELLER001", "seller_name": "TechWorld Store"}`
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/products", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), "PRODUCT_ALREADY_EXISTS")
+}
+
+func TestIntegration_CreateProduct_InvalidProduct(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	body := `{"title": "", "price": 10, "currency": "XYZ", "condition": "new", "images": ["https://example.com/1.jpg", ""]}`
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/products", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+
+	var response errors.ErrorResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "VALIDATION_FAILED", response.Code)
+	assert.Equal(t, []errors.FieldError{
+		{Field: "currency", Rule: "iso4217", Message: "currency must be an ISO 4217 code"},
+		{Field: "title", Rule: "required", Message: "title is required"},
+		{Field: "seller_id", Rule: "required", Message: "seller_id is required"},
+		{Field: "images[1].image_url", Rule: "required", Message: "images[1]: image_url is required"},
+	}, response.Details)
+}
+
+func TestIntegration_CreateProduct_Attributes(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	body := `{
+		"id": "MLB100",
+		"title": "Galaxy S24 Ultra",
+		"price": 1199.99,
+		"currency": "USD",
+		"condition": "new",
+		"stock": 5,
+		"seller_id": "SELLER001",
+		"category": "Electronics > Smartphones",
+		"attributes": {"brand": "Samsung", "ram_gb": "twelve", "size": "6.8"}
+	}`
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/products", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+
+	var response errors.ErrorResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, []errors.FieldError{
+		{Field: "attributes.ram_gb", Rule: "type", Message: "attributes.ram_gb must be a number"},
+		{Field: "attributes.storage", Rule: "required", Message: "attributes.storage is required"},
+		{Field: "attributes.size", Rule: "allowed", Message: "attributes.size is not an attribute of the category"},
+	}, response.Details)
+
+	body = strings.Replace(body, `"ram_gb": "twelve", "size": "6.8"`, `"ram_gb": "12", "storage": "512GB"`, 1)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/api/v1/products", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	var product dto.ProductResponse
+	getJSON(t, router, "/api/v1/products/MLB100", &product)
+	assert.Equal(t, map[string]string{"brand": "Samsung", "ram_gb": "12", "storage": "512GB"}, product.Data.Attributes)
+
+	var list dto.ProductListResponse
+	getJSON(t, router, "/api/v1/products?attr.storage=512gb&attr.brand=Samsung", &list)
+	assert.Len(t, list.Data, 1)
+	assert.Equal(t, "MLB100", list.Data[0].ID)
+}
+
+func TestIntegration_ListProducts_BatchGetMatchesGetProduct(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	var product dto.ProductResponse
+	getJSON(t, router, "/api/v1/products/MLB001", &product)
+
+	var batch struct {
+		Data []dto.ProductDTO `json:"data"`
+	}
+	getJSON(t, router, "/api/v1/products?ids=MLB001", &batch)
+
+	assert.Len(t, batch.Data, 1)
+	assert.NotEmpty(t, product.Data.Variations)
+	assert.Equal(t, product.Data, batch.Data[0])
+}
+
+func TestIntegration_GetProduct_Variations(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	var product dto.ProductResponse
+	getJSON(t, router, "/api/v1/products/MLB001", &product)
+
+	assert.Equal(t, 1299.99, product.Data.Price)
+	assert.Equal(t, 45, product.Data.Stock)
+	assert.Equal(t, map[string]string{"brand": "Apple", "ram_gb": "8"}, product.Data.Attributes)
+	assert.Len(t, product.Data.Variations, 3)
+	assert.Equal(t, map[string]string{"color": "Titanium Blue", "storage": "512GB"}, product.Data.Variations[1].Attributes)
+	assert.Equal(t, 1499.99, product.Data.Variations[1].Price)
+	assert.Equal(t, 15, product.Data.Variations[1].Stock)
+	assert.Len(t, product.Data.Variations[1].Images, 1)
+}
+
+func TestIntegration_CreateProduct_Variations(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	body := `{
+		"id": "MLB100",
+		"title": "Galaxy S24 Ultra",
+		"price": 1,
+		"currency": "USD",
+		"condition": "new",
+		"stock": 1,
+		"seller_id": "SELLER001",
+		"category": "Electronics > Smartphones",
+		"attributes": {"brand": "Samsung"},
+		"variations": [
+			{"attributes": {"storage": "512GB"}, "price": 1299.99, "stock": 3, "images": ["https://example.com/512.jpg"]},
+			{"attributes": {"storage": "256gb"}, "price": 1199.99, "stock": 0},
+			{"attributes": {"storage": "256GB"}, "price": 1199.99, "stock": 2}
+		]
+	}`
+
+	w := postProduct(t, router, body)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+
+	var response errors.ErrorResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, []errors.FieldError{
+		{Field: "variations[2].attributes", Rule: "unique", Message: "variations[2].attributes must differ from variations[1]"},
+	}, response.Details)
+
+	w = postProduct(t, router, strings.Replace(body, `"256GB"`, `"1TB"`, 1))
+
+	assert.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	var list dto.ProductListResponse
+	getJSON(t, router, "/api/v1/products?attr.storage=1tb&attr.brand=Samsung", &list)
+	assert.Len(t, list.Data, 1)
+	assert.Equal(t, "MLB100", list.Data[0].ID)
+	assert.Equal(t, 1199.99, list.Data[0].Price, "a product is priced at its cheapest variation")
+	assert.Equal(t, 5, list.Data[0].Stock, "a product has the stock of all of its variations")
+	assert.Nil(t, list.Data[0].Variations)
+
+	var product dto.ProductResponse
+	getJSON(t, router, "/api/v1/products/MLB100", &product)
+	assert.Len(t, product.Data.Variations, 3)
+	assert.Equal(t, []string{"https://example.com/512.jpg"}, product.Data.Variations[0].Images)
+}
+
+func TestIntegration_UpdateProduct_OptimisticConcurrency(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/products/MLB003", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	originalETag := w.Header().Get("ETag")
+	assert.NotEmpty(t, originalETag)
+
+	// First back-office tool patches with the current version
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("PATCH", "/api/v1/products/MLB003", strings.NewReader(`{"price": 179.99, "stock": 100}`))
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	req.Header.Set("If-Match", originalETag)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"price":179.99`)
+	assert.Contains(t, w.Body.String(), "Keychron Q1 Pro")
+	newETag := w.Header().Get("ETag")
+	assert.NotEqual(t, originalETag, newETag)
+
+	// Second tool still holds the old version and must not overwrite the change
+	body := `{"title": "Keychron Q1 Pro", "price": 150, "currency": "USD", "condition": "new", "stock": 1, "seller_id": "SELLER003", "seller_name": "Keyboard Kingdom"}`
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("PUT", "/api/v1/products/MLB003", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", originalETag)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+	assert.Contains(t, w.Body.String(), "PRECONDITION_FAILED")
+
+	// Retrying with the fresh version succeeds and replaces the images
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("PUT", "/api/v1/products/MLB003", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", newETag)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/products/MLB003", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"price":150`)
+	assert.NotContains(t, w.Body.String(), "images")
+}
+
+func TestIntegration_UpdateProduct_RequiresIfMatch(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("PATCH", "/api/v1/products/MLB001", strings.NewReader(`{"stock": 1}`))
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusPreconditionRequired, w.Code)
+}
+
+func TestIntegration_DeleteAndRestoreProduct(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("DELETE", "/api/v1/products/MLB002", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+
+	// Deleted products are hidden from regular reads
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/products/MLB002", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/products", nil)
+	router.ServeHTTP(w, req)
+
+	assert.NotContains(t, w.Body.String(), "MLB002")
+
+	// Deleting twice reports the product as missing
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("DELETE", "/api/v1/products/MLB002", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	// Only administrators can look at deleted products
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/products?include_deleted=true", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/products/MLB002?include_deleted=true", nil)
+	req.Header.Set("X-Admin-Token", testAdminToken)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "deleted_at")
+
+	// Restoring is an administrative operation
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/api/v1/products/MLB002/restore", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/api/v1/products/MLB002/restore", nil)
+	req.Header.Set("X-Admin-Token", testAdminToken)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), "deleted_at")
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/products/MLB002", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestIntegration_PurgeDeletedProducts(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	db, err := database.InitDB(testDBOptions)
+	if err != nil {
+		t.Fatalf("Failed to initialize test database: %v", err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	productRepo := database.NewProductRepository(db)
+
+	assert.NoError(t, productRepo.SoftDeleteProduct(ctx, "MLB001", time.Now().UTC().Add(-48*time.Hour)))
+	assert.NoError(t, productRepo.SoftDeleteProduct(ctx, "MLB002", time.Now().UTC()))
+
+	purged, err := usecase.NewPurgeDeletedProductsUseCase(productRepo, 24*time.Hour).Execute(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+
+	_, err = productRepo.GetProduct(ctx, "MLB001", true)
+	assert.ErrorIs(t, err, errors.ErrProductNotFound)
+
+	images, err := productRepo.FindImagesByProductID(ctx, "MLB001")
+	assert.NoError(t, err)
+	assert.Empty(t, images)
+
+	// Still within the retention window, so it can be restored
+	_, err = productRepo.GetProduct(ctx, "MLB002", true)
+	assert.NoError(t, err)
+}
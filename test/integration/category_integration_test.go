@@ -0,0 +1,166 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"project/internal/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func getJSON(t *testing.T, router *gin.Engine, url string, target any) {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", url, nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), target))
+}
+
+func TestIntegration_ListCategories(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	var response dto.CategoryTreeResponse
+	getJSON(t, router, "/api/v1/categories", &response)
+
+	// The seed category paths are converted into a tree
+	assert.Len(t, response.Data, 2)
+	electronics, fashion := response.Data[0], response.Data[1]
+	assert.Equal(t, "Electronics", electronics.Name)
+	assert.Equal(t, 4, electronics.ProductCount)
+	assert.Len(t, electronics.Children, 4)
+	assert.Equal(t, "Fashion", fashion.Name)
+	assert.Equal(t, "Shoes", fashion.Children[0].Name)
+	assert.Equal(t, "Fashion > Shoes > Sneakers", fashion.Children[0].Children[0].Path)
+	assert.Equal(t, 1, fashion.Children[0].Children[0].ProductCount)
+}
+
+func TestIntegration_GetCategory(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	var product dto.ProductResponse
+	getJSON(t, router, "/api/v1/products/MLB005", &product)
+
+	assert.NotNil(t, product.Data.CategoryID)
+	assert.Len(t, product.Data.Breadcrumbs, 3)
+	assert.Equal(t, "Electronics", product.Data.Breadcrumbs[0].Name)
+	assert.Equal(t, "Audio", product.Data.Breadcrumbs[1].Name)
+	assert.Equal(t, "Headphones", product.Data.Breadcrumbs[2].Name)
+	assert.Equal(t, *product.Data.CategoryID, product.Data.Breadcrumbs[2].ID)
+
+	audioID := product.Data.Breadcrumbs[1].ID
+
+	var category dto.CategoryResponse
+	getJSON(t, router, "/api/v1/categories/"+strconv.FormatInt(audioID, 10), &category)
+
+	assert.Equal(t, "Electronics > Audio", category.Data.Path)
+	assert.Equal(t, product.Data.Breadcrumbs[:2], category.Data.Breadcrumbs)
+	assert.Equal(t, []dto.CategoryRefDTO{product.Data.Breadcrumbs[2]}, category.Data.Children)
+	assert.Equal(t, 1, category.Data.ProductCount)
+	assert.Equal(t, []dto.CategoryAttributeDTO{
+		{Name: "brand", Type: "string"},
+		{Name: "wireless", Type: "boolean"},
+	}, category.Data.Attributes)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/categories/999", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "CATEGORY_NOT_FOUND")
+}
+
+func TestIntegration_ListProducts_ByCategoryID(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	var tree dto.CategoryTreeResponse
+	getJSON(t, router, "/api/v1/categories", &tree)
+	electronicsID := tree.Data[0].ID
+
+	var response dto.ProductListResponse
+	getJSON(t, router, "/api/v1/products?category_id="+strconv.FormatInt(electronicsID, 10), &response)
+
+	ids := []string{}
+	for _, product := range response.Data {
+		ids = append(ids, product.ID)
+	}
+	assert.Equal(t, []string{"MLB001", "MLB002", "MLB003", "MLB005"}, ids)
+	assert.Equal(t, 2, response.Facets.Category.Level)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/products?category_id=999", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestIntegration_CreateProduct_CreatesCategories(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	body := `{
+		"title": "Kindle Paperwhite",
+		"price": 149.99,
+		"currency": "USD",
+		"condition": "new",
+		"stock": 3,
+		"seller_id": "SELLER001",
+		"seller_name": "TechWorld Store",
+		"category": "Electronics > E-readers > Kindle"
+	}`
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/products", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var product dto.ProductResponse
+	getJSON(t, router, w.Header().Get("Location"), &product)
+
+	assert.Equal(t, []string{"Electronics", "E-readers", "Kindle"}, breadcrumbNames(product.Data.Breadcrumbs))
+
+	var tree dto.CategoryTreeResponse
+	getJSON(t, router, "/api/v1/categories", &tree)
+	assert.Equal(t, 5, tree.Data[0].ProductCount)
+	assert.Len(t, tree.Data[0].Children, 5)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/api/v1/products", strings.NewReader(strings.Replace(body, "E-readers > Kindle", "E-readers >  > Kindle", 1)))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+}
+
+func breadcrumbNames(breadcrumbs []dto.CategoryRefDTO) []string {
+	names := []string{}
+	for _, breadcrumb := range breadcrumbs {
+		names = append(names, breadcrumb.Name)
+	}
+	return names
+}
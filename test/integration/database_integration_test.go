@@ -0,0 +1,100 @@
+package integration
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"project/internal/errors"
+	"project/internal/infra/database"
+	"project/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntegration_DatabaseFile_SurvivesRestart(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	options := database.Options{
+		Path:        filepath.Join(t.TempDir(), "products.db"),
+		WAL:         true,
+		BusyTimeout: time.Second,
+		ForeignKeys: true,
+		Seed:        true,
+	}
+	ctx := context.Background()
+
+	db, err := database.InitDB(options)
+	if err != nil {
+		t.Fatalf("Failed to initialize test database: %v", err)
+	}
+
+	var journalMode string
+	assert.NoError(t, db.Get(&journalMode, "PRAGMA journal_mode"))
+	assert.Equal(t, "wal", journalMode)
+
+	productRepo := database.NewProductRepository(db)
+	_, err = productRepo.GetProduct(ctx, "MLB001", false)
+	assert.NoError(t, err)
+
+	assert.NoError(t, productRepo.SoftDeleteProduct(ctx, "MLB001", time.Now().UTC().Add(-48*time.Hour)))
+	purged, err := productRepo.PurgeDeletedProducts(ctx, time.Now().UTC().Add(-24*time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+	assert.NoError(t, db.Close())
+
+	// Reopening neither runs the migrations again nor seeds the database
+	// again, so the purged product stays gone.
+	db, err = database.InitDB(options)
+	if err != nil {
+		t.Fatalf("Failed to reopen test database: %v", err)
+	}
+	defer db.Close()
+
+	productRepo = database.NewProductRepository(db)
+	_, err = productRepo.GetProduct(ctx, "MLB001", true)
+	assert.ErrorIs(t, err, errors.ErrProductNotFound)
+	_, err = productRepo.GetProduct(ctx, "MLB002", false)
+	assert.NoError(t, err)
+
+	var foreignKeys bool
+	assert.NoError(t, db.Get(&foreignKeys, "PRAGMA foreign_keys"))
+	assert.True(t, foreignKeys)
+}
+
+func TestIntegration_DatabaseFile_WithoutSeed(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	path := filepath.Join(t.TempDir(), "products.db")
+
+	db, err := database.InitDB(database.Options{Path: path, ForeignKeys: true})
+	if err != nil {
+		t.Fatalf("Failed to initialize test database: %v", err)
+	}
+
+	products, err := database.NewProductRepository(db).ListProducts(context.Background(), repository.ProductQuery{Limit: 10})
+	assert.NoError(t, err)
+	assert.Empty(t, products)
+
+	// Reference data, unlike the demo catalog, is always loaded.
+	rates, err := database.NewExchangeRateRepository(db).ListExchangeRates(context.Background())
+	assert.NoError(t, err)
+	assert.NotEmpty(t, rates)
+	assert.NoError(t, db.Close())
+
+	// A database that already exists is not seeded, even when asked to.
+	db, err = database.InitDB(database.Options{Path: path, ForeignKeys: true, Seed: true})
+	if err != nil {
+		t.Fatalf("Failed to reopen test database: %v", err)
+	}
+	defer db.Close()
+
+	products, err = database.NewProductRepository(db).ListProducts(context.Background(), repository.ProductQuery{Limit: 10})
+	assert.NoError(t, err)
+	assert.Empty(t, products)
+}
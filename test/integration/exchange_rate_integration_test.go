@@ -0,0 +1,156 @@
+package integration
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"project/internal/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func putExchangeRates(t *testing.T, router *gin.Engine, body, adminToken string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("PUT", "/api/v1/exchange-rates", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if adminToken != "" {
+		req.Header.Set("X-Admin-Token", adminToken)
+	}
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestIntegration_GetProduct_ConvertsCurrency(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	var product dto.ProductResponse
+	getJSON(t, router, "/api/v1/products/MLB001?currency=brl", &product)
+	assert.True(t, product.Data.Converted)
+	assert.Equal(t, 6395.95, product.Data.Price)
+	assert.Equal(t, "BRL", product.Data.Currency)
+	assert.Equal(t, 1299.99, *product.Data.OriginalPrice)
+	assert.Equal(t, "USD", product.Data.OriginalCurrency)
+
+	var products dto.ProductListResponse
+	getJSON(t, router, "/api/v1/products?limit=2&currency=EUR", &products)
+	for _, p := range products.Data {
+		assert.True(t, p.Converted)
+		assert.Equal(t, "EUR", p.Currency)
+		assert.Equal(t, "USD", p.OriginalCurrency)
+	}
+
+	var unconverted dto.ProductResponse
+	getJSON(t, router, "/api/v1/products/MLB001?currency=USD", &unconverted)
+	assert.False(t, unconverted.Data.Converted)
+	assert.Nil(t, unconverted.Data.OriginalPrice)
+	assert.Equal(t, 1299.99, unconverted.Data.Price)
+}
+
+func TestIntegration_ListProducts_UnknownCurrency(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	for _, url := range []string{"/api/v1/products?currency=XYZ", "/api/v1/products/MLB001?currency=XYZ"} {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", url, nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, url)
+		assert.Contains(t, w.Body.String(), "UNKNOWN_CURRENCY", url)
+	}
+}
+
+func TestIntegration_LoadExchangeRates(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	w := putExchangeRates(t, router, `{"rates": {"BRL": 5}}`, "")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = putExchangeRates(t, router, `{"rates": {"BRL": 5, "CLP": -1}}`, testAdminToken)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "rate of CLP must be greater than 0")
+
+	w = putExchangeRates(t, router, `{"rates": {"BRL": 5, "clp": 880}}`, testAdminToken)
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var rates dto.ExchangeRatesResponse
+	getJSON(t, router, "/api/v1/exchange-rates", &rates)
+	assert.Equal(t, "USD", rates.Data.Base)
+	assert.Len(t, rates.Data.Rates, 6)
+	for _, rate := range rates.Data.Rates {
+		switch rate.Currency {
+		case "BRL":
+			assert.Equal(t, 5.0, rate.Rate)
+		case "CLP":
+			assert.Equal(t, 880.0, rate.Rate)
+		}
+	}
+
+	var product dto.ProductResponse
+	getJSON(t, router, "/api/v1/products/MLB001?currency=CLP", &product)
+	// CLP has no minor unit, so 1299.99 * 880 is rounded to whole pesos.
+	assert.Equal(t, 1143991.0, product.Data.Price)
+}
+
+func TestIntegration_ListProducts_PriceFilterCurrencies(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	for _, body := range []string{
+		`{"id": "MLB100", "title": "Teclado Mecânico", "price": 100, "currency": "MXN", "condition": "refurbished", "stock": 5, "seller_id": "SELLER001", "seller_name": "TechWorld Store"}`,
+		`{"id": "MLB101", "title": "Monitor 24 Polegadas", "price": 492, "currency": "BRL", "condition": "refurbished", "stock": 5, "seller_id": "SELLER001", "seller_name": "TechWorld Store"}`,
+		`{"id": "MLB102", "title": "Headset Gamer", "price": 150, "currency": "USD", "condition": "refurbished", "stock": 5, "seller_id": "SELLER001", "seller_name": "TechWorld Store"}`,
+		`{"id": "MLB103", "title": "Webcam Full HD", "price": 50, "currency": "GBP", "condition": "refurbished", "stock": 5, "seller_id": "SELLER001", "seller_name": "TechWorld Store"}`,
+	} {
+		w := postProduct(t, router, body)
+		assert.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	}
+
+	ids := func(response dto.ProductListResponse) []string {
+		ids := []string{}
+		for _, product := range response.Data {
+			ids = append(ids, product.ID)
+		}
+		return ids
+	}
+
+	var inUSD dto.ProductListResponse
+	getJSON(t, router, "/api/v1/products?condition=refurbished&max_price=100", &inUSD)
+	assert.ElementsMatch(t, []string{"MLB100", "MLB101"}, ids(inUSD))
+
+	var inBRL dto.ProductListResponse
+	getJSON(t, router, "/api/v1/products?condition=refurbished&currency=BRL&min_price=492&max_price=492", &inBRL)
+	assert.Equal(t, []string{"MLB101"}, ids(inBRL))
+
+	var all dto.ProductListResponse
+	getJSON(t, router, "/api/v1/products?condition=refurbished", &all)
+	assert.Len(t, all.Data, 4)
+	if assert.Len(t, all.Facets.PriceRange, 2) {
+		assert.Equal(t, 0.0, all.Facets.PriceRange[0].Min)
+		assert.Equal(t, "USD", all.Facets.PriceRange[0].Currency)
+		assert.Equal(t, 1, all.Facets.PriceRange[0].Count)
+		assert.Equal(t, 100.0, all.Facets.PriceRange[1].Min)
+		assert.Equal(t, "USD", all.Facets.PriceRange[1].Currency)
+		assert.Equal(t, 2, all.Facets.PriceRange[1].Count)
+	}
+}
@@ -0,0 +1,120 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"project/internal/dto"
+	"project/internal/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntegration_GetProductInstallments(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	var response dto.ProductInstallmentsResponse
+	getJSON(t, router, "/api/v1/products/MLB001/installments", &response)
+
+	assert.Equal(t, "MLB001", response.Data.ProductID)
+	assert.Equal(t, 1299.99, response.Data.Price)
+	assert.Equal(t, "USD", response.Data.Currency)
+	assert.Equal(t, []dto.InstallmentDTO{
+		{Quantity: 1, Amount: 1299.99, FirstAmount: 1299.99, Total: 1299.99, Currency: "USD", InterestFree: true},
+		{Quantity: 3, Amount: 433.33, FirstAmount: 433.33, Total: 1299.99, Currency: "USD", InterestFree: true},
+		{Quantity: 6, Amount: 216.66, FirstAmount: 216.69, Total: 1299.99, Currency: "USD", InterestFree: true},
+		{Quantity: 12, Amount: 122.85, FirstAmount: 122.85, Total: 1474.2, Currency: "USD", MonthlyInterestRate: 1.99},
+		{Quantity: 18, Amount: 90.49, FirstAmount: 90.49, Total: 1628.82, Currency: "USD", MonthlyInterestRate: 2.49},
+	}, response.Data.Options)
+}
+
+func TestIntegration_GetProductInstallments_ConvertsCurrency(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	var response dto.ProductInstallmentsResponse
+	getJSON(t, router, "/api/v1/products/MLB001/installments?currency=BRL", &response)
+
+	assert.Equal(t, 6395.95, response.Data.Price)
+	assert.Equal(t, "BRL", response.Data.Currency)
+	assert.Len(t, response.Data.Options, 7)
+	for _, option := range response.Data.Options {
+		assert.Equal(t, "BRL", option.Currency)
+	}
+}
+
+func TestIntegration_GetProductInstallments_Errors(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	tests := []struct {
+		name   string
+		url    string
+		status int
+		code   string
+	}{
+		{name: "product not found", url: "/api/v1/products/MLB999/installments", status: http.StatusNotFound, code: "PRODUCT_NOT_FOUND"},
+		{name: "unknown currency", url: "/api/v1/products/MLB001/installments?currency=XYZ", status: http.StatusBadRequest, code: "UNKNOWN_CURRENCY"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", tt.url, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.status, w.Code, w.Body.String())
+
+			var response errors.ErrorResponse
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, tt.code, response.Code)
+		})
+	}
+}
+
+func TestIntegration_ProductInstallments(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	t.Run("detail shows the most installments without interest", func(t *testing.T) {
+		var response dto.ProductResponse
+		getJSON(t, router, "/api/v1/products/MLB001?currency=BRL", &response)
+
+		assert.Equal(t, &dto.InstallmentDTO{
+			Quantity:     12,
+			Amount:       532.99,
+			FirstAmount:  533.06,
+			Total:        6395.95,
+			Currency:     "BRL",
+			InterestFree: true,
+		}, response.Data.Installments)
+	})
+
+	t.Run("listing shows installments of every product", func(t *testing.T) {
+		var response dto.ProductListResponse
+		getJSON(t, router, "/api/v1/products?fields=id,price,installments", &response)
+
+		assert.NotEmpty(t, response.Data)
+		for _, product := range response.Data {
+			if assert.NotNil(t, product.Installments, product.ID) {
+				assert.True(t, product.Installments.InterestFree, product.ID)
+				assert.Equal(t, product.Price, product.Installments.Total, product.ID)
+			}
+		}
+	})
+}
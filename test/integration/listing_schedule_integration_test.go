@@ -0,0 +1,142 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"project/internal/dto"
+	"project/internal/infra/database"
+	"project/internal/infra/http/middleware"
+	"project/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// listedIDs lists the IDs of the products url returns.
+func listedIDs(t *testing.T, router *gin.Engine, url string) []string {
+	t.Helper()
+
+	var list dto.ProductListResponse
+	getJSON(t, router, url, &list)
+
+	ids := make([]string, 0, len(list.Data))
+	for _, product := range list.Data {
+		ids = append(ids, product.ID)
+	}
+	return ids
+}
+
+func TestIntegration_ListingSchedule(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	db, err := database.InitDB(testDBOptions)
+	if err != nil {
+		t.Fatalf("Failed to initialize test database: %v", err)
+	}
+	defer db.Close()
+
+	router := newTestRouter(db)
+	scheduler := usecase.NewApplyListingScheduleUseCase(database.NewProductRepository(db))
+	ctx := context.Background()
+
+	var seller dto.SellerResponse
+	getJSON(t, router, "/api/v1/sellers/SELLER001", &seller)
+	activeCount := seller.Data.ProductCount
+
+	publishAt := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+	body := fmt.Sprintf(`{
+		"id": "MLB900",
+		"title": "Launch Edition Headphones",
+		"price": 199.90,
+		"currency": "USD",
+		"condition": "new",
+		"stock": 50,
+		"seller_id": "SELLER001",
+		"category": "Electronics > Audio",
+		"publish_at": %q,
+		"expires_at": %q
+	}`, publishAt.Format(time.RFC3339), publishAt.Add(24*time.Hour).Format(time.RFC3339))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/products", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var product dto.ProductResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &product))
+	assert.Equal(t, "scheduled", product.Data.Status)
+	assert.True(t, publishAt.Equal(*product.Data.PublishAt))
+
+	// Until publish_at the listing is only shown to its seller.
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/products/MLB900", nil)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, http.StatusOK, getAs(router, "/api/v1/products/MLB900", middleware.SellerIDHeader, "SELLER001").Code)
+	assert.NotContains(t, listedIDs(t, router, "/api/v1/products?limit=100"), "MLB900")
+
+	applied, err := scheduler.Execute(ctx)
+	assert.NoError(t, err)
+	assert.Zero(t, applied)
+
+	// publish_at passes while the scheduler is not running: the listing is
+	// already public, and the scheduler stores it as active when it runs.
+	_, err = db.Exec("UPDATE products SET publish_at = ? WHERE id = ?", time.Now().UTC().Add(-time.Minute), "MLB900")
+	assert.NoError(t, err)
+
+	getJSON(t, router, "/api/v1/products/MLB900", &product)
+	assert.Equal(t, "active", product.Data.Status)
+	assert.Contains(t, listedIDs(t, router, "/api/v1/products?limit=100"), "MLB900")
+	getJSON(t, router, "/api/v1/sellers/SELLER001", &seller)
+	assert.Equal(t, activeCount+1, seller.Data.ProductCount)
+
+	applied, err = scheduler.Execute(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, applied)
+
+	// Past expires_at the listing is closed, even before the scheduler runs.
+	_, err = db.Exec("UPDATE products SET expires_at = ? WHERE id = ?", time.Now().UTC().Add(-time.Second), "MLB900")
+	assert.NoError(t, err)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/products/MLB900", nil)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.NotContains(t, listedIDs(t, router, "/api/v1/products?limit=100"), "MLB900")
+	w = getAs(router, "/api/v1/products?status=closed&seller_id=SELLER001", middleware.SellerIDHeader, "SELLER001")
+	assert.Contains(t, w.Body.String(), "MLB900")
+
+	// The seller cannot activate an expired listing.
+	w = postStatus(t, router, "MLB900", `{"status": "paused"}`, middleware.SellerIDHeader, "SELLER001")
+	assert.Equal(t, http.StatusConflict, w.Code, w.Body.String())
+
+	var changes dto.StatusChangeListResponse
+	w = getAs(router, "/api/v1/products/MLB900/status-changes", middleware.SellerIDHeader, "SELLER001")
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &changes))
+	var reasons []string
+	for _, change := range changes.Data {
+		assert.Equal(t, "system", change.Actor)
+		reasons = append(reasons, change.Reason)
+	}
+	assert.Equal(t, []string{"scheduled", "published"}, reasons)
+
+	applied, err = scheduler.Execute(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, applied)
+
+	w = getAs(router, "/api/v1/products/MLB900/status-changes", middleware.SellerIDHeader, "SELLER001")
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &changes))
+	assert.Len(t, changes.Data, 3)
+	assert.Equal(t, "closed", changes.Data[2].To)
+	assert.Equal(t, "expired", changes.Data[2].Reason)
+}
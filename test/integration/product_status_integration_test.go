@@ -0,0 +1,220 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"project/internal/dto"
+	"project/internal/infra/http/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// postStatus changes the status of product id with body, as the caller set
+// by the header, if any.
+func postStatus(t *testing.T, router *gin.Engine, id, body, header, value string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/products/"+id+"/status", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if header != "" {
+		req.Header.Set(header, value)
+	}
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+// getAs gets url as the caller set by header.
+func getAs(router *gin.Engine, url, header, value string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", url, nil)
+	req.Header.Set(header, value)
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestIntegration_ProductStatus(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	// Another seller cannot pause the listing, and a seller cannot put it
+	// under review.
+	w := postStatus(t, router, "MLB001", `{"status": "paused"}`, middleware.SellerIDHeader, "SELLER002")
+	assert.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+	w = postStatus(t, router, "MLB001", `{"status": "under_review"}`, middleware.SellerIDHeader, "SELLER001")
+	assert.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+
+	w = postStatus(t, router, "MLB001", `{"status": "paused", "reason": "On vacation"}`, middleware.SellerIDHeader, "SELLER001")
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var product dto.ProductResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &product))
+	assert.Equal(t, "paused", product.Data.Status)
+
+	// The paused listing is hidden from the public, but not from its seller
+	// or administrators.
+	w = httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/products/MLB001", nil)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, http.StatusNotFound, getAs(router, "/api/v1/products/MLB001", middleware.SellerIDHeader, "SELLER002").Code)
+	assert.Equal(t, http.StatusOK, getAs(router, "/api/v1/products/MLB001", middleware.SellerIDHeader, "SELLER001").Code)
+	assert.Equal(t, http.StatusOK, getAs(router, "/api/v1/products/MLB001", middleware.AdminTokenHeader, testAdminToken).Code)
+
+	var list dto.ProductListResponse
+	getJSON(t, router, "/api/v1/products", &list)
+	for _, listed := range list.Data {
+		assert.NotEqual(t, "MLB001", listed.ID)
+	}
+
+	w = getAs(router, "/api/v1/products?status=paused", middleware.SellerIDHeader, "SELLER001")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	w = getAs(router, "/api/v1/sellers/SELLER001/products?status=paused", middleware.SellerIDHeader, "SELLER001")
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &list))
+	assert.Len(t, list.Data, 1)
+	assert.Equal(t, "MLB001", list.Data[0].ID)
+
+	var seller dto.SellerResponse
+	getJSON(t, router, "/api/v1/sellers/SELLER001", &seller)
+	assert.Equal(t, 1, seller.Data.ProductCount)
+
+	// A closed listing is final.
+	w = postStatus(t, router, "MLB001", `{"status": "closed"}`, middleware.SellerIDHeader, "SELLER001")
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	w = postStatus(t, router, "MLB001", `{"status": "active"}`, middleware.AdminTokenHeader, testAdminToken)
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), "INVALID_STATUS_TRANSITION")
+
+	w = getAs(router, "/api/v1/products/MLB001/status-changes", middleware.SellerIDHeader, "SELLER001")
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var changes dto.StatusChangeListResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &changes))
+	assert.Len(t, changes.Data, 2)
+	assert.Equal(t, "active", changes.Data[0].From)
+	assert.Equal(t, "paused", changes.Data[0].To)
+	assert.Equal(t, "seller", changes.Data[0].Actor)
+	assert.Equal(t, "SELLER001", changes.Data[0].ActorID)
+	assert.Equal(t, "On vacation", changes.Data[0].Reason)
+	assert.False(t, changes.Data[0].CreatedAt.IsZero())
+	assert.Equal(t, "closed", changes.Data[1].To)
+
+	w = getAs(router, "/api/v1/products/MLB001/status-changes", middleware.SellerIDHeader, "SELLER002")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestIntegration_ProductStatus_OutOfStockPauses(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	body := `{
+		"id": "MLB900",
+		"title": "Kindle Paperwhite 16GB",
+		"price": 149.99,
+		"currency": "USD",
+		"condition": "new",
+		"stock": 0,
+		"seller_id": "SELLER001",
+		"seller_name": "TechWorld Store",
+		"category": "Electronics > Tablets"
+	}`
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/products", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var product dto.ProductResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &product))
+	assert.Equal(t, "paused", product.Data.Status)
+
+	// It cannot be activated until it has stock again.
+	w = postStatus(t, router, "MLB900", `{"status": "active"}`, middleware.SellerIDHeader, "SELLER001")
+	assert.Equal(t, http.StatusConflict, w.Code, w.Body.String())
+
+	w = getAs(router, "/api/v1/products/MLB900/status-changes", middleware.AdminTokenHeader, testAdminToken)
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var changes dto.StatusChangeListResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &changes))
+	assert.Len(t, changes.Data, 1)
+	assert.Equal(t, "system", changes.Data[0].Actor)
+	assert.Equal(t, "out of stock", changes.Data[0].Reason)
+}
+
+func TestIntegration_ProductStatus_ReviewsAndQuestions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	w := postStatus(t, router, "MLB001", `{"status": "paused"}`, middleware.SellerIDHeader, "SELLER001")
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	// The reviews and questions of a paused listing are hidden like the
+	// listing itself, and nobody else can review it or ask about it.
+	for _, url := range []string{"/api/v1/products/MLB001/reviews", "/api/v1/products/MLB001/questions"} {
+		assert.Equal(t, http.StatusNotFound, getAs(router, url, middleware.SellerIDHeader, "SELLER002").Code, url)
+		assert.Equal(t, http.StatusOK, getAs(router, url, middleware.SellerIDHeader, "SELLER001").Code, url)
+		assert.Equal(t, http.StatusOK, getAs(router, url, middleware.AdminTokenHeader, testAdminToken).Code, url)
+	}
+
+	w = postReview(t, router, "MLB001", `{"rating": 1}`)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "PRODUCT_NOT_FOUND")
+	w = postQuestionAction(t, router, "MLB001/questions", `{"text": "Is it unlocked?"}`, nil)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "PRODUCT_NOT_FOUND")
+
+	w = getAs(router, "/api/v1/products/MLB001", middleware.SellerIDHeader, "SELLER001")
+	assert.Equal(t, http.StatusOK, w.Code)
+	var product dto.ProductResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &product))
+	assert.Equal(t, 3, product.Data.Rating.Count)
+
+	w = postQuestionAction(t, router, "MLB001/questions", `{"text": "Is it unlocked?"}`, map[string]string{middleware.AdminTokenHeader: testAdminToken})
+	assert.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+}
+
+func TestIntegration_ProductStatus_MatchesGetProduct(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	w := postPromotion(t, router, promotionBody(`"product_id": "MLB001"`, 20, 0, time.Now().Add(-time.Hour)))
+	assert.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	w = postStatus(t, router, "MLB001", `{"status": "paused"}`, middleware.SellerIDHeader, "SELLER001")
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var changed dto.ProductResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &changed))
+
+	w = getAs(router, "/api/v1/products/MLB001", middleware.SellerIDHeader, "SELLER001")
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var got dto.ProductResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+
+	// The status change answers with the product as the detail shows it,
+	// with its variations, breadcrumbs, promotion price and installments.
+	assert.Equal(t, got, changed)
+	assert.NotEmpty(t, changed.Data.Variations)
+	assert.NotEmpty(t, changed.Data.Breadcrumbs)
+	assert.Equal(t, 20.0, changed.Data.DiscountPercent)
+	assert.NotNil(t, changed.Data.Installments)
+}
@@ -0,0 +1,190 @@
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"project/internal/dto"
+	"project/internal/infra/database"
+	"project/internal/infra/http/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func postPromotion(t *testing.T, router *gin.Engine, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/promotions", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(middleware.AdminTokenHeader, testAdminToken)
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+// promotionBody is a promotion for target, such as `"product_id": "MLB001"`,
+// active from startsAt for a day.
+func promotionBody(target string, percent float64, priority int, startsAt time.Time) string {
+	return fmt.Sprintf(`{%s, "type": "percentage", "value": %g, "priority": %d, "starts_at": %q, "ends_at": %q}`,
+		target, percent, priority, startsAt.Format(time.RFC3339), startsAt.Add(24*time.Hour).Format(time.RFC3339))
+}
+
+func TestIntegration_Promotions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+	startsAt := time.Now().Add(-time.Hour)
+
+	var tree dto.CategoryTreeResponse
+	getJSON(t, router, "/api/v1/categories", &tree)
+	electronics := `"category_id": ` + strconv.FormatInt(tree.Data[0].ID, 10)
+
+	w := postPromotion(t, router, promotionBody(electronics, 10, 0, startsAt))
+	assert.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	w = postPromotion(t, router, promotionBody(`"product_id": "MLB001"`, 20, 0, startsAt))
+	assert.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var productPromotion dto.PromotionResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &productPromotion))
+	assert.True(t, productPromotion.Data.Active)
+
+	// Scheduled for tomorrow: listed, but not active yet.
+	w = postPromotion(t, router, promotionBody(`"product_id": "MLB002"`, 50, 0, startsAt.Add(48*time.Hour)))
+	assert.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	// The product promotion wins over the one of its category.
+	var product dto.ProductResponse
+	getJSON(t, router, "/api/v1/products/MLB001", &product)
+	assert.Equal(t, 1039.99, product.Data.Price)
+	assert.Equal(t, 1299.99, *product.Data.OriginalPrice)
+	assert.Equal(t, "USD", product.Data.OriginalCurrency)
+	assert.Equal(t, 20.0, product.Data.DiscountPercent)
+	assert.Equal(t, productPromotion.Data.ID, *product.Data.PromotionID)
+
+	var list dto.ProductListResponse
+	getJSON(t, router, "/api/v1/products", &list)
+	discounts := map[string]float64{}
+	for _, product := range list.Data {
+		discounts[product.ID] = product.DiscountPercent
+	}
+	assert.Equal(t, map[string]float64{"MLB001": 20, "MLB002": 10, "MLB003": 10, "MLB004": 0, "MLB005": 10}, discounts)
+
+	var active dto.PromotionListResponse
+	getJSON(t, router, "/api/v1/promotions?active=true", &active)
+	assert.Len(t, active.Data, 2)
+
+	var all dto.PromotionListResponse
+	getJSON(t, router, "/api/v1/promotions", &all)
+	assert.Len(t, all.Data, 3)
+
+	// Ending the product promotion leaves the one of the category.
+	w = httptest.NewRecorder()
+	req, _ := http.NewRequest("DELETE", "/api/v1/promotions/"+strconv.FormatInt(productPromotion.Data.ID, 10), nil)
+	req.Header.Set(middleware.AdminTokenHeader, testAdminToken)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+
+	getJSON(t, router, "/api/v1/products/MLB001", &product)
+	assert.Equal(t, 1169.99, product.Data.Price)
+	assert.Equal(t, 10.0, product.Data.DiscountPercent)
+}
+
+func TestIntegration_Promotions_Errors(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+	startsAt := time.Now()
+
+	w := postPromotion(t, router, promotionBody(`"product_id": "MLB001"`, 10, 1, startsAt))
+	assert.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	// Same product, same priority, overlapping window.
+	w = postPromotion(t, router, promotionBody(`"product_id": "MLB001"`, 15, 1, startsAt.Add(12*time.Hour)))
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), "PROMOTION_CONFLICT")
+
+	// A higher priority may overlap.
+	w = postPromotion(t, router, promotionBody(`"product_id": "MLB001"`, 15, 2, startsAt.Add(12*time.Hour)))
+	assert.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	w = postPromotion(t, router, promotionBody(`"product_id": "MLB999"`, 10, 0, startsAt))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "PRODUCT_NOT_FOUND")
+
+	w = postPromotion(t, router, `{"product_id": "MLB001", "type": "percentage", "value": 150}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(t, w.Body.String(), `"field":"value"`)
+	assert.Contains(t, w.Body.String(), `"field":"starts_at"`)
+
+	w = httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/promotions", strings.NewReader(promotionBody(`"product_id": "MLB001"`, 10, 0, startsAt)))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("DELETE", "/api/v1/promotions/999", nil)
+	req.Header.Set(middleware.AdminTokenHeader, testAdminToken)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "PROMOTION_NOT_FOUND")
+}
+
+func TestIntegration_Promotions_ConcurrentConflict(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	// A database file, unlike the in-memory one, serves requests on several
+	// connections at once.
+	db, err := database.InitDB(database.Options{
+		Path:        filepath.Join(t.TempDir(), "products.db"),
+		WAL:         true,
+		BusyTimeout: 5 * time.Second,
+		ForeignKeys: true,
+		Seed:        true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to initialize test database: %v", err)
+	}
+	defer db.Close()
+
+	router := newTestRouter(db)
+	body := promotionBody(`"product_id": "MLB001"`, 10, 1, time.Now())
+
+	const requests = 8
+	codes := make([]int, requests)
+	var wg sync.WaitGroup
+	for i := range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			codes[i] = postPromotion(t, router, body).Code
+		}()
+	}
+	wg.Wait()
+
+	created := 0
+	for _, code := range codes {
+		if code == http.StatusCreated {
+			created++
+		} else {
+			assert.Equal(t, http.StatusConflict, code)
+		}
+	}
+	assert.Equal(t, 1, created)
+}
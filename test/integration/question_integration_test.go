@@ -0,0 +1,130 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"project/internal/dto"
+	"project/internal/infra/http/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func postQuestionAction(t *testing.T, router *gin.Engine, path, body string, headers map[string]string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/products/"+path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestIntegration_Questions_AskAndAnswer(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+	seller := map[string]string{middleware.SellerIDHeader: "SELLER001"}
+
+	w := postQuestionAction(t, router, "MLB001/questions", `{"text": "Is the box sealed?"}`, nil)
+	assert.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	var created dto.QuestionResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	assert.Equal(t, "unanswered", created.Data.Status)
+
+	var questions dto.QuestionListResponse
+	getJSON(t, router, "/api/v1/products/MLB001/questions?limit=2", &questions)
+	assert.Len(t, questions.Data, 2)
+	assert.Equal(t, created.Data.ID, questions.Data[0].ID)
+	assert.True(t, questions.Pagination.HasMore)
+
+	path := "MLB001/questions/" + strconv.FormatInt(created.Data.ID, 10) + "/answer"
+
+	w = postQuestionAction(t, router, path, `{"answer": "Yes."}`, map[string]string{middleware.SellerIDHeader: "SELLER002"})
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = postQuestionAction(t, router, path, `{"answer": "Yes, factory sealed."}`, seller)
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Contains(t, w.Body.String(), `"status":"answered"`)
+
+	w = postQuestionAction(t, router, path, `{"answer": "Again."}`, seller)
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), "QUESTION_ALREADY_ANSWERED")
+
+	var product dto.ProductResponse
+	getJSON(t, router, "/api/v1/products/MLB001?expand=questions", &product)
+	assert.Len(t, product.Data.Questions, 3)
+	assert.Equal(t, "Yes, factory sealed.", product.Data.Questions[0].Answer)
+
+	var plain dto.ProductResponse
+	getJSON(t, router, "/api/v1/products/MLB001", &plain)
+	assert.Empty(t, plain.Data.Questions)
+}
+
+func TestIntegration_Questions_Hide(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	w := postQuestionAction(t, router, "MLB001/questions/1/hide", "", nil)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = postQuestionAction(t, router, "MLB001/questions/1/hide", "", map[string]string{middleware.SellerIDHeader: "SELLER001"})
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var questions dto.QuestionListResponse
+	getJSON(t, router, "/api/v1/products/MLB001/questions", &questions)
+	for _, question := range questions.Data {
+		assert.NotEqual(t, int64(1), question.ID)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/products/MLB001/questions?status=hidden", nil)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	var hidden dto.QuestionListResponse
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/products/MLB001/questions?status=hidden", nil)
+	req.Header.Set(middleware.AdminTokenHeader, testAdminToken)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &hidden))
+	assert.Len(t, hidden.Data, 1)
+	assert.Equal(t, int64(1), hidden.Data[0].ID)
+
+	// Questions of another product are not found under this one
+	w = postQuestionAction(t, router, "MLB001/questions/4/hide", "", map[string]string{middleware.AdminTokenHeader: testAdminToken})
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "QUESTION_NOT_FOUND")
+}
+
+func TestIntegration_Questions_UnknownProduct(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	w := postQuestionAction(t, router, "MLB999/questions", `{"text": "Hello?"}`, nil)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "PRODUCT_NOT_FOUND")
+
+	w = postQuestionAction(t, router, "MLB001/questions", `{"text": " "}`, nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "text is required")
+}
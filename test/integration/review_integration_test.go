@@ -0,0 +1,118 @@
+package integration
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"project/internal/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func postReview(t *testing.T, router *gin.Engine, productID, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/products/"+productID+"/reviews", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestIntegration_CreateReview_UpdatesRating(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	var product dto.ProductResponse
+	getJSON(t, router, "/api/v1/products/MLB001", &product)
+	assert.Equal(t, &dto.ProductRatingDTO{
+		Average: 4.67,
+		Count:   3,
+		Stars:   dto.ProductRatingStarsDTO{Four: 1, Five: 2},
+	}, product.Data.Rating)
+
+	w := postReview(t, router, "MLB001", `{"rating": 1, "title": "Broken", "comment": "Screen arrived cracked."}`)
+	assert.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	assert.Contains(t, w.Body.String(), `"title":"Broken"`)
+
+	var updated dto.ProductResponse
+	getJSON(t, router, "/api/v1/products/MLB001", &updated)
+	assert.Equal(t, &dto.ProductRatingDTO{
+		Average: 3.75,
+		Count:   4,
+		Stars:   dto.ProductRatingStarsDTO{One: 1, Four: 1, Five: 2},
+	}, updated.Data.Rating)
+
+	// A review does not change the product version
+	assert.Equal(t, product.Data.UpdatedAt, updated.Data.UpdatedAt)
+
+	var products dto.ProductListResponse
+	getJSON(t, router, "/api/v1/products?limit=1", &products)
+	assert.Equal(t, 4, products.Data[0].Rating.Count)
+}
+
+func TestIntegration_CreateReview_Errors(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	w := postReview(t, router, "MLB001", `{"rating": 6}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "rating must be between 1 and 5")
+
+	w = postReview(t, router, "MLB999", `{"rating": 5}`)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "PRODUCT_NOT_FOUND")
+
+	w = httptest.NewRecorder()
+	req, _ := http.NewRequest("DELETE", "/api/v1/products/MLB002", nil)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+
+	w = postReview(t, router, "MLB002", `{"rating": 5}`)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/products/MLB002/reviews", nil)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestIntegration_ListReviews(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	var page dto.ReviewListResponse
+	getJSON(t, router, "/api/v1/products/MLB001/reviews?limit=2", &page)
+	assert.Len(t, page.Data, 2)
+	assert.Equal(t, "Arrived in two days, sealed box.", page.Data[0].Comment)
+	assert.Equal(t, "Great, but pricey", page.Data[1].Title)
+	assert.True(t, page.Pagination.HasMore)
+
+	var next dto.ReviewListResponse
+	getJSON(t, router, "/api/v1/products/MLB001/reviews?limit=2&cursor="+page.Pagination.NextCursor, &next)
+	assert.Len(t, next.Data, 1)
+	assert.Equal(t, "Best iPhone so far", next.Data[0].Title)
+	assert.False(t, next.Pagination.HasMore)
+
+	var empty dto.ReviewListResponse
+	getJSON(t, router, "/api/v1/products/MLB004/reviews", &empty)
+	assert.Empty(t, empty.Data)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/products/MLB999/reviews", nil)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,200 @@
+//go:build sqlite_fts5
+
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"project/internal/dto"
+	"project/internal/infra/database"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+)
+
+func search(t *testing.T, router *gin.Engine, query string) dto.ProductListResponse {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/products/search?"+query, nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var response dto.ProductListResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	return response
+}
+
+func resultIDs(response dto.ProductListResponse) []string {
+	ids := []string{}
+	for _, product := range response.Data {
+		ids = append(ids, product.ID)
+	}
+	return ids
+}
+
+func TestIntegration_SearchProducts(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	response := search(t, router, "q=wireless")
+
+	assert.ElementsMatch(t, []string{"MLB003", "MLB005"}, resultIDs(response))
+	for _, product := range response.Data {
+		assert.Contains(t, product.Highlight.Title, "<em>Wireless</em>")
+		assert.NotEmpty(t, product.Highlight.Snippet)
+	}
+
+	// Title matches rank above description-only matches
+	response = search(t, router, "q=gaming")
+	assert.Equal(t, []string{"MLB002"}, resultIDs(response))
+
+	// The last word matches as a prefix while the user is still typing
+	response = search(t, router, "q=noise+cancel")
+	assert.Equal(t, []string{"MLB005"}, resultIDs(response))
+
+	// Filters apply to the matches
+	response = search(t, router, "q=wireless&condition=used")
+	assert.Equal(t, []string{"MLB005"}, resultIDs(response))
+
+	// FTS5 operators in the text are searched literally instead of failing
+	response = search(t, router, "q="+url.QueryEscape(`"pro" -chip*`))
+	assert.Equal(t, []string{"MLB001"}, resultIDs(response))
+}
+
+func TestIntegration_SearchProducts_Facets(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	// Facets count every match, not only the returned page
+	response := search(t, router, "q=wireless&limit=1")
+
+	assert.Len(t, response.Data, 1)
+	assert.ElementsMatch(t, []dto.FacetBucketDTO{{Value: "new", Count: 1}, {Value: "used", Count: 1}}, response.Facets.Condition)
+	assert.Equal(t, []dto.FacetBucketDTO{{Value: "Electronics", Label: "Electronics", Count: 2}}, response.Facets.Category.Buckets)
+	assert.Equal(t, []dto.PriceRangeBucketDTO{{Min: 100, Max: ptr(250.0), Currency: "USD", Count: 1}, {Min: 250, Max: ptr(500.0), Currency: "USD", Count: 1}}, response.Facets.PriceRange)
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
+
+func TestIntegration_SearchProducts_Pagination(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	first := search(t, router, "q=wireless&limit=1")
+	assert.Len(t, first.Data, 1)
+	assert.True(t, first.Pagination.HasMore)
+
+	second := search(t, router, "q=wireless&limit=1&cursor="+first.Pagination.NextCursor)
+	assert.Len(t, second.Data, 1)
+	assert.False(t, second.Pagination.HasMore)
+	assert.NotEqual(t, first.Data[0].ID, second.Data[0].ID)
+}
+
+func TestIntegration_SearchProducts_IndexFollowsWrites(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	body := `{"id": "MLB100", "title": "Logitech MX Master 3S Mouse", "price": 99.99, "currency": "USD", "condition": "new", "stock": 5, "seller_id": "SELLER001", "seller_name": "TechWorld Store", "category": "Electronics > Computer Accessories"}`
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/products", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	assert.Equal(t, []string{"MLB100"}, resultIDs(search(t, router, "q=logitech")))
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("PATCH", "/api/v1/products/MLB100", strings.NewReader(`{"title": "Razer Basilisk V3 Mouse"}`))
+	req.Header.Set("If-Match", etagOf(t, router, "MLB100"))
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	assert.Empty(t, search(t, router, "q=logitech").Data)
+	assert.Equal(t, []string{"MLB100"}, resultIDs(search(t, router, "q=razer")))
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("DELETE", "/api/v1/products/MLB100", nil)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+
+	assert.Empty(t, search(t, router, "q=razer").Data)
+}
+
+func etagOf(t *testing.T, router *gin.Engine, id string) string {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/products/"+id, nil)
+	router.ServeHTTP(w, req)
+
+	return w.Header().Get("ETag")
+}
+
+func TestIntegration_SearchProducts_IndexSurvivesRestart(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	options := database.Options{Path: filepath.Join(t.TempDir(), "products.db"), ForeignKeys: true, Seed: true}
+	sentinels := func(db *sqlx.DB) int {
+		var count int
+		assert.NoError(t, db.Get(&count, "SELECT count(*) FROM products_fts WHERE products_fts MATCH 'sentinel'"))
+		return count
+	}
+
+	db, err := database.InitDB(options)
+	if err != nil {
+		t.Fatalf("Failed to initialize test database: %v", err)
+	}
+	assert.ElementsMatch(t, []string{"MLB003", "MLB005"}, resultIDs(search(t, newTestRouter(db), "q=wireless")))
+
+	// An entry only the index has shows whether opening the database
+	// rebuilds it.
+	_, err = db.Exec("INSERT INTO products_fts(rowid, title, description, category) VALUES (-1, 'sentinel', '', '')")
+	assert.NoError(t, err)
+	assert.NoError(t, db.Close())
+
+	db, err = database.InitDB(options)
+	if err != nil {
+		t.Fatalf("Failed to reopen test database: %v", err)
+	}
+	assert.Equal(t, 1, sentinels(db))
+
+	// An index created before its migration is rebuilt when the migration
+	// runs.
+	_, err = db.Exec("DELETE FROM schema_migrations WHERE version = '020_search_index.sql'")
+	assert.NoError(t, err)
+	assert.NoError(t, db.Close())
+
+	db, err = database.InitDB(options)
+	if err != nil {
+		t.Fatalf("Failed to reopen test database: %v", err)
+	}
+	defer db.Close()
+
+	assert.Equal(t, 0, sentinels(db))
+	assert.ElementsMatch(t, []string{"MLB003", "MLB005"}, resultIDs(search(t, newTestRouter(db), "q=wireless")))
+}
@@ -0,0 +1,260 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"project/internal/dto"
+	"project/internal/entity"
+	"project/internal/infra/database"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func postProduct(t *testing.T, router *gin.Engine, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/products", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestIntegration_GetSeller(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	var response dto.SellerResponse
+	getJSON(t, router, "/api/v1/sellers/SELLER001", &response)
+
+	assert.Equal(t, "SELLER001", response.Data.ID)
+	assert.Equal(t, "TechWorld Store", response.Data.Name)
+	assert.Equal(t, 2, response.Data.ProductCount)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/sellers/SELLER999", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "SELLER_NOT_FOUND")
+}
+
+func TestIntegration_ListSellerProducts(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	var response dto.ProductListResponse
+	getJSON(t, router, "/api/v1/sellers/SELLER001/products?expand=seller", &response)
+
+	assert.Len(t, response.Data, 2)
+	assert.Equal(t, "MLB001", response.Data[0].ID)
+	assert.Equal(t, "MLB005", response.Data[1].ID)
+	assert.Equal(t, &dto.SellerDTO{ID: "SELLER001", Name: "TechWorld Store"}, response.Data[1].Seller)
+	assert.Equal(t, []dto.FacetBucketDTO{{Value: "SELLER001", Label: "TechWorld Store", Count: 2}}, response.Facets.Seller)
+
+	getJSON(t, router, "/api/v1/sellers/SELLER001/products?condition=used", &response)
+	assert.Len(t, response.Data, 1)
+	assert.Equal(t, "MLB005", response.Data[0].ID)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/sellers/SELLER999/products", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestIntegration_GetProduct_JoinsSeller(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	var product dto.ProductResponse
+	getJSON(t, router, "/api/v1/products/MLB001", &product)
+
+	assert.Equal(t, "TechWorld Store", product.Data.SellerName)
+	assert.Equal(t, &dto.SellerDTO{ID: "SELLER001", Name: "TechWorld Store"}, product.Data.Seller)
+}
+
+func TestIntegration_CreateProduct_Sellers(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	// A known seller keeps its stored name whatever the request says
+	w := postProduct(t, router, `{"title": "Pixel 9", "price": 799, "currency": "USD", "condition": "new", "stock": 2, "seller_id": "SELLER001", "seller_name": "Renamed Store"}`)
+	assert.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	assert.Contains(t, w.Body.String(), `"seller_name":"TechWorld Store"`)
+
+	// Without seller_name only known sellers are accepted
+	w = postProduct(t, router, `{"title": "Pixel 9", "price": 799, "currency": "USD", "condition": "new", "stock": 2, "seller_id": "SELLER001"}`)
+	assert.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	w = postProduct(t, router, `{"title": "Pixel 9", "price": 799, "currency": "USD", "condition": "new", "stock": 2, "seller_id": "SELLER100"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	// A new seller is registered from the product
+	w = postProduct(t, router, `{"title": "Pixel 9", "price": 799, "currency": "USD", "condition": "new", "stock": 2, "seller_id": "SELLER100", "seller_name": "Pixel Shop"}`)
+	assert.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	var seller dto.SellerResponse
+	getJSON(t, router, "/api/v1/sellers/SELLER100", &seller)
+	assert.Equal(t, "Pixel Shop", seller.Data.Name)
+	assert.Equal(t, 1, seller.Data.ProductCount)
+
+	getJSON(t, router, "/api/v1/sellers/SELLER001", &seller)
+	assert.Equal(t, "TechWorld Store", seller.Data.Name)
+	assert.Equal(t, 4, seller.Data.ProductCount)
+}
+
+func TestIntegration_SellerReputation(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	var seller dto.SellerResponse
+	getJSON(t, router, "/api/v1/sellers/SELLER001", &seller)
+	assert.Equal(t, dto.SellerReputationDTO{
+		Level:            "green",
+		PowerSeller:      "gold",
+		CompletedSales:   60,
+		CancelledSales:   1,
+		CancellationRate: 0.0164,
+		ReviewCount:      3,
+		RatingAverage:    4.67,
+	}, seller.Data.Reputation)
+
+	// Too few finished orders for a level
+	var newSeller dto.SellerResponse
+	getJSON(t, router, "/api/v1/sellers/SELLER004", &newSeller)
+	assert.Equal(t, "none", newSeller.Data.Reputation.Level)
+	assert.Empty(t, newSeller.Data.Reputation.PowerSeller)
+
+	var product dto.ProductResponse
+	getJSON(t, router, "/api/v1/products/MLB002", &product)
+	assert.Equal(t, &dto.SellerReputationSummaryDTO{Level: "red", CompletedSales: 12}, product.Data.SellerReputation)
+
+	var expanded dto.ProductListResponse
+	getJSON(t, router, "/api/v1/sellers/SELLER001/products?expand=seller", &expanded)
+	assert.Equal(t, &dto.SellerReputationSummaryDTO{Level: "green", PowerSeller: "gold", CompletedSales: 60}, expanded.Data[0].SellerReputation)
+
+	// Only embedded along with the seller
+	var products dto.ProductListResponse
+	getJSON(t, router, "/api/v1/sellers/SELLER001/products", &products)
+	assert.Nil(t, products.Data[0].SellerReputation)
+}
+
+func TestIntegration_SellerReputation_RecountedOnOrderChanges(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	db, err := database.InitDB(testDBOptions)
+	if err != nil {
+		t.Fatalf("Failed to initialize test database: %v", err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	sellerRepo := database.NewSellerRepository(db)
+
+	_, err = db.ExecContext(ctx, "INSERT INTO orders (id, product_id, seller_id, status) VALUES ('ORD-NEW', 'MLB004', 'SELLER004', 'completed'), ('ORD-PENDING', 'MLB004', 'SELLER004', 'pending')")
+	assert.NoError(t, err)
+
+	seller, err := sellerRepo.GetSeller(ctx, "SELLER004")
+	assert.NoError(t, err)
+	assert.Equal(t, entity.SellerReputation{CompletedSales: 4}, seller.SellerReputation)
+
+	_, err = db.ExecContext(ctx, "UPDATE orders SET status = 'cancelled' WHERE id = 'ORD-PENDING'")
+	assert.NoError(t, err)
+
+	seller, err = sellerRepo.GetSeller(ctx, "SELLER004")
+	assert.NoError(t, err)
+	assert.Equal(t, entity.SellerReputation{CompletedSales: 4, CancelledSales: 1}, seller.SellerReputation)
+	assert.Equal(t, entity.ReputationRed, seller.Level())
+
+	_, err = db.ExecContext(ctx, "DELETE FROM orders WHERE id = 'ORD-PENDING'")
+	assert.NoError(t, err)
+
+	seller, err = sellerRepo.GetSeller(ctx, "SELLER004")
+	assert.NoError(t, err)
+	assert.Equal(t, entity.SellerReputation{CompletedSales: 4}, seller.SellerReputation)
+}
+
+func TestIntegration_SellerReputation_RecountedOnReviewChanges(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	db, err := database.InitDB(testDBOptions)
+	if err != nil {
+		t.Fatalf("Failed to initialize test database: %v", err)
+	}
+	defer db.Close()
+
+	router := newTestRouter(db)
+
+	// Two poor reviews give SELLER001 enough reviews for its average of 3.2
+	// stars to outweigh its green sales record.
+	for range 2 {
+		w := postReview(t, router, "MLB005", `{"rating": 1, "comment": "Stopped working after a week."}`)
+		assert.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	}
+
+	var seller dto.SellerResponse
+	getJSON(t, router, "/api/v1/sellers/SELLER001", &seller)
+	assert.Equal(t, 5, seller.Data.Reputation.ReviewCount)
+	assert.Equal(t, 3.2, seller.Data.Reputation.RatingAverage)
+	assert.Equal(t, "orange", seller.Data.Reputation.Level)
+	assert.Empty(t, seller.Data.Reputation.PowerSeller)
+
+	var product dto.ProductResponse
+	getJSON(t, router, "/api/v1/products/MLB001", &product)
+	assert.Equal(t, &dto.SellerReputationSummaryDTO{Level: "orange", CompletedSales: 60}, product.Data.SellerReputation)
+
+	// A product moving to another seller takes its reviews along.
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/products/MLB001", nil)
+	router.ServeHTTP(w, req)
+	etag := w.Header().Get("ETag")
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("PATCH", "/api/v1/products/MLB001", strings.NewReader(`{"seller_id": "SELLER002"}`))
+	req.Header.Set("If-Match", etag)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	getJSON(t, router, "/api/v1/sellers/SELLER001", &seller)
+	assert.Equal(t, 2, seller.Data.Reputation.ReviewCount)
+	getJSON(t, router, "/api/v1/sellers/SELLER002", &seller)
+	assert.Equal(t, 4, seller.Data.Reputation.ReviewCount)
+
+	// Purging the product takes its reviews out of the reputation.
+	ctx := context.Background()
+	productRepo := database.NewProductRepository(db)
+	assert.NoError(t, productRepo.SoftDeleteProduct(ctx, "MLB005", time.Now().UTC().Add(-48*time.Hour)))
+	_, err = productRepo.PurgeDeletedProducts(ctx, time.Now().UTC().Add(-24*time.Hour))
+	assert.NoError(t, err)
+
+	getJSON(t, router, "/api/v1/sellers/SELLER001", &seller)
+	assert.Zero(t, seller.Data.Reputation.ReviewCount)
+	assert.Equal(t, "green", seller.Data.Reputation.Level)
+}
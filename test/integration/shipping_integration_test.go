@@ -0,0 +1,213 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"project/internal/dto"
+	"project/internal/errors"
+	"project/internal/infra/http/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func getShippingQuote(t *testing.T, router *gin.Engine, productID, zip string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/products/"+productID+"/shipping?zip="+zip, nil)
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestIntegration_GetShippingQuote(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	tests := []struct {
+		name         string
+		productID    string
+		zip          string
+		freeShipping bool
+		cost         float64
+		weight       int
+		minDays      int
+		maxDays      int
+	}{
+		{name: "free shipping", productID: "MLB001", zip: "20040-002", freeShipping: true, cost: 0, weight: 240, minDays: 3, maxDays: 5},
+		{name: "narrowest rate wins", productID: "MLB005", zip: "01310200", cost: 4.9, weight: 800, minDays: 1, maxDays: 2},
+		{name: "fallback rate", productID: "MLB003", zip: "90010000", cost: 24.9, weight: 900, minDays: 6, maxDays: 10},
+		{name: "cost per further kilogram", productID: "MLB004", zip: "80020000", cost: 29.8, weight: 1621, minDays: 6, maxDays: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := getShippingQuote(t, router, tt.productID, tt.zip)
+
+			assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+			var response dto.ShippingQuoteResponse
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			quote := response.Data
+			assert.Equal(t, tt.freeShipping, quote.FreeShipping)
+			assert.Equal(t, tt.cost, quote.Cost)
+			assert.Equal(t, "USD", quote.Currency)
+			assert.Equal(t, tt.weight, quote.BillableWeightGrams)
+			assert.Equal(t, tt.minDays, quote.MinDays)
+			assert.Equal(t, tt.maxDays, quote.MaxDays)
+
+			from, err := time.Parse(time.DateOnly, quote.EstimatedDeliveryFrom)
+			assert.NoError(t, err)
+			to, err := time.Parse(time.DateOnly, quote.EstimatedDeliveryTo)
+			assert.NoError(t, err)
+			assert.True(t, from.After(time.Now()), "delivery is estimated from today")
+			assert.False(t, to.Before(from))
+			for _, date := range []time.Time{from, to} {
+				assert.NotEqual(t, time.Saturday, date.Weekday())
+				assert.NotEqual(t, time.Sunday, date.Weekday())
+			}
+		})
+	}
+}
+
+func TestIntegration_GetShippingQuote_Errors(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	w := postProduct(t, router, `{
+		"id": "MLB100",
+		"title": "Phone case",
+		"price": 19.99,
+		"currency": "USD",
+		"condition": "new",
+		"stock": 10,
+		"seller_id": "SELLER001",
+		"category": "Electronics > Computer Accessories"
+	}`)
+	assert.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	tests := []struct {
+		name           string
+		productID      string
+		zip            string
+		expectedStatus int
+		expectedCode   string
+	}{
+		{name: "missing zip", productID: "MLB001", zip: "", expectedStatus: http.StatusBadRequest, expectedCode: "INVALID_INPUT"},
+		{name: "invalid zip", productID: "MLB001", zip: "0131-0100", expectedStatus: http.StatusBadRequest, expectedCode: "INVALID_INPUT"},
+		{name: "unknown product", productID: "MLB999", zip: "01310100", expectedStatus: http.StatusNotFound, expectedCode: "PRODUCT_NOT_FOUND"},
+		{name: "product without shipping profile", productID: "MLB100", zip: "01310100", expectedStatus: http.StatusUnprocessableEntity, expectedCode: "SHIPPING_UNAVAILABLE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := getShippingQuote(t, router, tt.productID, tt.zip)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response errors.ErrorResponse
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, tt.expectedCode, response.Code)
+		})
+	}
+}
+
+func TestIntegration_GetShippingQuote_ListingNotActive(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	w := postStatus(t, router, "MLB005", `{"status": "paused"}`, middleware.SellerIDHeader, "SELLER001")
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	// A paused listing is quoted to its seller and administrators only, like
+	// the product itself.
+	w = getShippingQuote(t, router, "MLB005", "01310200")
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+	var response errors.ErrorResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "PRODUCT_NOT_FOUND", response.Code)
+
+	url := "/api/v1/products/MLB005/shipping?zip=01310200"
+	assert.Equal(t, http.StatusNotFound, getAs(router, url, middleware.SellerIDHeader, "SELLER002").Code)
+	assert.Equal(t, http.StatusOK, getAs(router, url, middleware.SellerIDHeader, "SELLER001").Code)
+	assert.Equal(t, http.StatusOK, getAs(router, url, middleware.AdminTokenHeader, testAdminToken).Code)
+
+	// So are its installments.
+	url = "/api/v1/products/MLB005/installments"
+	assert.Equal(t, http.StatusNotFound, getAs(router, url, middleware.SellerIDHeader, "SELLER002").Code)
+	assert.Equal(t, http.StatusOK, getAs(router, url, middleware.SellerIDHeader, "SELLER001").Code)
+}
+
+func TestIntegration_CreateProduct_Shipping(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	router := setupTestRouter(t)
+
+	body := `{
+		"id": "MLB100",
+		"title": "Mechanical keyboard",
+		"price": 89.9,
+		"currency": "USD",
+		"condition": "new",
+		"stock": 10,
+		"seller_id": "SELLER001",
+		"category": "Electronics > Computer Accessories",
+		"shipping": {"weight_grams": -5, "length_cm": 40, "width_cm": 15, "height_cm": 5, "origin_zip": "4538-132"}
+	}`
+
+	w := postProduct(t, router, body)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+
+	var response errors.ErrorResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, []errors.FieldError{
+		{Field: "shipping.weight_grams", Rule: "min", Message: "shipping: weight_grams must be greater than or equal to 0"},
+		{Field: "shipping.origin_zip", Rule: "format", Message: "shipping: origin_zip must be a zip code of 8 digits"},
+	}, response.Details)
+
+	body = `{
+		"id": "MLB100",
+		"title": "Mechanical keyboard",
+		"price": 89.9,
+		"currency": "USD",
+		"condition": "new",
+		"stock": 10,
+		"seller_id": "SELLER001",
+		"category": "Electronics > Computer Accessories",
+		"shipping": {"weight_grams": 850, "length_cm": 40, "width_cm": 15, "height_cm": 5, "free_shipping": true, "origin_zip": "04538-132"}
+	}`
+	w = postProduct(t, router, body)
+
+	assert.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	var product dto.ProductResponse
+	getJSON(t, router, "/api/v1/products/MLB100", &product)
+	assert.Equal(t, &dto.ShippingDTO{WeightGrams: 850, LengthCm: 40, WidthCm: 15, HeightCm: 5, FreeShipping: true, OriginZip: "04538132"}, product.Data.Shipping)
+
+	var list dto.ProductListResponse
+	getJSON(t, router, "/api/v1/products?fields=id,shipping&seller_id=SELLER001", &list)
+	assert.Len(t, list.Data, 3)
+	for _, listed := range list.Data {
+		assert.True(t, listed.Shipping.FreeShipping || listed.ID == "MLB005", listed.ID)
+	}
+
+	w = getShippingQuote(t, router, "MLB100", "01310100")
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+}